@@ -0,0 +1,171 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+const (
+	// DefaultAssumeRoleName is the role AWS Organizations creates in the accounts it creates, and that
+	// cloud-nuke assumes in each account of a multi-account run unless told otherwise.
+	DefaultAssumeRoleName = "OrganizationAccountAccessRole"
+	// AccountIdPlaceholder is replaced by the account ID in the role name template of an AccountSelection.
+	AccountIdPlaceholder = "{account_id}"
+)
+
+// OrganizationsAPI is the part of the AWS Organizations API used to list the accounts of an organization.
+type OrganizationsAPI interface {
+	ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error)
+}
+
+// AccountSelection selects the accounts of a multi-account run, and the role assumed in each of them.
+type AccountSelection struct {
+	// OrgAccounts selects the active accounts of the AWS Organization of the current credentials, which must be
+	// those of the management account or of a delegated administrator.
+	OrgAccounts bool
+	// AccountIds selects these accounts. With OrgAccounts, only the accounts of the organization that are listed
+	// here are selected.
+	AccountIds []string
+	// ExcludeAccountIds are never selected, whichever way the other accounts are selected.
+	ExcludeAccountIds []string
+	// RoleName is the name of the role to assume in each account, or its ARN for partitions other than aws.
+	// AccountIdPlaceholder is replaced by the account ID. Defaults to DefaultAssumeRoleName.
+	RoleName string
+}
+
+// IsMultiAccount returns true when the selection selects accounts, rather than the account of the current credentials.
+func (s AccountSelection) IsMultiAccount() bool {
+	return s.OrgAccounts || len(s.AccountIds) > 0
+}
+
+// Validate ensures the account IDs of the selection are valid.
+func (s AccountSelection) Validate() error {
+	for _, accountId := range slices.Concat(s.AccountIds, s.ExcludeAccountIds) {
+		if !isAccountId(accountId) {
+			return InvalidAccountIdError{Value: accountId}
+		}
+	}
+	return nil
+}
+
+// Accounts returns the IDs of the selected accounts, sorted. client is only used with OrgAccounts.
+func (s AccountSelection) Accounts(ctx context.Context, client OrganizationsAPI) ([]string, error) {
+	accountIds := s.AccountIds
+	if s.OrgAccounts {
+		orgAccountIds, err := listActiveOrganizationAccounts(ctx, client)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+		if len(s.AccountIds) > 0 {
+			orgAccountIds = slices.DeleteFunc(orgAccountIds, func(accountId string) bool {
+				return !slices.Contains(s.AccountIds, accountId)
+			})
+		}
+		accountIds = orgAccountIds
+	}
+
+	var selected []string
+	for _, accountId := range accountIds {
+		if !slices.Contains(s.ExcludeAccountIds, accountId) {
+			selected = append(selected, accountId)
+		}
+	}
+	slices.Sort(selected)
+	return slices.Compact(selected), nil
+}
+
+// RoleArn returns the ARN of the role to assume in an account.
+func (s AccountSelection) RoleArn(accountId string) string {
+	roleName := s.RoleName
+	if roleName == "" {
+		roleName = DefaultAssumeRoleName
+	}
+	roleName = strings.ReplaceAll(roleName, AccountIdPlaceholder, accountId)
+	if strings.HasPrefix(roleName, "arn:") {
+		return roleName
+	}
+	return fmt.Sprintf("arn:aws:iam::%s:role/%s", accountId, roleName)
+}
+
+// InAccount runs fn with the credentials of the role assumed in an account: every AWS config created while fn runs,
+// including those of the resources scanned and nuked, is for that account.
+func (s AccountSelection) InAccount(accountId string, fn func() error) error {
+	restore := externalcreds.AssumeRole(s.RoleArn(accountId))
+	defer restore()
+	return fn()
+}
+
+// CheckAccount ensures the accounts config allows running against an account. An empty account ID is one that
+// could not be determined, and is only allowed when no account is restricted.
+func CheckAccount(accountId string, accounts config.Accounts) error {
+	if !accounts.IsRestricted() {
+		return nil
+	}
+	if accountId == "" {
+		return UnknownAccountError{}
+	}
+	if !accounts.IsAllowed(accountId) {
+		return AccountNotAllowedError{AccountId: accountId, Blocked: slices.Contains(accounts.Block, accountId)}
+	}
+	return nil
+}
+
+// CheckCurrentAccount ensures the accounts config allows running against the account of the current credentials.
+func CheckCurrentAccount(accounts config.Accounts) error {
+	if !accounts.IsRestricted() {
+		return nil
+	}
+	accountId, err := GetCurrentAccountId(GlobalRegion)
+	if err != nil {
+		return errors.WithStackTrace(UnknownAccountError{Underlying: err})
+	}
+	return CheckAccount(accountId, accounts)
+}
+
+// NewOrganizationsClient returns a client of the AWS Organizations API, with the current credentials.
+func NewOrganizationsClient() (*organizations.Client, error) {
+	cfg, err := NewSession(GlobalRegion)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	return organizations.NewFromConfig(cfg), nil
+}
+
+// listActiveOrganizationAccounts returns the IDs of the active accounts of the organization.
+func listActiveOrganizationAccounts(ctx context.Context, client OrganizationsAPI) ([]string, error) {
+	var accountIds []string
+	paginator := organizations.NewListAccountsPaginator(client, &organizations.ListAccountsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, account := range page.Accounts {
+			if account.State == types.AccountStateActive && account.Id != nil {
+				accountIds = append(accountIds, *account.Id)
+			}
+		}
+	}
+	return accountIds, nil
+}
+
+// isAccountId returns true if value is a 12-digit AWS account ID.
+func isAccountId(value string) bool {
+	if len(value) != 12 {
+		return false
+	}
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
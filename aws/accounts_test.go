@@ -0,0 +1,104 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockOrganizationsClient struct {
+	pages [][]types.Account
+}
+
+func (m mockOrganizationsClient) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	page := 0
+	if params.NextToken != nil {
+		page = 1
+	}
+	output := &organizations.ListAccountsOutput{Accounts: m.pages[page]}
+	if page+1 < len(m.pages) {
+		output.NextToken = awsgo.String("next")
+	}
+	return output, nil
+}
+
+func TestAccountSelection_Accounts(t *testing.T) {
+	t.Parallel()
+
+	client := mockOrganizationsClient{pages: [][]types.Account{
+		{
+			{Id: awsgo.String("333333333333"), State: types.AccountStateActive},
+			{Id: awsgo.String("111111111111"), State: types.AccountStateActive},
+		},
+		{
+			{Id: awsgo.String("222222222222"), State: types.AccountStateActive},
+			{Id: awsgo.String("444444444444"), State: types.AccountStateSuspended},
+		},
+	}}
+
+	tests := []struct {
+		name      string
+		selection AccountSelection
+		expected  []string
+	}{
+		{
+			name:      "organization",
+			selection: AccountSelection{OrgAccounts: true},
+			expected:  []string{"111111111111", "222222222222", "333333333333"},
+		},
+		{
+			name:      "organization with allow and deny lists",
+			selection: AccountSelection{OrgAccounts: true, AccountIds: []string{"111111111111", "222222222222", "444444444444"}, ExcludeAccountIds: []string{"222222222222"}},
+			expected:  []string{"111111111111"},
+		},
+		{
+			name:      "account ids",
+			selection: AccountSelection{AccountIds: []string{"555555555555", "111111111111", "555555555555"}, ExcludeAccountIds: []string{"111111111111"}},
+			expected:  []string{"555555555555"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			accounts, err := tc.selection.Accounts(context.Background(), client)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, accounts)
+		})
+	}
+}
+
+func TestAccountSelection_RoleArn(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "arn:aws:iam::111111111111:role/OrganizationAccountAccessRole", AccountSelection{}.RoleArn("111111111111"))
+	assert.Equal(t, "arn:aws:iam::111111111111:role/sandbox-111111111111-nuker",
+		AccountSelection{RoleName: "sandbox-{account_id}-nuker"}.RoleArn("111111111111"))
+	assert.Equal(t, "arn:aws-us-gov:iam::111111111111:role/nuker",
+		AccountSelection{RoleName: "arn:aws-us-gov:iam::{account_id}:role/nuker"}.RoleArn("111111111111"))
+}
+
+func TestAccountSelection_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, AccountSelection{AccountIds: []string{"111111111111"}}.Validate())
+	assert.Equal(t, InvalidAccountIdError{Value: "11111111111"}, AccountSelection{ExcludeAccountIds: []string{"11111111111"}}.Validate())
+	assert.Equal(t, InvalidAccountIdError{Value: "sandbox-1234"}, AccountSelection{AccountIds: []string{"sandbox-1234"}}.Validate())
+}
+
+func TestCheckAccount(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CheckAccount("", config.Accounts{}))
+	require.NoError(t, CheckAccount("111111111111", config.Accounts{Aliases: map[string]string{"111111111111": "sandbox"}}))
+
+	accounts := config.Accounts{Allow: []string{"111111111111", "222222222222"}, Block: []string{"222222222222"}}
+	require.NoError(t, CheckAccount("111111111111", accounts))
+	assert.Equal(t, AccountNotAllowedError{AccountId: "222222222222", Blocked: true}, CheckAccount("222222222222", accounts))
+	assert.Equal(t, AccountNotAllowedError{AccountId: "333333333333"}, CheckAccount("333333333333", accounts))
+	assert.Equal(t, UnknownAccountError{}, CheckAccount("", accounts))
+}
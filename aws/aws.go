@@ -2,24 +2,20 @@ package aws
 
 import (
 	"context"
-	"fmt"
 	"sort"
-	"time"
-
-	"github.com/gruntwork-io/cloud-nuke/util"
-	"github.com/hashicorp/go-multierror"
-
-	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/gruntwork-io/go-commons/collections"
 )
 
 // GetAllResources - Lists all aws resources
-func GetAllResources(c context.Context, query *Query, configObj config.Config, collector *reporting.Collector) (*AwsAccountResources, error) {
+func GetAllResources(c context.Context, query *Query, configObj config.Config, collector reporting.Emitter) (*AwsAccountResources, error) {
 	configObj.AddExcludeAfterTime(query.ExcludeAfter)
 	configObj.AddIncludeAfterTime(query.IncludeAfter)
 	configObj.AddIncludeTags(query.IncludeTags)
@@ -35,87 +31,36 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 
 	account := AwsAccountResources{
 		Resources: make(map[string]AwsResources),
+		configObj: configObj,
 	}
 
-	c = context.WithValue(c, util.ExcludeFirstSeenTagKey, query.ExcludeFirstSeen)
-	for _, region := range query.Regions {
-		cloudNukeSession, errSession := NewSession(region)
-		if errSession != nil {
-			return nil, errSession
-		}
-
-		accountId, err := util.GetCurrentAccountId(cloudNukeSession)
-		if err == nil {
+	// Some resource types need the account ID to list and nuke resources
+	var accountErr error
+	if len(query.Regions) > 0 {
+		var accountId string
+		if accountId, accountErr = GetCurrentAccountId(query.Regions[0]); accountErr == nil {
 			telemetry.SetAccountId(accountId)
+			account.accountId = accountId
 			c = context.WithValue(c, util.AccountIdKey, accountId)
 		}
+	}
 
-		awsResource := AwsResources{}
-		registeredResources := GetAndInitRegisteredResources(cloudNukeSession, region)
-		for _, resource := range registeredResources {
-			if IsNukeable((*resource).ResourceName(), query.ResourceTypes) {
-
-				(*resource).GetAndSetResourceConfig(configObj)
-
-				// Emit scan progress event
-				collector.Emit(reporting.ScanProgress{
-					ResourceType: (*resource).ResourceName(),
-					Region:       region,
-				})
-
-				start := time.Now()
-				identifiers, err := (*resource).GetAndSetIdentifiers(c, configObj)
-				if err != nil {
-					logging.Errorf("Unable to retrieve %v, %v", (*resource).ResourceName(), err)
-
-					// Reporting resource-level failures encountered during the GetIdentifiers phase
-					telemetry.TrackEvent(commonTelemetry.EventContext{
-						EventName: fmt.Sprintf("error:GetIdentifiers:%s", (*resource).ResourceName()),
-					}, map[string]interface{}{
-						"region": region,
-					})
-
-					collector.Emit(reporting.GeneralError{
-						ResourceType: (*resource).ResourceName(),
-						Description:  fmt.Sprintf("Unable to retrieve %s", (*resource).ResourceName()),
-						Error:        err.Error(),
-					})
-				}
-
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: fmt.Sprintf("Done getting %s identifiers", (*resource).ResourceName()),
-				}, map[string]interface{}{
-					"recordCount": len(identifiers),
-					"actionTime":  time.Since(start).Seconds(),
-				})
-
-				// Only append if we have non-empty identifiers
-				if len(identifiers) > 0 {
-					logging.Infof("Found %d %s resources in %s", len(identifiers), (*resource).ResourceName(), region)
-					awsResource.Resources = append(awsResource.Resources, resource)
-
-					// Emit ResourceFound events for each identifier
-					for _, id := range identifiers {
-						nukable, reason := true, ""
-						if _, err := (*resource).IsNukable(id); err != nil {
-							nukable, reason = false, err.Error()
-						}
-						collector.Emit(reporting.ResourceFound{
-							ResourceType: (*resource).ResourceName(),
-							Region:       region,
-							Identifier:   id,
-							Nukable:      nukable,
-							Reason:       reason,
-						})
-					}
-				}
-			}
-		}
+	// Refuse to scan accounts the config doesn't allow
+	if account.accountId == "" && configObj.Accounts.IsRestricted() {
+		return nil, UnknownAccountError{Underlying: accountErr}
+	}
+	if err := CheckAccount(account.accountId, configObj.Accounts); err != nil {
+		return nil, err
+	}
 
-		if len(awsResource.Resources) > 0 {
-			account.Resources[region] = awsResource
-		}
+	found, err := engine.Scan(c, provider{query: query}, query.engineSettings(), configObj, collector)
+	if err != nil {
+		return nil, err
 	}
+	for region, regionResources := range found.ByScope {
+		account.Resources[region] = toAwsResources(regionResources)
+	}
+	account.incompleteRegions = found.IncompleteScopes
 
 	logging.Info("Done searching for resources")
 	logging.Infof("Found total of %d resources", account.TotalResourceCount())
@@ -123,6 +68,23 @@ func GetAllResources(c context.Context, query *Query, configObj config.Config, c
 	return &account, nil
 }
 
+// NewScanStarted builds the ScanStarted event that announces a scan of the query, for the renderers to display
+// its parameters.
+func NewScanStarted(query *Query) reporting.ScanStarted {
+	event := reporting.ScanStarted{
+		Regions:              query.Regions,
+		ResourceTypes:        query.ResourceTypes,
+		ListUnaliasedKMSKeys: query.ListUnaliasedKMSKeys,
+	}
+	if query.ExcludeAfter != nil && !query.ExcludeAfter.IsZero() {
+		event.ExcludeAfter = query.ExcludeAfter.Format("2006-01-02 15:04:05")
+	}
+	if query.IncludeAfter != nil && !query.IncludeAfter.IsZero() {
+		event.IncludeAfter = query.IncludeAfter.Format("2006-01-02 15:04:05")
+	}
+	return event
+}
+
 // ListResourceTypes - Returns list of resources which can be passed to --resource-type
 func ListResourceTypes() []string {
 	resourceTypes := []string{}
@@ -134,6 +96,17 @@ func ListResourceTypes() []string {
 	return resourceTypes
 }
 
+// ListResourceCategories - Returns the resource types of each category, which can also be passed to --resource-type
+func ListResourceCategories() map[string][]string {
+	return resource.GroupByCategory(append(globalResources(), regionalResources()...))
+}
+
+// ConfigSchema - Returns the config sections of the resource types, keyed by the names accepted by --resource-type,
+// see config.GetConfigWithSchema
+func ConfigSchema() config.Schema {
+	return resource.ConfigSchema(append(globalResources(), regionalResources()...))
+}
+
 // IsValidResourceType - Checks if a resourceType is valid or not
 func IsValidResourceType(resourceType string, allResourceTypes []string) bool {
 	return collections.ListContainsElement(allResourceTypes, resourceType)
@@ -141,112 +114,21 @@ func IsValidResourceType(resourceType string, allResourceTypes []string) bool {
 
 // IsNukeable - Checks if we should nuke a resource or not
 func IsNukeable(resourceType string, resourceTypes []string) bool {
-	if len(resourceTypes) == 0 ||
-		collections.ListContainsElement(resourceTypes, "all") ||
-		collections.ListContainsElement(resourceTypes, resourceType) {
-		return true
-	}
-	return false
+	return engine.IsNukeable(resourceType, resourceTypes, nil)
 }
 
-func nukeAllResourcesInRegion(ctx context.Context, account *AwsAccountResources, region string, collector *reporting.Collector) error {
-	var allErrors *multierror.Error
-	resourcesInRegion := account.Resources[region]
-
-	for _, awsResource := range resourcesInRegion.Resources {
-		length := len((*awsResource).ResourceIdentifiers())
-
-		// Split api calls into batches
-		logging.Debugf("Terminating %d awsResource in batches", length)
-		batches := util.Split((*awsResource).ResourceIdentifiers(), (*awsResource).MaxBatchSize())
-
-		for i, batch := range batches {
-			// Emit progress event (CLIRenderer updates its progress bar)
-			collector.Emit(reporting.NukeProgress{
-				ResourceType: (*awsResource).ResourceName(),
-				Region:       region,
-				BatchSize:    len(batch),
-			})
-
-			results, err := (*awsResource).Nuke(ctx, batch)
-
-			// Emit ResourceDeleted for each result
-			for _, result := range results {
-				errStr := ""
-				if result.Error != nil {
-					errStr = result.Error.Error()
-				}
-				collector.Emit(reporting.ResourceDeleted{
-					ResourceType: (*awsResource).ResourceName(),
-					Region:       region,
-					Identifier:   result.Identifier,
-					Success:      result.Error == nil,
-					Warning:      result.Error != nil && util.IsWarningError(result.Error),
-					Error:        errStr,
-				})
-			}
-
-			if err != nil {
-				// Handle rate limiting
-				if util.IsThrottlingError(err) {
-					logging.Debug(
-						"Request limit reached. Waiting 1 minute before making new requests",
-					)
-					time.Sleep(1 * time.Minute)
-					continue
-				}
-
-				allErrors = multierror.Append(allErrors, fmt.Errorf("[%s] %s: %w", region, (*awsResource).ResourceName(), err))
-
-				// Report to telemetry - aggregated metrics of failures per resources.
-				telemetry.TrackEvent(commonTelemetry.EventContext{
-					EventName: fmt.Sprintf("error:Nuke:%s", (*awsResource).ResourceName()),
-				}, map[string]interface{}{
-					"region": region,
-				})
-			}
-
-			if i != len(batches)-1 {
-				logging.Debug("Sleeping for 10 seconds before processing next batch...")
-				time.Sleep(10 * time.Second)
-			}
-		}
+// NukeAllResources - Nukes all aws resources in the regions targeted by the query, see engine.Nuke.
+// Up to query.ParallelRegions regions are nuked concurrently. Global resources are nuked on their own,
+// once all regional resources have been processed.
+//
+// When query.MaxPasses is greater than 1, identifiers that failed or warned are re-listed and retried in
+// further passes, until every deletion succeeds, a pass makes no progress, or MaxPasses is reached.
+//
+// When query.Verify is set, the deleted identifiers are listed again once all passes are done, and those still
+// present are reported as ResourceStillPresent events and as an engine.ResourcesStillPresentError.
+func NukeAllResources(ctx context.Context, account *AwsAccountResources, query *Query, collector reporting.Emitter) error {
+	if account.accountId != "" {
+		ctx = context.WithValue(ctx, util.AccountIdKey, account.accountId)
 	}
-
-	return allErrors.ErrorOrNil()
-}
-
-// NukeAllResources - Nukes all aws resources
-func NukeAllResources(ctx context.Context, account *AwsAccountResources, regions []string, collector *reporting.Collector) error {
-	// Emit NukeStarted event (CLIRenderer will initialize progress bar)
-	collector.Emit(reporting.NukeStarted{Total: account.TotalResourceCount()})
-
-	var allErrors *multierror.Error
-
-	telemetry.TrackEvent(commonTelemetry.EventContext{
-		EventName: "Begin nuking resources",
-	}, map[string]interface{}{})
-
-	for _, region := range regions {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Creating session for region",
-		}, map[string]interface{}{
-			"region": region,
-		})
-
-		if err := nukeAllResourcesInRegion(ctx, account, region, collector); err != nil {
-			allErrors = multierror.Append(allErrors, err)
-		}
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Done Nuking Region",
-		}, map[string]interface{}{
-			"region":        region,
-			"resourceCount": len(account.Resources[region].Resources),
-		})
-	}
-
-	// Emit NukeComplete event (triggers final output in renderers)
-	collector.Emit(reporting.NukeComplete{})
-
-	return allErrors.ErrorOrNil()
+	return engine.Nuke(ctx, provider{query: query}, account.engineResources(), query.engineSettings(), collector)
 }
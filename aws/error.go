@@ -26,6 +26,14 @@ func (err InvalidResourceTypesSuppliedError) Error() string {
 	return fmt.Sprintf("Invalid resourceTypes %s specified: %s", err.InvalidTypes, "Try --list-resource-types to get a list of valid resource types.")
 }
 
+type NoResourceTypesSelectedError struct {
+	Patterns []string
+}
+
+func (err NoResourceTypesSelectedError) Error() string {
+	return fmt.Sprintf("No resource types left to nuke after applying %s", err.Patterns)
+}
+
 type ResourceTypeAndExcludeFlagsBothPassedError struct{}
 
 func (err ResourceTypeAndExcludeFlagsBothPassedError) Error() string {
@@ -56,3 +64,107 @@ type ResourceInspectionError struct {
 func (err ResourceInspectionError) Error() string {
 	return fmt.Sprintf("Error encountered when querying for account resources. Original error: %v", err.Underlying)
 }
+
+type InvalidParallelRegionsError struct {
+	Value int
+}
+
+func (err InvalidParallelRegionsError) Error() string {
+	return fmt.Sprintf("Invalid number of parallel regions %d: must not be negative", err.Value)
+}
+
+type InvalidMaxPassesError struct {
+	Value int
+}
+
+func (err InvalidMaxPassesError) Error() string {
+	return fmt.Sprintf("Invalid number of nuke passes %d: must not be negative", err.Value)
+}
+
+type InvalidMaxDeleteError struct {
+	Value int
+}
+
+func (err InvalidMaxDeleteError) Error() string {
+	return fmt.Sprintf("Invalid max delete %d: must not be negative", err.Value)
+}
+
+type InvalidPlanError struct {
+	Path       string
+	Underlying error
+}
+
+func (err InvalidPlanError) Error() string {
+	return fmt.Sprintf("Unable to load the plan %s. Original error: %v", err.Path, err.Underlying)
+}
+
+type UnsupportedPlanVersionError struct {
+	Version int
+}
+
+func (err UnsupportedPlanVersionError) Error() string {
+	return fmt.Sprintf("Unsupported plan version %d: expected version %d. Create the plan again with inspect-aws --out-plan.", err.Version, PlanVersion)
+}
+
+type PlanAccountMismatchError struct {
+	PlanAccountID    string
+	CurrentAccountID string
+}
+
+func (err PlanAccountMismatchError) Error() string {
+	return fmt.Sprintf("The plan was created for account %s, but the current credentials are for account %s", err.PlanAccountID, err.CurrentAccountID)
+}
+
+type CouldNotVerifyPlanAccountError struct {
+	PlanAccountID string
+	Underlying    error
+}
+
+func (err CouldNotVerifyPlanAccountError) Error() string {
+	return fmt.Sprintf("Unable to verify that the current credentials are for account %s, which the plan was created for. Original error: %v", err.PlanAccountID, err.Underlying)
+}
+
+type InvalidAccountIdError struct {
+	Value string
+}
+
+func (err InvalidAccountIdError) Error() string {
+	return fmt.Sprintf("Invalid AWS account ID '%s': account IDs are 12 digits", err.Value)
+}
+
+type AccountNukeError struct {
+	AccountId  string
+	Underlying error
+}
+
+func (err AccountNukeError) Error() string {
+	return fmt.Sprintf("Error encountered in account %s. Original error: %v", err.AccountId, err.Underlying)
+}
+
+func (err AccountNukeError) Unwrap() error {
+	return err.Underlying
+}
+
+type AccountNotAllowedError struct {
+	AccountId string
+	Blocked   bool
+}
+
+func (err AccountNotAllowedError) Error() string {
+	if err.Blocked {
+		return fmt.Sprintf("Refusing to run against account %s: it is blocked by the accounts section of the config", err.AccountId)
+	}
+	return fmt.Sprintf("Refusing to run against account %s: it is not allowed by the accounts section of the config", err.AccountId)
+}
+
+type UnknownAccountError struct {
+	Underlying error
+}
+
+func (err UnknownAccountError) Error() string {
+	return fmt.Sprintf("Refusing to run: unable to determine the current account, which the accounts section of the config restricts. Original error: %v", err.Underlying)
+}
+
+func (err UnknownAccountError) Unwrap() error {
+	return err.Underlying
+}
@@ -1,13 +1,15 @@
 package aws
 
 import (
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/go-commons/collections"
 )
 
+// ensureValidResourceTypes rejects the resource types that are not exact names of registered resource types.
 func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
 	invalidresourceTypes := []string{}
 	for _, resourceType := range resourceTypes {
-		if resourceType == "all" {
+		if resourceType == resource.SelectAll {
 			continue
 		}
 		if !IsValidResourceType(resourceType, ListResourceTypes()) {
@@ -22,8 +24,21 @@ func ensureValidResourceTypes(resourceTypes []string) ([]string, error) {
 	return resourceTypes, nil
 }
 
-// HandleResourceTypeSelections accepts a slice of target resourceTypes and a slice of resourceTypes to exclude. It filters
-// any excluded or invalid types from target resourceTypes then returns the filtered slice
+// expandResourceTypes returns the resource types selected by the patterns (names, globs such as "ec2-*",
+// categories such as "network", and their "!" negations), see resource.ExpandSelection.
+func expandResourceTypes(patterns []string) ([]string, error) {
+	resourceTypes, unmatched := resource.ExpandSelection(ListResourceTypes(), patterns, ListResourceCategories())
+	if len(unmatched) > 0 {
+		return []string{}, InvalidResourceTypesSuppliedError{InvalidTypes: unmatched}
+	}
+
+	return resourceTypes, nil
+}
+
+// HandleResourceTypeSelections accepts a slice of target resourceTypes and a slice of resourceTypes to exclude. Both
+// can hold resource type names, globs, categories and negations, see resource.ExpandSelection. It expands them,
+// filters any excluded types from target resourceTypes, rejects patterns that select nothing, then returns the
+// filtered slice
 func HandleResourceTypeSelections(
 	includeResourceTypes, excludeResourceTypes []string,
 ) ([]string, error) {
@@ -32,12 +47,20 @@ func HandleResourceTypeSelections(
 	}
 
 	if len(includeResourceTypes) > 0 {
-		return ensureValidResourceTypes(includeResourceTypes)
+		resourceTypes, err := expandResourceTypes(includeResourceTypes)
+		if err != nil {
+			return []string{}, err
+		}
+		// An empty list of resource types selects every resource type
+		if len(resourceTypes) == 0 {
+			return []string{}, NoResourceTypesSelectedError{Patterns: includeResourceTypes}
+		}
+		return resourceTypes, nil
 	}
 
 	// Handle exclude resource types by going through the list of all types and only include those that are not
 	// mentioned in the exclude list.
-	validExcludeResourceTypes, err := ensureValidResourceTypes(excludeResourceTypes)
+	validExcludeResourceTypes, err := expandResourceTypes(excludeResourceTypes)
 	if err != nil {
 		return []string{}, err
 	}
@@ -48,5 +71,8 @@ func HandleResourceTypeSelections(
 			resourceTypes = append(resourceTypes, resourceType)
 		}
 	}
+	if len(resourceTypes) == 0 {
+		return []string{}, NoResourceTypesSelectedError{Patterns: excludeResourceTypes}
+	}
 	return resourceTypes, nil
 }
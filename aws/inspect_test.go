@@ -38,6 +38,22 @@ func TestHandleResourceTypeSelectionsRejectsInvalid(t *testing.T) {
 
 }
 
+func TestHandleResourceTypeSelectionsExcludesPatterns(t *testing.T) {
+	got, err := HandleResourceTypeSelections(nil, []string{"network", "ec2*"})
+	require.NoError(t, err)
+	require.NotEmpty(t, got)
+	for _, resourceType := range got {
+		require.NotContains(t, []string{"vpc", "ec2", "ec2-keypairs", "security-group"}, resourceType)
+	}
+	require.Contains(t, got, "s3")
+
+	var noneSelected NoResourceTypesSelectedError
+	_, err = HandleResourceTypeSelections(nil, []string{"*"})
+	require.ErrorAs(t, err, &noneSelected)
+	_, err = HandleResourceTypeSelections([]string{"s3", "!s3"}, nil)
+	require.ErrorAs(t, err, &noneSelected)
+}
+
 func TestHandleResourceTypeSelectionsRejectsConflictingParams(t *testing.T) {
 	type TestCase struct {
 		Name                 string
@@ -80,6 +96,15 @@ func TestHandleResourceTypeSelectionsFiltering(t *testing.T) {
 		ResourceTypes:        []string{"ec2", "vpc"},
 		ExcludeResourceTypes: []string{},
 		Want:                 []string{"ec2", "vpc"},
+	}, {
+		Name:                 "Globs, categories and negations are expanded",
+		ResourceTypes:        []string{"sagemaker-*", "!sagemaker-studio", "iam"},
+		ExcludeResourceTypes: []string{},
+		Want: []string{
+			"access-analyzer", "acmpca", "iam-group", "iam-instance-profile", "iam-policy", "iam-role",
+			"iam-service-linked-role", "iam-user", "kms-customer-key", "oidc-provider", "resource-share",
+			"sagemaker-endpoint", "sagemaker-endpoint-config", "sagemaker-notebook-instance", "secrets-manager",
+		},
 	},
 	}
 	for _, tc := range testCases {
@@ -0,0 +1,34 @@
+package aws
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/engine"
+)
+
+// EnforceDeleteLimits checks the identifiers to nuke against query.MaxDelete and the max_delete of each resource
+// type in the config. When a limit is exceeded, a resource.DeleteLimitExceededError is returned, unless
+// query.TrimToMaxDelete is set, in which case only the identifiers within the limits are kept as targets and the
+// rest are skipped with a warning. See engine.EnforceDeleteLimits.
+func EnforceDeleteLimits(account *AwsAccountResources, query *Query) error {
+	found := account.engineResources()
+	if err := engine.EnforceDeleteLimits(found, query.engineSettings()); err != nil {
+		return err
+	}
+	account.targets = found.Targets
+	return nil
+}
+
+// EnforceTotalDeleteLimit checks the identifiers to nuke across the accounts of a multi-account run against
+// query.MaxDelete, once EnforceDeleteLimits was enforced in each of them. See engine.EnforceTotalDeleteLimit.
+func EnforceTotalDeleteLimit(accounts []*AwsAccountResources, query *Query) error {
+	found := make([]*engine.Resources, len(accounts))
+	for i, account := range accounts {
+		found[i] = account.engineResources()
+	}
+	if err := engine.EnforceTotalDeleteLimit(found, query.engineSettings()); err != nil {
+		return err
+	}
+	for i, account := range accounts {
+		account.targets = found[i].Targets
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package aws
+
+import (
+	"testing"
+
+	awsgo "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEC2ConfiguredResource returns a resource configured by the EC2 section of the config, listing the given ids.
+func newEC2ConfiguredResource(t *testing.T, ids ...string) *resources.AwsResource {
+	fake := resourcetest.NewFake("limited", ids...)
+	fake.ConfigKey = "EC2"
+	return newListedAwsResource(t, fake)
+}
+
+func newLimitedAccount(t *testing.T, maxDelete *int) *AwsAccountResources {
+	configObj := config.Config{}
+	configObj.EC2.MaxDelete = maxDelete
+	return &AwsAccountResources{
+		Resources: map[string]AwsResources{
+			"us-east-1": {Resources: []*resources.AwsResource{newEC2ConfiguredResource(t, "a", "b")}},
+			"us-west-2": {Resources: []*resources.AwsResource{newEC2ConfiguredResource(t, "c")}},
+		},
+		configObj: configObj,
+	}
+}
+
+func TestEnforceDeleteLimits_WithinLimits(t *testing.T) {
+	account := newLimitedAccount(t, awsgo.Int(3))
+
+	require.NoError(t, EnforceDeleteLimits(account, &Query{MaxDelete: 3}))
+	assert.Nil(t, account.targets)
+	assert.Equal(t, 3, account.TotalResourceCount())
+}
+
+func TestEnforceDeleteLimits_AbortsWhenTotalExceeded(t *testing.T) {
+	account := newLimitedAccount(t, nil)
+
+	var limitErr resource.DeleteLimitExceededError
+	require.ErrorAs(t, EnforceDeleteLimits(account, &Query{MaxDelete: 2}), &limitErr)
+	assert.Equal(t, resource.DeleteLimitExceededError{Count: 3, Limit: 2}, limitErr)
+}
+
+func TestEnforceDeleteLimits_AbortsWhenResourceTypeExceeded(t *testing.T) {
+	account := newLimitedAccount(t, awsgo.Int(2))
+
+	var limitErr resource.DeleteLimitExceededError
+	require.ErrorAs(t, EnforceDeleteLimits(account, &Query{}), &limitErr)
+	assert.Equal(t, resource.DeleteLimitExceededError{ResourceType: "limited", Count: 3, Limit: 2}, limitErr)
+}
+
+func TestEnforceDeleteLimits_Trims(t *testing.T) {
+	account := newLimitedAccount(t, awsgo.Int(2))
+
+	require.NoError(t, EnforceDeleteLimits(account, &Query{TrimToMaxDelete: true}))
+	assert.Equal(t, engine.Targets{"us-east-1": {"limited": {"a", "b"}}}, account.targets)
+	assert.Equal(t, 2, account.TotalResourceCount())
+}
+
+func TestEnforceDeleteLimits_TrimsPlannedTargets(t *testing.T) {
+	account := newLimitedAccount(t, nil)
+	account.targets = engine.Targets{"us-east-1": {"limited": {"b"}}, "us-west-2": {"limited": {"c"}}}
+
+	require.NoError(t, EnforceDeleteLimits(account, &Query{MaxDelete: 1, TrimToMaxDelete: true}))
+	assert.Equal(t, engine.Targets{"us-east-1": {"limited": {"b"}}}, account.targets)
+}
+
+func TestEnforceTotalDeleteLimit_AbortsWhenTotalExceeded(t *testing.T) {
+	accounts := []*AwsAccountResources{newLimitedAccount(t, nil), newLimitedAccount(t, nil)}
+	for _, account := range accounts {
+		require.NoError(t, EnforceDeleteLimits(account, &Query{MaxDelete: 5}))
+	}
+
+	var limitErr resource.DeleteLimitExceededError
+	require.ErrorAs(t, EnforceTotalDeleteLimit(accounts, &Query{MaxDelete: 5}), &limitErr)
+	assert.Equal(t, resource.DeleteLimitExceededError{Count: 6, Limit: 5}, limitErr)
+}
+
+func TestEnforceTotalDeleteLimit_Trims(t *testing.T) {
+	accounts := []*AwsAccountResources{newLimitedAccount(t, nil), newLimitedAccount(t, nil), newLimitedAccount(t, nil)}
+
+	require.NoError(t, EnforceTotalDeleteLimit(accounts, &Query{MaxDelete: 4, TrimToMaxDelete: true}))
+	assert.Nil(t, accounts[0].targets)
+	assert.Equal(t, engine.Targets{"us-east-1": {"limited": {"a"}}}, accounts[1].targets)
+	assert.Equal(t, engine.Targets{}, accounts[2].targets)
+	assert.Equal(t, 0, accounts[2].TotalResourceCount())
+}
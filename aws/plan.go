@@ -0,0 +1,284 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/collections"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// PlanVersion is the version of the plan file format written by WritePlan.
+const PlanVersion = 1
+
+// PlanMissingReason is the reason reported for planned identifiers that no longer exist, or that are protected since
+// the plan was saved, when the plan is applied.
+const PlanMissingReason = "no longer present or protected, refusing to nuke"
+
+// Plan is the set of resources found by an inspection, saved so that exactly those resources can be nuked later.
+type Plan struct {
+	Version   int               `json:"version"`
+	AccountID string            `json:"account_id,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Resources []PlannedResource `json:"resources"`
+}
+
+// PlannedResource lists the identifiers of one resource type in one region.
+type PlannedResource struct {
+	ResourceType string   `json:"resource_type"`
+	Region       string   `json:"region"`
+	Identifiers  []string `json:"identifiers"`
+}
+
+// NewPlan builds a plan from the nukable resources found in an account.
+func NewPlan(account *AwsAccountResources) *Plan {
+	plan := &Plan{
+		Version:   PlanVersion,
+		AccountID: account.accountId,
+		CreatedAt: time.Now().UTC(),
+		Resources: []PlannedResource{},
+	}
+
+	regions := make([]string, 0, len(account.Resources))
+	for region := range account.Resources {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	for _, region := range regions {
+		for _, awsResource := range account.Resources[region].Resources {
+			var identifiers []string
+			for _, id := range (*awsResource).ResourceIdentifiers() {
+				if nukable, _ := (*awsResource).IsNukable(id); nukable {
+					identifiers = append(identifiers, id)
+				}
+			}
+			if len(identifiers) == 0 {
+				continue
+			}
+			plan.Resources = append(plan.Resources, PlannedResource{
+				ResourceType: (*awsResource).ResourceName(),
+				Region:       region,
+				Identifiers:  identifiers,
+			})
+		}
+	}
+	return plan
+}
+
+// Regions returns the regions targeted by the plan, in the order they first appear.
+func (p *Plan) Regions() []string {
+	var regions []string
+	for _, planned := range p.Resources {
+		if !collections.ListContainsElement(regions, planned.Region) {
+			regions = append(regions, planned.Region)
+		}
+	}
+	return regions
+}
+
+// ResourceTypes returns the resource types targeted by the plan, in the order they first appear.
+func (p *Plan) ResourceTypes() []string {
+	var resourceTypes []string
+	for _, planned := range p.Resources {
+		if !collections.ListContainsElement(resourceTypes, planned.ResourceType) {
+			resourceTypes = append(resourceTypes, planned.ResourceType)
+		}
+	}
+	return resourceTypes
+}
+
+// Validate checks that the plan was written by a compatible version and only targets known resource types.
+func (p *Plan) Validate() error {
+	if p.Version != PlanVersion {
+		return UnsupportedPlanVersionError{Version: p.Version}
+	}
+	_, err := ensureValidResourceTypes(p.ResourceTypes())
+	return err
+}
+
+// WritePlan saves the plan as JSON to the given path.
+func WritePlan(path string, plan *Plan) error {
+	data, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// ReadPlan loads and validates a plan saved by WritePlan.
+func ReadPlan(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStackTrace(InvalidPlanError{Path: path, Underlying: err})
+	}
+	var plan Plan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, errors.WithStackTrace(InvalidPlanError{Path: path, Underlying: err})
+	}
+	if err := plan.Validate(); err != nil {
+		return nil, errors.WithStackTrace(InvalidPlanError{Path: path, Underlying: err})
+	}
+	return &plan, nil
+}
+
+// GetPlannedResources lists the resource types of a plan again, without any filters, and returns the planned
+// identifiers that still exist. Planned identifiers that no longer exist are reported as not nukable, and are
+// never nuked. Only the hooks and the protection tags of configObj apply to the planned resources. When checkAccount is set, the plan must
+// have been created for the current account.
+func GetPlannedResources(c context.Context, plan *Plan, query *Query, configObj config.Config, checkAccount bool,
+	collector *reporting.Collector) (*AwsAccountResources, error) {
+	// Plans are applied without filters, so everything that could have been planned is listed, unless it has been
+	// protected since
+	configObj = engine.UnfilteredConfig(configObj, query.Timeout)
+
+	account := AwsAccountResources{
+		Resources: make(map[string]AwsResources),
+		configObj: configObj,
+		targets:   make(engine.Targets),
+	}
+
+	regions := plan.Regions()
+	if len(regions) == 0 {
+		return &account, nil
+	}
+
+	accountId, err := GetCurrentAccountId(regions[0])
+	if err != nil && checkAccount && plan.AccountID != "" {
+		return nil, errors.WithStackTrace(CouldNotVerifyPlanAccountError{PlanAccountID: plan.AccountID, Underlying: err})
+	}
+	if err == nil && checkAccount && plan.AccountID != "" && accountId != plan.AccountID {
+		return nil, errors.WithStackTrace(PlanAccountMismatchError{PlanAccountID: plan.AccountID, CurrentAccountID: accountId})
+	}
+	account.accountId = accountId
+
+	c = context.WithValue(c, util.ExcludeFirstSeenTagKey, query.ExcludeFirstSeen)
+	if accountId != "" {
+		c = context.WithValue(c, util.AccountIdKey, accountId)
+	}
+
+	planned := make(engine.Targets)
+	for _, p := range plan.Resources {
+		for _, id := range p.Identifiers {
+			planned.Add(p.Region, p.ResourceType, id)
+		}
+	}
+
+	// Regions are listed concurrently, and their events are flushed in the order of the plan
+	var mu sync.Mutex
+	var sessionErr error
+	regionEvents := make(map[string]*reporting.EventBuffer, len(regions))
+
+	listRegion := func(region string) {
+		session, err := NewSession(region)
+		if err != nil {
+			mu.Lock()
+			if sessionErr == nil {
+				sessionErr = err
+			}
+			mu.Unlock()
+			return
+		}
+
+		events := &reporting.EventBuffer{}
+		registered := GetAndInitRegisteredResources(session, region)
+		found, targets := getPlannedResourcesInRegion(c, registered, region, planned[region], configObj, collector, events)
+
+		mu.Lock()
+		regionEvents[region] = events
+		if len(found.Resources) > 0 {
+			account.Resources[region] = found
+			account.targets[region] = targets
+		}
+		mu.Unlock()
+	}
+
+	regional, listGlobal := engine.SplitGlobalScope(regions)
+	util.ForEachConcurrently(regional, query.ParallelRegions, listRegion)
+	if listGlobal && sessionErr == nil {
+		listRegion(GlobalRegion)
+	}
+	if sessionErr != nil {
+		return nil, sessionErr
+	}
+
+	for _, region := range regions {
+		if events, ok := regionEvents[region]; ok {
+			events.FlushTo(collector)
+		}
+	}
+
+	logging.Infof("Found %d of %d planned resources", account.targets.Count(), planned.Count())
+	return &account, nil
+}
+
+// getPlannedResourcesInRegion lists the planned resource types among the registered resources of a single region,
+// and returns the resources that still have planned identifiers along with those identifiers.
+func getPlannedResourcesInRegion(c context.Context, registered []*resources.AwsResource, region string,
+	planned map[string][]string, configObj config.Config, collector *reporting.Collector,
+	events reporting.Emitter) (AwsResources, map[string][]string) {
+	found := AwsResources{}
+	targets := make(map[string][]string)
+
+	for _, awsResource := range registered {
+		resourceName := (*awsResource).ResourceName()
+		identifiers, ok := planned[resourceName]
+		if !ok || c.Err() != nil {
+			continue
+		}
+
+		collector.Emit(reporting.ScanProgress{ResourceType: resourceName, Region: region})
+
+		current, err := (*awsResource).GetAndSetIdentifiers(c, configObj)
+		if err != nil {
+			logging.Errorf("Unable to retrieve %v, %v", resourceName, err)
+			events.Emit(reporting.GeneralError{
+				ResourceType: resourceName,
+				Description:  "Unable to retrieve " + resourceName + ", refusing to nuke its planned identifiers",
+				Error:        err.Error(),
+			})
+			continue
+		}
+
+		for _, id := range identifiers {
+			found := engine.NewResourceFound(*awsResource, region, id)
+			if !collections.ListContainsElement(current, id) {
+				found.Nukable, found.Reason = false, PlanMissingReason
+			}
+
+			if found.Nukable {
+				targets[resourceName] = append(targets[resourceName], id)
+			} else {
+				logging.Warnf("Not nuking planned %s %s in %s: %s", resourceName, id, region, found.Reason)
+			}
+			events.Emit(found)
+		}
+
+		if len(targets[resourceName]) > 0 {
+			found.Resources = append(found.Resources, awsResource)
+		}
+	}
+	return found, targets
+}
+
+// GetCurrentAccountId returns the ID of the account the credentials belong to.
+func GetCurrentAccountId(region string) (string, error) {
+	session, err := NewSession(region)
+	if err != nil {
+		return "", err
+	}
+	return util.GetCurrentAccountId(session)
+}
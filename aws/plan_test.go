@@ -0,0 +1,167 @@
+package aws
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newListedAwsResource returns the fake resource type as an AWS resource, listed with an empty config.
+func newListedAwsResource(t *testing.T, fake *resourcetest.Fake) *resources.AwsResource {
+	res := resources.NewAwsResource(fake.Listed(t, config.Config{}))
+	return &res
+}
+
+func TestNewPlan(t *testing.T) {
+	account := &AwsAccountResources{
+		Resources: map[string]AwsResources{
+			"us-west-2": {Resources: []*resources.AwsResource{newListedAwsResource(t, resourcetest.NewFake("flaky", "b"))}},
+			"us-east-1": {Resources: []*resources.AwsResource{newListedAwsResource(t, resourcetest.NewFake("flaky", "a"))}},
+		},
+		accountId: "123456789012",
+	}
+
+	plan := NewPlan(account)
+
+	assert.Equal(t, PlanVersion, plan.Version)
+	assert.Equal(t, "123456789012", plan.AccountID)
+	assert.Equal(t, []PlannedResource{
+		{ResourceType: "flaky", Region: "us-east-1", Identifiers: []string{"a"}},
+		{ResourceType: "flaky", Region: "us-west-2", Identifiers: []string{"b"}},
+	}, plan.Resources)
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, plan.Regions())
+	assert.Equal(t, []string{"flaky"}, plan.ResourceTypes())
+}
+
+func TestWriteAndReadPlan(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.json")
+	plan := &Plan{
+		Version:   PlanVersion,
+		AccountID: "123456789012",
+		Resources: []PlannedResource{
+			{ResourceType: "ec2", Region: "us-east-1", Identifiers: []string{"i-123"}},
+		},
+	}
+
+	require.NoError(t, WritePlan(path, plan))
+	loaded, err := ReadPlan(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, plan.AccountID, loaded.AccountID)
+	assert.Equal(t, plan.Resources, loaded.Resources)
+}
+
+func TestReadPlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{name: "malformed", content: "{"},
+		{name: "unsupported version", content: `{"version": 99, "resources": []}`},
+		{name: "unknown resource type", content: `{"version": 1, "resources": [{"resource_type": "not-a-resource", "region": "us-east-1", "identifiers": ["x"]}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "plan.json")
+			require.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			_, err := ReadPlan(path)
+			var planErr InvalidPlanError
+			require.ErrorAs(t, err, &planErr)
+		})
+	}
+
+	_, err := ReadPlan(filepath.Join(t.TempDir(), "missing.json"))
+	require.ErrorAs(t, err, &InvalidPlanError{})
+}
+
+func TestNukeAllResources_OnlyNukesTargets(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+	res := newListedAwsResource(t, resourcetest.NewFake("flaky", "planned", "unplanned"))
+
+	account := &AwsAccountResources{
+		Resources: map[string]AwsResources{
+			"us-east-1": {Resources: []*resources.AwsResource{res}},
+		},
+		targets: engine.Targets{"us-east-1": {"flaky": {"planned"}}},
+	}
+	collector, renderer := resourcetest.NewCollector()
+
+	err := NukeAllResources(context.Background(), account, &Query{Regions: []string{"us-east-1"}}, collector)
+	require.NoError(t, err)
+
+	assert.Equal(t, reporting.NukeStarted{Total: 1}, renderer.Events()[0])
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "planned", deleted[0].Identifier)
+}
+
+func TestNukeAllResources_PlanRunsHooks(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+	res := newListedAwsResource(t, resourcetest.NewFake("flaky", "planned", "vetoed"))
+
+	configObj := config.Config{
+		Hooks: config.Hooks{PreDelete: []config.Hook{{
+			Command: []string{"sh", "-c", `cat > /dev/null; echo '{"veto": ["vetoed"]}'`},
+		}}},
+		S3: config.ResourceType{ExcludeRule: config.FilterRule{NamesRegExp: []config.Expression{{RE: *regexp.MustCompile(".*")}}}},
+	}
+	account := &AwsAccountResources{
+		Resources: map[string]AwsResources{
+			"us-east-1": {Resources: []*resources.AwsResource{res}},
+		},
+		configObj: engine.UnfilteredConfig(configObj, nil),
+		targets:   engine.Targets{"us-east-1": {"flaky": {"planned", "vetoed"}}},
+	}
+	collector, renderer := resourcetest.NewCollector()
+
+	_ = NukeAllResources(context.Background(), account, &Query{Regions: []string{"us-east-1"}}, collector)
+
+	assert.Empty(t, account.configObj.S3.ExcludeRule.NamesRegExp)
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, deleted, 2)
+	for _, event := range deleted {
+		switch event.Identifier {
+		case "planned":
+			assert.True(t, event.Success)
+		case "vetoed":
+			assert.False(t, event.Success)
+			assert.Contains(t, event.Error, "vetoed")
+		}
+	}
+}
+
+func TestGetPlannedResourcesInRegion_SkipsProtectedResources(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	// b and c are protected after the plan was saved, by a protection tag and by the default exclusion tag
+	fake := resourcetest.NewFake("widget", "a", "b", "c")
+	fake.Tags = map[string]map[string]string{
+		"b": {"do-not-delete": "yes"},
+		"c": {config.DefaultAwsResourceExclusionTagKey: "true"},
+	}
+	res := resources.NewAwsResource(fake.Resource())
+
+	configObj := config.Config{}
+	configObj.AddProtectionTags([]config.ProtectionTag{{Key: "do-not-delete"}})
+	planned := map[string][]string{"widget": {"a", "b", "c"}}
+	collector, _ := resourcetest.NewCollector()
+	events := &reporting.EventBuffer{}
+
+	found, targets := getPlannedResourcesInRegion(context.Background(), []*resources.AwsResource{&res}, "us-east-1",
+		planned, engine.UnfilteredConfig(configObj, nil), collector, events)
+
+	assert.Len(t, found.Resources, 1)
+	assert.Equal(t, map[string][]string{"widget": {"a"}}, targets)
+}
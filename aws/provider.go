@@ -0,0 +1,90 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+)
+
+// provider lets the engine scan and nuke the regions of an AWS account targeted by a query.
+type provider struct {
+	query *Query
+}
+
+func (p provider) Name() string {
+	return "aws"
+}
+
+func (p provider) Scopes() []string {
+	return p.query.Regions
+}
+
+// Resources returns the registered resources of a region, initialized with a session for that region.
+func (p provider) Resources(ctx context.Context, region string) ([]resource.NukeableResource, error) {
+	session, err := NewSession(region)
+	if err != nil {
+		return nil, err
+	}
+
+	var registered []resource.NukeableResource
+	for _, awsResource := range GetAndInitRegisteredResources(session, region) {
+		registered = append(registered, *awsResource)
+	}
+	return registered, nil
+}
+
+// Dependencies returns the transitive dependencies of the resource types registered in a region, see
+// engine.Provider.
+func (p provider) Dependencies(region string) map[string][]string {
+	registered := regionalResources()
+	if region == GlobalRegion {
+		registered = globalResources()
+	}
+	return resource.TransitiveDependencies(registered, resources.AwsResource.ResourceName, resources.AwsResource.Dependencies)
+}
+
+// engineSettings returns the settings of the query that the engine applies.
+func (q *Query) engineSettings() engine.Settings {
+	return engine.Settings{
+		ResourceTypes:           q.ResourceTypes,
+		ExcludeResourceTypes:    q.ExcludeResourceTypes,
+		ExcludeFirstSeen:        q.ExcludeFirstSeen,
+		Timeout:                 q.Timeout,
+		ParallelScopes:          q.ParallelRegions,
+		MaxPasses:               q.MaxPasses,
+		Journal:                 q.Journal,
+		Verify:                  q.Verify,
+		VerifyGracePeriod:       q.VerifyGracePeriod,
+		MaxDelete:               q.MaxDelete,
+		TrimToMaxDelete:         q.TrimToMaxDelete,
+		CircuitBreakerThreshold: q.CircuitBreakerThreshold,
+	}
+}
+
+// engineResources returns the resources of the account as the engine nukes them.
+func (a *AwsAccountResources) engineResources() *engine.Resources {
+	found := &engine.Resources{
+		ByScope:          make(map[string][]resource.NukeableResource, len(a.Resources)),
+		Config:           a.configObj,
+		Targets:          a.targets,
+		IncompleteScopes: a.incompleteRegions,
+	}
+	for region, regionResources := range a.Resources {
+		for _, awsResource := range regionResources.Resources {
+			found.ByScope[region] = append(found.ByScope[region], *awsResource)
+		}
+	}
+	return found
+}
+
+// toAwsResources converts resources found by the engine back to the AWS resources they were initialized from.
+func toAwsResources(found []resource.NukeableResource) AwsResources {
+	awsResources := AwsResources{}
+	for _, res := range found {
+		awsResource := res.(resources.AwsResource)
+		awsResources.Resources = append(awsResources.Resources, &awsResource)
+	}
+	return awsResources
+}
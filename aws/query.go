@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/journal"
 )
 
 // Query is a struct that represents the desired parameters for scanning resources within a given account
@@ -19,11 +20,50 @@ type Query struct {
 	ExcludeFirstSeen     bool
 	DefaultOnly          bool
 	IncludeTags          map[string]config.Expression
+	// ParallelRegions is the maximum number of regions scanned or nuked concurrently.
+	// Values of 1 or less process regions sequentially.
+	ParallelRegions int
+	// MaxPasses is the maximum number of nuke passes. Identifiers that failed or warned in a pass are
+	// retried in the next one. Values of 1 or less nuke in a single pass.
+	MaxPasses int
+	// Journal records deletion results and completed regions. When resuming from a journal, completed regions
+	// are not scanned and identifiers that were already nuked are skipped. Nil disables journaling.
+	Journal *journal.Journal
+	// Verify lists the nuked resource types again once the nuke is complete, and reports the deleted identifiers
+	// that are still present.
+	Verify bool
+	// VerifyGracePeriod is how long the deleted identifiers that are still present are listed again before being
+	// reported, for asynchronous deletions to complete. Zero lists them once.
+	VerifyGracePeriod time.Duration
+	// MaxDelete caps the number of identifiers nuked in a run. Zero means no limit. Resource types can also be
+	// capped with max_delete in the config. See EnforceDeleteLimits.
+	MaxDelete int
+	// TrimToMaxDelete nukes identifiers up to the delete limits and skips the rest, instead of refusing to nuke.
+	TrimToMaxDelete bool
+	// CircuitBreakerThreshold is the number of consecutive failures of the same class after which the remaining
+	// identifiers of a resource type in a region are skipped. Values of 0 or less disable the circuit breakers.
+	CircuitBreakerThreshold int
 }
 
 // Validate ensures the configured values for a Query are valid, returning an error if there are
 // any invalid params, or nil if the Query is valid
 func (q *Query) Validate() error {
+	if err := ValidateResourceDependencies(); err != nil {
+		return err
+	}
+
+	if q.ParallelRegions < 0 {
+		return InvalidParallelRegionsError{Value: q.ParallelRegions}
+	}
+
+	if q.MaxPasses < 0 {
+		return InvalidMaxPassesError{Value: q.MaxPasses}
+	}
+
+	if q.MaxDelete < 0 {
+		return InvalidMaxDeleteError{Value: q.MaxDelete}
+	}
+
 	resourceTypes, err := HandleResourceTypeSelections(q.ResourceTypes, q.ExcludeResourceTypes)
 	if err != nil {
 		return err
@@ -85,3 +85,21 @@ func TestQueryValidateAcceptsValidExcludeAfterEntries(t *testing.T) {
 		})
 	}
 }
+
+func TestQuery_EngineSettings(t *testing.T) {
+	timeout := 5 * time.Minute
+	q := &Query{
+		Timeout: &timeout, ParallelRegions: 2, MaxPasses: 3, Verify: true, VerifyGracePeriod: time.Minute,
+		MaxDelete: 10, TrimToMaxDelete: true, CircuitBreakerThreshold: 4,
+	}
+
+	settings := q.engineSettings()
+	assert.Equal(t, &timeout, settings.Timeout)
+	assert.Equal(t, 2, settings.ParallelScopes)
+	assert.Equal(t, 3, settings.MaxPasses)
+	assert.True(t, settings.Verify)
+	assert.Equal(t, time.Minute, settings.VerifyGracePeriod)
+	assert.Equal(t, 10, settings.MaxDelete)
+	assert.True(t, settings.TrimToMaxDelete)
+	assert.Equal(t, 4, settings.CircuitBreakerThreshold)
+}
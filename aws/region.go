@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/gruntwork-io/cloud-nuke/externalcreds"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/go-commons/collections"
 	"github.com/gruntwork-io/go-commons/errors"
 )
@@ -104,7 +105,8 @@ func GetEnabledRegions() ([]string, error) {
 }
 
 // GetTargetRegions - Used enabled, selected and excluded regions to create a
-// final list of valid regions
+// final list of valid regions. Selected and excluded regions can be globs such as
+// "eu-*", and negations such as "!us-east-1", see resource.ExpandSelection.
 func GetTargetRegions(enabledRegions []string, selectedRegions []string, excludedRegions []string) ([]string, error) {
 	if len(enabledRegions) == 0 {
 		return nil, fmt.Errorf("Cannot have empty enabled regions")
@@ -119,39 +121,29 @@ func GetTargetRegions(enabledRegions []string, selectedRegions []string, exclude
 		return nil, fmt.Errorf("Cannot specify both selected and excluded regions")
 	}
 
-	var invalidRegions []string
-
-	// Validate selectedRegions
-	for _, selectedRegion := range selectedRegions {
-		if !collections.ListContainsElement(enabledRegions, selectedRegion) {
-			invalidRegions = append(invalidRegions, selectedRegion)
-		}
-	}
-	if len(invalidRegions) > 0 {
-		return nil, fmt.Errorf("Invalid values for region: [%s]", invalidRegions)
-	}
-
 	if len(selectedRegions) > 0 {
-		return selectedRegions, nil
+		// Validate selectedRegions
+		targetRegions, invalidRegions := resource.ExpandSelection(enabledRegions, selectedRegions, nil)
+		if len(invalidRegions) > 0 {
+			return nil, fmt.Errorf("Invalid values for region: [%s]", invalidRegions)
+		}
+		if len(targetRegions) == 0 {
+			return nil, fmt.Errorf("No regions selected by: %s", selectedRegions)
+		}
+		return targetRegions, nil
 	}
 
 	// Validate excludedRegions
-	for _, excludedRegion := range excludedRegions {
-		if !collections.ListContainsElement(enabledRegions, excludedRegion) {
-			invalidRegions = append(invalidRegions, excludedRegion)
-		}
-	}
+	expandedExcludedRegions, invalidRegions := resource.ExpandSelection(enabledRegions, excludedRegions, nil)
 	if len(invalidRegions) > 0 {
 		return nil, fmt.Errorf("Invalid values for exclude-region: [%s]", invalidRegions)
 	}
 
 	// Filter out excludedRegions from enabledRegions
 	var targetRegions []string
-	if len(excludedRegions) > 0 {
-		for _, region := range enabledRegions {
-			if !collections.ListContainsElement(excludedRegions, region) {
-				targetRegions = append(targetRegions, region)
-			}
+	for _, region := range enabledRegions {
+		if !collections.ListContainsElement(expandedExcludedRegions, region) {
+			targetRegions = append(targetRegions, region)
 		}
 	}
 	if len(targetRegions) == 0 {
@@ -24,3 +24,24 @@ func TestGlobalRegion(t *testing.T) {
 	})
 
 }
+
+func TestGetTargetRegionsPatterns(t *testing.T) {
+	enabledRegions := []string{"eu-west-1", "eu-west-2", "us-east-1", "us-west-2", GlobalRegion}
+
+	regions, err := GetTargetRegions(enabledRegions, []string{"eu-*"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu-west-1", "eu-west-2"}, regions)
+
+	regions, err = GetTargetRegions(enabledRegions, []string{"!us-east-1"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"eu-west-1", "eu-west-2", "us-west-2", GlobalRegion}, regions)
+
+	regions, err = GetTargetRegions(enabledRegions, nil, []string{"eu-*", GlobalRegion})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, regions)
+
+	_, err = GetTargetRegions(enabledRegions, []string{"ap-*"}, nil)
+	assert.Error(t, err)
+	_, err = GetTargetRegions(enabledRegions, []string{"eu-*", "!eu-*"}, nil)
+	assert.Error(t, err)
+}
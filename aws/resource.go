@@ -4,6 +4,8 @@ import (
 	"strings"
 
 	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
 )
 
 // AwsResources is a struct to hold multiple instances of AwsResource.
@@ -14,6 +16,16 @@ type AwsResources struct {
 // AwsAccountResources is a struct that represents the resources found in a single AWS account
 type AwsAccountResources struct {
 	Resources map[string]AwsResources
+
+	// configObj and accountId are the scan settings, kept so resources can be re-listed between nuke passes
+	configObj config.Config
+	accountId string
+
+	// targets restricts the first nuke pass to these identifiers. Set when nuking a plan, nil otherwise.
+	targets engine.Targets
+
+	// incompleteRegions holds the regions where a resource type couldn't be listed, see engine.Resources.
+	incompleteRegions map[string]bool
 }
 
 func (a *AwsAccountResources) GetRegion(region string) AwsResources {
@@ -27,6 +39,9 @@ func (a *AwsAccountResources) GetRegion(region string) AwsResources {
 // In other words, if you have 3 nukeable resources in us-east-1 and 4 nukeable resources in ap-southeast-1, this function
 // would return 7
 func (a *AwsAccountResources) TotalResourceCount() int {
+	if a.targets != nil {
+		return a.targets.Count()
+	}
 	total := 0
 	for _, regionResource := range a.Resources {
 		for _, resource := range regionResource.Resources {
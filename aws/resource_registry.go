@@ -1,15 +1,39 @@
 package aws
 
 import (
+	"slices"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 )
 
+// customResources holds the resource types registered with RegisterResource.
+var customResources = &resource.Registry[resources.AwsResource]{}
+
+// RegisterResource registers a resource type defined outside of cloud-nuke, so that it is scanned and nuked like
+// the built-in ones: it can be selected with --resource-type, is listed by ListResourceTypes, and is nuked in the
+// order given by its own DependsOn and by the After and Before of the registration. factory must return a new,
+// uninitialized instance each time it is called, e.g.:
+//
+//	err := aws.RegisterResource(func() resources.AwsResource {
+//	    return resources.NewAwsResource(&resource.Resource[*myservice.Client]{
+//	        ResourceTypeName: "my-service-tag",
+//	        // ... other configuration
+//	    })
+//	}, resource.Registration{Before: []string{"ec2"}})
+//
+// Its config is read from the Custom section of the config file, keyed by resource type name.
+// Resource types must be registered before a Query is validated.
+func RegisterResource(factory func() resources.AwsResource, registration resource.Registration) error {
+	return customResources.Register(factory, registration, append(getRegisteredGlobalResources(), getRegisteredRegionalResources()...))
+}
+
 // GetAllRegisteredResources - returns a list of all registered resources without initialization.
 // This is useful for listing all resources without initializing them.
 func GetAllRegisteredResources() []*resources.AwsResource {
-	registeredResources := getRegisteredGlobalResources()
-	registeredResources = append(registeredResources, getRegisteredRegionalResources()...)
+	registeredResources := globalResources()
+	registeredResources = append(registeredResources, regionalResources()...)
 
 	return toAwsResourcesPointer(registeredResources)
 }
@@ -18,16 +42,96 @@ func GetAllRegisteredResources() []*resources.AwsResource {
 func GetAndInitRegisteredResources(session aws.Config, region string) []*resources.AwsResource {
 	var registeredResources []resources.AwsResource
 	if region == GlobalRegion {
-		registeredResources = getRegisteredGlobalResources()
+		registeredResources = globalResources()
 	} else {
-		registeredResources = getRegisteredRegionalResources()
+		registeredResources = regionalResources()
 	}
 
 	return initRegisteredResources(toAwsResourcesPointer(registeredResources), session, region)
 }
 
+// ValidateResourceDependencies checks that the dependencies declared by registered resources refer to
+// resource types registered in the same scope (global or regional) and do not form a cycle. Global resources may
+// also depend on regional resources (e.g., "rds-global-cluster" on "rds-global-cluster-membership"), since the
+// global scope is only nuked once every regional scope is.
+func ValidateResourceDependencies() error {
+	for _, global := range []bool{true, false} {
+		registered := globalResources()
+		if !global {
+			registered = regionalResources()
+		}
+		var names []string
+		for _, r := range registered {
+			names = append(names, r.ResourceName())
+		}
+
+		if err := customResources.Validate(global, names); err != nil {
+			return err
+		}
+
+		known := registered
+		if global {
+			known = append(regionalResources(), registered...)
+		}
+		if err := resource.ValidateDependencies(toAwsResourcesPointer(known), resourceName, resourceDependencies); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// globalResources returns the built-in global resources followed by the global resources registered with
+// RegisterResource.
+func globalResources() []resources.AwsResource {
+	return withCustomResources(getRegisteredGlobalResources(), true)
+}
+
+// regionalResources returns the built-in regional resources followed by the regional resources registered with
+// RegisterResource.
+func regionalResources() []resources.AwsResource {
+	return withCustomResources(getRegisteredRegionalResources(), false)
+}
+
+// withCustomResources appends the resources registered with RegisterResource to the built-in resources of the
+// same scope, and adds the dependencies required by their registrations.
+func withCustomResources(builtIn []resources.AwsResource, global bool) []resources.AwsResource {
+	all := append(builtIn, customResources.Resources(global)...)
+	dependencies := customResources.Dependencies(global)
+	for i, r := range all {
+		if dependsOn := dependencies[r.ResourceName()]; len(dependsOn) > 0 {
+			all[i] = orderedResource{AwsResource: r, dependsOn: dependsOn}
+		}
+	}
+	return all
+}
+
+// orderedResource is a resource whose deletion order is further constrained by registered resource types.
+type orderedResource struct {
+	resources.AwsResource
+	dependsOn []string
+}
+
+func (r orderedResource) Dependencies() []string {
+	return slices.Concat(r.AwsResource.Dependencies(), r.dependsOn)
+}
+
+// sortByDependencies orders resources so that each one is nuked after the resources it declares in
+// DependsOn. Registration order is used as a tie-breaker between independent resources.
+func sortByDependencies(res []*resources.AwsResource) ([]*resources.AwsResource, error) {
+	return resource.SortByDependencies(res, resourceName, resourceDependencies)
+}
+
+func resourceName(r *resources.AwsResource) string {
+	return (*r).ResourceName()
+}
+
+func resourceDependencies(r *resources.AwsResource) []string {
+	return (*r).Dependencies()
+}
+
 // GetRegisteredGlobalResources - returns a list of registered global resources.
-// Note: The order is important for IAM resources due to dependencies:
+// Note: Deletion order is derived from the DependsOn declared by each resource. For IAM resources
+// this yields the following order:
 // 1. Users (removes itself from groups, detaches its policies)
 // 2. Groups (removes remaining users, detaches its policies)
 // 3. Roles (deletes instance profiles, detaches policies)
@@ -55,9 +159,9 @@ func getRegisteredGlobalResources() []resources.AwsResource {
 }
 
 func getRegisteredRegionalResources() []resources.AwsResource {
-	// Note: The order is important because it determines the order of nuking resources. Some resources need to
-	// be deleted before others (Dependencies between resources exist). For example, we want to delete all EC2
-	// instances before deleting the VPC.
+	// Note: Deletion order is derived from the DependsOn declared by each resource (e.g., "vpc" depends on
+	// "ec2-subnet"), see sortByDependencies. The position in this list is only used as a tie-breaker between
+	// resources that do not depend on each other.
 	return []resources.AwsResource{
 		resources.NewAccessAnalyzer(),
 		resources.NewACM(),
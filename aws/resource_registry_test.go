@@ -1,10 +1,16 @@
 package aws
 
 import (
+	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 func TestGetAllRegisteredResources_NonEmpty(t *testing.T) {
@@ -68,3 +74,198 @@ func TestRegisteredResources_RegionalContainsExpectedResources(t *testing.T) {
 	assert.Contains(t, names, "lambda")
 	assert.Contains(t, names, "vpc")
 }
+
+// standaloneResourceTypes lists resource types that intentionally declare no dependencies and are not a
+// dependency of any other resource: nothing they use is nuked by another resource type, or they delete what they
+// use themselves (e.g., "cloudformation-stack"). A new resource must either declare its DependsOn edges (or be
+// referenced by another resource's DependsOn), or be added here to confirm that its deletion order does not matter.
+var standaloneResourceTypes = []string{
+	"access-analyzer",
+	"acmpca",
+	"api-gateway",
+	"api-gateway-v2",
+	"app-runner-service",
+	"backup-vault",
+	"cloudformation-stack",
+	"cloudfront-distribution",
+	"cloudtrail",
+	"cloudwatch-alarm",
+	"cloudwatch-dashboard",
+	"cloudwatch-loggroup",
+	"codedeploy-application",
+	"config-recorders",
+	"config-rules",
+	"data-pipeline",
+	"dynamodb",
+	"ec2-keypairs",
+	"ecr",
+	"elastic-beanstalk",
+	"grafana",
+	"guard-duty",
+	"iam-service-linked-role",
+	"kms-customer-key",
+	"lambda-layer",
+	"macie-member",
+	"managed-prometheus",
+	"network-firewall-resource-policy",
+	"oidc-provider",
+	"rds-cluster-snapshot",
+	"rds-snapshot",
+	"redshift-snapshot-copy-grant",
+	"resource-share",
+	"route53-traffic-policy",
+	"secrets-manager",
+	"security-hub",
+	"ses-configuration-set",
+	"ses-email-template",
+	"ses-identity",
+	"ses-receipt-filter",
+	"ses-receipt-rule-set",
+	"sns-topic",
+	"sqs",
+	"ssm-parameter",
+}
+
+func TestValidateResourceDependencies(t *testing.T) {
+	require.NoError(t, ValidateResourceDependencies())
+}
+
+func TestRegisteredResources_DependencyEdgesDeclared(t *testing.T) {
+	inGraph := make(map[string]bool)
+	for _, r := range GetAllRegisteredResources() {
+		for _, dep := range (*r).Dependencies() {
+			inGraph[(*r).ResourceName()] = true
+			inGraph[dep] = true
+		}
+	}
+
+	for _, r := range GetAllRegisteredResources() {
+		name := (*r).ResourceName()
+		if inGraph[name] {
+			assert.NotContains(t, standaloneResourceTypes, name,
+				"%s declares dependencies, remove it from standaloneResourceTypes", name)
+			continue
+		}
+		assert.Contains(t, standaloneResourceTypes, name,
+			"%s declares no dependencies: set DependsOn or add it to standaloneResourceTypes", name)
+	}
+}
+
+func TestSortByDependencies_RegionalOrder(t *testing.T) {
+	sorted, err := sortByDependencies(toAwsResourcesPointer(getRegisteredRegionalResources()))
+	require.NoError(t, err)
+
+	position := make(map[string]int)
+	for i, r := range sorted {
+		position[(*r).ResourceName()] = i
+	}
+
+	for _, r := range sorted {
+		for _, dep := range (*r).Dependencies() {
+			assert.Less(t, position[dep], position[(*r).ResourceName()],
+				"%s must be nuked before %s", dep, (*r).ResourceName())
+		}
+	}
+
+	assert.Less(t, position["ec2-subnet"], position["vpc"])
+	assert.Less(t, position["internet-gateway"], position["vpc"])
+	assert.Less(t, position["network-interface"], position["vpc"])
+	assert.Less(t, position["vpc"], position["ec2-dhcp-option"])
+	assert.Less(t, position["rds-cluster"], position["rds-subnet-group"])
+	assert.Less(t, position["ecs-service"], position["ecs-cluster"])
+	assert.Less(t, position["transit-gateway-route-table"], position["transit-gateway"])
+	assert.Less(t, position["ipam-pool"], position["ipam-scope"])
+	assert.Less(t, position["vpc-lattice-service"], position["vpc-lattice-service-network"])
+	assert.Less(t, position["eks-cluster"], position["ec2-subnet"])
+	assert.Less(t, position["security-group"], position["ec2-subnet"])
+}
+
+func TestProvider_Dependencies(t *testing.T) {
+	dependencies := provider{}.Dependencies("us-east-1")
+
+	// vpc is nuked after security-group, through ec2-subnet, even when ec2-subnet is not selected
+	assert.Contains(t, dependencies["vpc"], "security-group")
+	assert.Contains(t, dependencies["ec2-subnet"], "security-group")
+	assert.NotContains(t, provider{}.Dependencies(GlobalRegion), "vpc")
+}
+
+func TestRegisteredResources_ServiceName(t *testing.T) {
+	services := make(map[string]string)
+	for _, r := range GetAndInitRegisteredResources(aws.Config{Region: "us-east-1"}, "us-east-1") {
+		services[(*r).ResourceName()] = (*r).ServiceName()
+	}
+
+	// Resource types backed by the same SDK client share a service, and thus a rate limiter
+	assert.Equal(t, "ec2", services["vpc"])
+	assert.Equal(t, "ec2", services["ec2-subnet"])
+	assert.Equal(t, "rds", services["rds-cluster"])
+}
+
+// withCustomResourceRegistry gives the test its own registry of custom resource types.
+func withCustomResourceRegistry(t *testing.T) {
+	previous := customResources
+	customResources = &resource.Registry[resources.AwsResource]{}
+	t.Cleanup(func() { customResources = previous })
+}
+
+func newCustomResource(name string) func() resources.AwsResource {
+	return func() resources.AwsResource {
+		return resources.NewAwsResource(&resource.Resource[struct{}]{
+			ResourceTypeName: name,
+		})
+	}
+}
+
+func TestRegisterResource(t *testing.T) {
+	withCustomResourceRegistry(t)
+
+	require.NoError(t, RegisterResource(newCustomResource("internal-service-tag"),
+		resource.Registration{After: []string{"ec2-subnet"}, Before: []string{"vpc"}}))
+	require.NoError(t, ValidateResourceDependencies())
+
+	assert.Contains(t, ListResourceTypes(), "internal-service-tag")
+	_, err := HandleResourceTypeSelections([]string{"internal-service-tag"}, nil)
+	require.NoError(t, err)
+
+	sorted, err := sortByDependencies(GetAndInitRegisteredResources(aws.Config{Region: "us-east-1"}, "us-east-1"))
+	require.NoError(t, err)
+	position := make(map[string]int)
+	for i, r := range sorted {
+		position[(*r).ResourceName()] = i
+	}
+	assert.Less(t, position["ec2-subnet"], position["internal-service-tag"])
+	assert.Less(t, position["internal-service-tag"], position["vpc"])
+
+	// Global resources don't see regional registrations
+	for _, r := range GetAndInitRegisteredResources(aws.Config{Region: "us-east-1"}, GlobalRegion) {
+		assert.NotEqual(t, "internal-service-tag", (*r).ResourceName())
+	}
+}
+
+func TestRegisterResource_Errors(t *testing.T) {
+	withCustomResourceRegistry(t)
+
+	var alreadyRegistered resource.ResourceTypeAlreadyRegisteredError
+	require.ErrorAs(t, RegisterResource(newCustomResource("ec2"), resource.Registration{}), &alreadyRegistered)
+
+	// Constraints on resource types of the other scope are caught when validating
+	require.NoError(t, RegisterResource(newCustomResource("global-tag"),
+		resource.Registration{Global: true, Before: []string{"vpc"}}))
+	var unknown resource.UnknownDependencyError
+	require.ErrorAs(t, ValidateResourceDependencies(), &unknown)
+}
+
+func TestRegisteredResources_ConfigKeyIsAConfigField(t *testing.T) {
+	for _, r := range GetAllRegisteredResources() {
+		name := (*r).ResourceName()
+		section := fmt.Sprintf("Custom:\n  %s:\n    timeout: 7m\n", name)
+		if key := (*r).ResourceConfigKey(); key != "" {
+			section = fmt.Sprintf("%s:\n  timeout: 7m\n", key)
+		}
+
+		var configObj config.Config
+		require.NoError(t, yaml.Unmarshal([]byte(section), &configObj))
+		assert.Equal(t, "7m", (*r).GetAndSetResourceConfig(configObj).Timeout,
+			"%s: ConfigKey must be the YAML key of a field of the config", name)
+	}
+}
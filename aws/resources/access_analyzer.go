@@ -19,22 +19,21 @@ type AccessAnalyzerAPI interface {
 func NewAccessAnalyzer() AwsResource {
 	return NewAwsResource(&resource.Resource[AccessAnalyzerAPI]{
 		ResourceTypeName: "access-analyzer",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[AccessAnalyzerAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = accessanalyzer.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.AccessAnalyzer
-		},
-		Lister: listAccessAnalyzers,
-		Nuker:  resource.SimpleBatchDeleter(deleteAccessAnalyzer),
+		ConfigKey:      "AccessAnalyzer",
+		DetailedLister: listAccessAnalyzers,
+		Nuker:          resource.SimpleBatchDeleter(deleteAccessAnalyzer),
 	})
 }
 
 // listAccessAnalyzers retrieves all IAM Access Analyzers that match the config filters.
-func listAccessAnalyzers(ctx context.Context, client AccessAnalyzerAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allAnalyzers []*string
+func listAccessAnalyzers(ctx context.Context, client AccessAnalyzerAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allAnalyzers []resource.ResourceDetails
 	paginator := accessanalyzer.NewListAnalyzersPaginator(client, &accessanalyzer.ListAnalyzersInput{})
 
 	for paginator.HasMorePages() {
@@ -44,12 +43,13 @@ func listAccessAnalyzers(ctx context.Context, client AccessAnalyzerAPI, scope re
 		}
 
 		for _, analyzer := range page.Analyzers {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: analyzer.CreatedAt,
 				Name: analyzer.Name,
 				Tags: analyzer.Tags,
-			}) {
-				allAnalyzers = append(allAnalyzers, analyzer.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				allAnalyzers = append(allAnalyzers, resource.NewResourceDetails(analyzer.Name, value).WithARN(analyzer.Arn))
 			}
 		}
 	}
@@ -42,7 +42,7 @@ func TestListAccessAnalyzers(t *testing.T) {
 
 	names, err := listAccessAnalyzers(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"analyzer1", "analyzer2"}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{"analyzer1", "analyzer2"}, resource.IDs(names))
 }
 
 func TestListAccessAnalyzers_WithFilter(t *testing.T) {
@@ -66,7 +66,7 @@ func TestListAccessAnalyzers_WithFilter(t *testing.T) {
 
 	names, err := listAccessAnalyzers(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"analyzer1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"analyzer1"}, resource.IDs(names))
 }
 
 func TestListAccessAnalyzers_TagFilter(t *testing.T) {
@@ -90,7 +90,7 @@ func TestListAccessAnalyzers_TagFilter(t *testing.T) {
 
 	names, err := listAccessAnalyzers(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"analyzer1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"analyzer1"}, resource.IDs(names))
 }
 
 func TestDeleteAccessAnalyzer(t *testing.T) {
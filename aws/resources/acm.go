@@ -24,22 +24,22 @@ type ACMAPI interface {
 func NewACM() AwsResource {
 	return NewAwsResource(&resource.Resource[ACMAPI]{
 		ResourceTypeName: "acm",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"elb", "elbv2"},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ACMAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = acm.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ACM
-		},
-		Lister: listACMCertificates,
-		Nuker:  resource.SimpleBatchDeleter(deleteACMCertificate),
+		ConfigKey:      "ACM",
+		DetailedLister: listACMCertificates,
+		Nuker:          resource.SimpleBatchDeleter(deleteACMCertificate),
 	})
 }
 
 // listACMCertificates retrieves all ACM certificates that match the config filters.
-func listACMCertificates(ctx context.Context, client ACMAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var acmArns []*string
+func listACMCertificates(ctx context.Context, client ACMAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var acmArns []resource.ResourceDetails
 
 	// By default, ListCertificates only returns RSA_1024 and RSA_2048 certificates.
 	// Explicitly include all key types to ensure we find all certificates.
@@ -82,12 +82,13 @@ func listACMCertificates(ctx context.Context, client ACMAPI, scope resource.Scop
 				tags = util.ConvertACMTagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cert.DomainName,
 				Time: cert.CreatedAt,
 				Tags: tags,
-			}) {
-				acmArns = append(acmArns, cert.CertificateArn)
+			}
+			if cfg.ShouldInclude(value) {
+				acmArns = append(acmArns, resource.NewResourceDetails(cert.CertificateArn, value).WithARN(cert.CertificateArn))
 			}
 		}
 	}
@@ -92,7 +92,7 @@ func TestListACMCertificates(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listACMCertificates(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
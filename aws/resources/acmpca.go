@@ -32,22 +32,21 @@ type ACMPCAAPI interface {
 func NewACMPCA() AwsResource {
 	return NewAwsResource(&resource.Resource[ACMPCAAPI]{
 		ResourceTypeName: "acmpca",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ACMPCAAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = acmpca.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ACMPCA
-		},
-		Lister: listACMPCA,
-		Nuker:  resource.SimpleBatchDeleter(deleteACMPCA),
+		ConfigKey:      "ACMPCA",
+		DetailedLister: listACMPCA,
+		Nuker:          resource.SimpleBatchDeleter(deleteACMPCA),
 	})
 }
 
 // listACMPCA retrieves all ACM PCA certificate authorities that match the config filters.
-func listACMPCA(ctx context.Context, client ACMPCAAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var arns []*string
+func listACMPCA(ctx context.Context, client ACMPCAAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var arns []resource.ResourceDetails
 
 	paginator := acmpca.NewListCertificateAuthoritiesPaginator(client, &acmpca.ListCertificateAuthoritiesInput{})
 	for paginator.HasMorePages() {
@@ -78,11 +77,12 @@ func listACMPCA(ctx context.Context, client ACMPCAAPI, scope resource.Scope, cfg
 				tags = util.ConvertACMPCATagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: &referenceTime,
 				Tags: tags,
-			}) {
-				arns = append(arns, ca.Arn)
+			}
+			if cfg.ShouldInclude(value) {
+				arns = append(arns, resource.NewResourceDetails(ca.Arn, value).WithARN(ca.Arn))
 			}
 		}
 	}
@@ -127,7 +127,7 @@ func TestListACMPCA(t *testing.T) {
 
 			arns, err := listACMPCA(context.Background(), mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.ElementsMatch(t, tc.expected, aws.ToStringSlice(arns))
+			require.ElementsMatch(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
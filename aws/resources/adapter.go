@@ -147,16 +147,23 @@ func (a *AwsResourceAdapter[C]) Nuke(ctx context.Context, identifiers []string)
 var _ AwsResource = (*AwsResourceAdapter[any])(nil)
 
 // EC2ListerFunc is a lister function signature for EC2 resources that need DefaultOnly support.
-type EC2ListerFunc[C any] func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error)
+type EC2ListerFunc[C any] func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error)
 
 // EC2ResourceOptions contains optional configuration for NewEC2AwsResource.
 type EC2ResourceOptions[C any] struct {
 	// PermissionVerifier is an optional function to verify deletion permissions via dry-run.
 	PermissionVerifier func(ctx context.Context, client C, id *string) error
+
+	// DependsOn lists the resource types that must be nuked before this one.
+	DependsOn []string
+
+	// Categories lists the categories of the resource type.
+	Categories []string
 }
 
 // NewEC2AwsResource creates an AWS resource that uses EC2ResourceType config (with DefaultOnly support).
-// This helper encapsulates the closure pattern needed to pass DefaultOnly from ConfigGetter to Lister.
+// This helper encapsulates the closure pattern needed to pass DefaultOnly from the EC2ResourceType field of
+// config.Config keyed by configKey to Lister.
 //
 // Example:
 //
@@ -167,7 +174,7 @@ type EC2ResourceOptions[C any] struct {
 //	            r.Scope.Region = cfg.Region
 //	            r.Client = ec2.NewFromConfig(cfg)
 //	        }),
-//	        func(c config.Config) config.EC2ResourceType { return c.VPC },
+//	        "VPC",
 //	        listVPCs,
 //	        resource.SequentialDeleter(deleteVPC),
 //	        nil, // no options
@@ -176,7 +183,7 @@ type EC2ResourceOptions[C any] struct {
 func NewEC2AwsResource[C any](
 	resourceTypeName string,
 	initClient func(r *resource.Resource[C], cfg any),
-	configExtractor func(c config.Config) config.EC2ResourceType,
+	configKey string,
 	lister EC2ListerFunc[C],
 	nuker resource.NukerFunc[C],
 	opts *EC2ResourceOptions[C],
@@ -187,12 +194,12 @@ func NewEC2AwsResource[C any](
 		ResourceTypeName: resourceTypeName,
 		BatchSize:        DefaultBatchSize,
 		InitClient:       initClient,
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			ec2Cfg := configExtractor(c)
+		ConfigKey:        configKey,
+		OnConfig: func(c config.Config) {
+			ec2Cfg, _ := c.EC2ResourceTypeByKey(configKey)
 			defaultOnly = ec2Cfg.DefaultOnly
-			return ec2Cfg.ResourceType
 		},
-		Lister: func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+		DetailedLister: func(ctx context.Context, client C, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 			return lister(ctx, client, scope, cfg, defaultOnly)
 		},
 		Nuker: nuker,
@@ -201,6 +208,10 @@ func NewEC2AwsResource[C any](
 	if opts != nil && opts.PermissionVerifier != nil {
 		r.PermissionVerifier = opts.PermissionVerifier
 	}
+	if opts != nil {
+		r.DependsOn = opts.DependsOn
+		r.Categories = opts.Categories
+	}
 
 	return NewAwsResource(r)
 }
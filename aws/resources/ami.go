@@ -24,22 +24,21 @@ type AMIsAPI interface {
 func NewAMIs() AwsResource {
 	return NewAwsResource(&resource.Resource[AMIsAPI]{
 		ResourceTypeName: "ami",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[AMIsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.AMI
-		},
-		Lister: listAMIs,
-		Nuker:  resource.SimpleBatchDeleter(nukeAMI),
+		ConfigKey:      "AMI",
+		DetailedLister: listAMIs,
+		Nuker:          resource.SimpleBatchDeleter(nukeAMI),
 	})
 }
 
 // listAMIs retrieves all user-owned AMIs that match the config filters.
-func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var imageIds []*string
+func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var imageIds []resource.ResourceDetails
 	paginator := ec2.NewDescribeImagesPaginator(client, &ec2.DescribeImagesInput{
 		Owners: []string{"self"},
 	})
@@ -60,12 +59,13 @@ func listAMIs(ctx context.Context, client AMIsAPI, scope resource.Scope, cfg con
 				return nil, err
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: image.Name,
 				Time: createdTime,
 				Tags: util.ConvertTypesTagsToMap(image.Tags),
-			}) {
-				imageIds = append(imageIds, image.ImageId)
+			}
+			if cfg.ShouldInclude(value) {
+				imageIds = append(imageIds, resource.NewResourceDetails(image.ImageId, value))
 			}
 		}
 	}
@@ -72,8 +72,8 @@ func TestListAMIs_SkipAWSManaged(t *testing.T) {
 
 	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.NotContains(t, aws.ToStringSlice(amis), testImageId1)
-	require.NotContains(t, aws.ToStringSlice(amis), testImageId2)
+	require.NotContains(t, resource.IDs(amis), testImageId1)
+	require.NotContains(t, resource.IDs(amis), testImageId2)
 }
 
 func TestListAMIs(t *testing.T) {
@@ -95,7 +95,7 @@ func TestListAMIs(t *testing.T) {
 	// without filters
 	amis, err := listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Contains(t, aws.ToStringSlice(amis), testImageId)
+	require.Contains(t, resource.IDs(amis), testImageId)
 
 	// with name filter
 	amis, err = listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{
@@ -106,7 +106,7 @@ func TestListAMIs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	require.NotContains(t, aws.ToStringSlice(amis), testImageId)
+	require.NotContains(t, resource.IDs(amis), testImageId)
 
 	// with time filter
 	amis, err = listAMIs(context.Background(), mock, resource.Scope{}, config.ResourceType{
@@ -115,7 +115,7 @@ func TestListAMIs(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	require.NotContains(t, aws.ToStringSlice(amis), testImageId)
+	require.NotContains(t, resource.IDs(amis), testImageId)
 }
 
 func TestNukeAMI_DeletesSnapshotsAfterDeregister(t *testing.T) {
@@ -20,22 +20,21 @@ type ApiGatewayAPI interface {
 func NewApiGateway() AwsResource {
 	return NewAwsResource(&resource.Resource[ApiGatewayAPI]{
 		ResourceTypeName: "api-gateway",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ApiGatewayAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = apigateway.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.APIGateway
-		},
-		Lister: listApiGateways,
-		Nuker:  resource.SimpleBatchDeleter(deleteApiGateway),
+		ConfigKey:      "APIGateway",
+		DetailedLister: listApiGateways,
+		Nuker:          resource.SimpleBatchDeleter(deleteApiGateway),
 	})
 }
 
 // listApiGateways retrieves all API Gateway (v1) REST APIs that match the config filters.
-func listApiGateways(ctx context.Context, client ApiGatewayAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listApiGateways(ctx context.Context, client ApiGatewayAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 
 	paginator := apigateway.NewGetRestApisPaginator(client, &apigateway.GetRestApisInput{})
 	for paginator.HasMorePages() {
@@ -45,12 +44,13 @@ func listApiGateways(ctx context.Context, client ApiGatewayAPI, scope resource.S
 		}
 
 		for _, api := range page.Items {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: api.Name,
 				Time: api.CreatedDate,
 				Tags: api.Tags,
-			}) {
-				ids = append(ids, api.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(api.Id, value))
 			}
 		}
 	}
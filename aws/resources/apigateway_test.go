@@ -108,7 +108,7 @@ func TestAPIGateway_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			apis, err := listApiGateways(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(apis))
+			require.Equal(t, tc.expected, resource.IDs(apis))
 		})
 	}
 }
@@ -20,23 +20,22 @@ type ApiGatewayV2API interface {
 func NewApiGatewayV2() AwsResource {
 	return NewAwsResource(&resource.Resource[ApiGatewayV2API]{
 		ResourceTypeName: "api-gateway-v2",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ApiGatewayV2API], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = apigatewayv2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.APIGatewayV2
-		},
-		Lister: listApiGatewaysV2,
-		Nuker:  resource.SimpleBatchDeleter(deleteApiGatewayV2),
+		ConfigKey:      "APIGatewayV2",
+		DetailedLister: listApiGatewaysV2,
+		Nuker:          resource.SimpleBatchDeleter(deleteApiGatewayV2),
 	})
 }
 
 // listApiGatewaysV2 retrieves all API Gateways V2 that match the config filters.
 // Note: apigatewayv2 SDK doesn't have built-in paginators, so we implement manual pagination.
-func listApiGatewaysV2(ctx context.Context, client ApiGatewayV2API, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listApiGatewaysV2(ctx context.Context, client ApiGatewayV2API, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	input := &apigatewayv2.GetApisInput{}
 
 	for {
@@ -46,12 +45,13 @@ func listApiGatewaysV2(ctx context.Context, client ApiGatewayV2API, scope resour
 		}
 
 		for _, api := range output.Items {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: api.CreatedDate,
 				Name: api.Name,
 				Tags: api.Tags,
-			}) {
-				ids = append(ids, api.ApiId)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(api.ApiId, value))
 			}
 		}
 
@@ -30,28 +30,27 @@ type AppRunnerServiceAPI interface {
 func NewAppRunnerService() AwsResource {
 	return NewAwsResource(&resource.Resource[AppRunnerServiceAPI]{
 		ResourceTypeName: "app-runner-service",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        20,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[AppRunnerServiceAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = apprunner.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.AppRunnerService
-		},
-		Lister: listAppRunnerServices,
-		Nuker:  resource.SimpleBatchDeleter(deleteAppRunnerService),
+		ConfigKey:      "AppRunnerService",
+		DetailedLister: listAppRunnerServices,
+		Nuker:          resource.SimpleBatchDeleter(deleteAppRunnerService),
 	})
 }
 
 // listAppRunnerServices retrieves all App Runner services that match the config filters.
-func listAppRunnerServices(ctx context.Context, client AppRunnerServiceAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listAppRunnerServices(ctx context.Context, client AppRunnerServiceAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Check if region supports App Runner
 	if !slices.Contains(AppRunnerAllowedRegions, scope.Region) {
 		logging.Debugf("Region %s is not allowed for App Runner", scope.Region)
 		return nil, nil
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	paginator := apprunner.NewListServicesPaginator(client, &apprunner.ListServicesInput{
 		MaxResults: aws.Int32(20),
 	})
@@ -75,12 +74,13 @@ func listAppRunnerServices(ctx context.Context, client AppRunnerServiceAPI, scop
 				tags = util.ConvertAppRunnerTagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: service.ServiceName,
 				Time: service.CreatedAt,
 				Tags: tags,
-			}) {
-				identifiers = append(identifiers, service.ServiceArn)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(service.ServiceArn, value).WithARN(service.ServiceArn))
 			}
 		}
 	}
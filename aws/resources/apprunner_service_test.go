@@ -102,7 +102,7 @@ func TestListAppRunnerServices(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listAppRunnerServices(context.Background(), mock, resource.Scope{Region: tc.region}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
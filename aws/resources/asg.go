@@ -22,22 +22,21 @@ type ASGroupsAPI interface {
 func NewASGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[ASGroupsAPI]{
 		ResourceTypeName: "asg",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ASGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = autoscaling.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.AutoScalingGroup
-		},
-		Lister: listASGroups,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteASG, waitForASGsDeleted),
+		ConfigKey:      "AutoScalingGroup",
+		DetailedLister: listASGroups,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteASG, waitForASGsDeleted),
 	})
 }
 
 // listASGroups retrieves all Auto Scaling Groups that match the config filters.
-func listASGroups(ctx context.Context, client ASGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var groupNames []*string
+func listASGroups(ctx context.Context, client ASGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var groupNames []resource.ResourceDetails
 	paginator := autoscaling.NewDescribeAutoScalingGroupsPaginator(client, &autoscaling.DescribeAutoScalingGroupsInput{})
 
 	for paginator.HasMorePages() {
@@ -47,12 +46,13 @@ func listASGroups(ctx context.Context, client ASGroupsAPI, scope resource.Scope,
 		}
 
 		for _, group := range page.AutoScalingGroups {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: group.CreatedTime,
 				Name: group.AutoScalingGroupName,
 				Tags: util.ConvertAutoScalingTagsToMap(group.Tags),
-			}) {
-				groupNames = append(groupNames, group.AutoScalingGroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				groupNames = append(groupNames, resource.NewResourceDetails(group.AutoScalingGroupName, value).WithARN(group.AutoScalingGroupARN))
 			}
 		}
 	}
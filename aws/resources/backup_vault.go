@@ -28,22 +28,21 @@ type BackupVaultAPI interface {
 func NewBackupVault() AwsResource {
 	return NewAwsResource(&resource.Resource[BackupVaultAPI]{
 		ResourceTypeName: "backup-vault",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[BackupVaultAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = backup.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.BackupVault
-		},
-		Lister: listBackupVaults,
-		Nuker:  resource.MultiStepDeleter(nukeRecoveryPoints, nukeBackupVault),
+		ConfigKey:      "BackupVault",
+		DetailedLister: listBackupVaults,
+		Nuker:          resource.MultiStepDeleter(nukeRecoveryPoints, nukeBackupVault),
 	})
 }
 
 // listBackupVaults retrieves all Backup Vaults that match the config filters.
-func listBackupVaults(ctx context.Context, client BackupVaultAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listBackupVaults(ctx context.Context, client BackupVaultAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := backup.NewListBackupVaultsPaginator(client, &backup.ListBackupVaultsInput{})
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -64,12 +63,13 @@ func listBackupVaults(ctx context.Context, client BackupVaultAPI, scope resource
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: backupVault.BackupVaultName,
 				Time: backupVault.CreationDate,
 				Tags: tags,
-			}) {
-				names = append(names, backupVault.BackupVaultName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(backupVault.BackupVaultName, value).WithARN(backupVault.BackupVaultArn))
 			}
 		}
 	}
@@ -134,7 +134,7 @@ func TestListBackupVaults(t *testing.T) {
 			names, err := listBackupVaults(context.Background(), mock, resource.Scope{}, tc.configObj)
 
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -201,7 +201,7 @@ func TestListBackupVaults_FilterTags(t *testing.T) {
 			names, err := listBackupVaults(context.Background(), mock, resource.Scope{}, tc.configObj)
 
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -229,7 +229,7 @@ func TestListBackupVaults_TagsError(t *testing.T) {
 
 	names, err := listBackupVaults(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{}, aws.ToStringSlice(names))
+	require.Equal(t, []string{}, resource.IDs(names))
 }
 
 func TestNukeBackupVault(t *testing.T) {
@@ -298,5 +298,5 @@ func TestListBackupVaultsPagination(t *testing.T) {
 
 	names, err := listBackupVaults(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"vault-1", "vault-2"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"vault-1", "vault-2"}, resource.IDs(names))
 }
@@ -55,17 +55,15 @@ func NewCloudFormationStacks() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudformation.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudFormationStack
-		},
-		Lister: listCloudFormationStacks,
-		Nuker:  resource.SimpleBatchDeleter(deleteCloudFormationStack),
+		ConfigKey:      "CloudFormationStack",
+		DetailedLister: listCloudFormationStacks,
+		Nuker:          resource.SimpleBatchDeleter(deleteCloudFormationStack),
 	})
 }
 
 // listCloudFormationStacks retrieves all CloudFormation stacks that match the config filters.
-func listCloudFormationStacks(ctx context.Context, client CloudFormationStacksAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var stackNames []*string
+func listCloudFormationStacks(ctx context.Context, client CloudFormationStacksAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var stackNames []resource.ResourceDetails
 
 	paginator := cloudformation.NewListStacksPaginator(client, &cloudformation.ListStacksInput{
 		StackStatusFilter: activeStackStatuses,
@@ -78,8 +76,8 @@ func listCloudFormationStacks(ctx context.Context, client CloudFormationStacksAP
 		}
 
 		for _, stack := range page.StackSummaries {
-			if shouldIncludeStack(ctx, client, &stack, cfg) {
-				stackNames = append(stackNames, stack.StackName)
+			if value, ok := shouldIncludeStack(ctx, client, &stack, cfg); ok {
+				stackNames = append(stackNames, resource.NewResourceDetails(stack.StackName, value))
 			}
 		}
 	}
@@ -87,10 +85,10 @@ func listCloudFormationStacks(ctx context.Context, client CloudFormationStacksAP
 	return stackNames, nil
 }
 
-// shouldIncludeStack determines if a CloudFormation stack should be included for deletion.
-func shouldIncludeStack(ctx context.Context, client CloudFormationStacksAPI, stack *types.StackSummary, cfg config.ResourceType) bool {
+// shouldIncludeStack determines if a CloudFormation stack should be included for deletion, returning the value it was filtered on.
+func shouldIncludeStack(ctx context.Context, client CloudFormationStacksAPI, stack *types.StackSummary, cfg config.ResourceType) (config.ResourceValue, bool) {
 	if stack == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// Get detailed stack information including tags
@@ -99,20 +97,21 @@ func shouldIncludeStack(ctx context.Context, client CloudFormationStacksAPI, sta
 	})
 	if err != nil {
 		logging.Debugf("Failed to describe stack %s: %v", aws.ToString(stack.StackName), err)
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	if len(stackDetails.Stacks) == 0 {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	tags := util.ConvertCloudFormationTagsToMap(stackDetails.Stacks[0].Tags)
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: stack.StackName,
 		Time: stack.CreationTime,
 		Tags: tags,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteCloudFormationStack deletes a single CloudFormation stack.
@@ -121,7 +121,7 @@ func TestCloudFormationStackGetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
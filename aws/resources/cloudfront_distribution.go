@@ -33,22 +33,21 @@ type CloudfrontDistributionAPI interface {
 func NewCloudfrontDistributions() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudfrontDistributionAPI]{
 		ResourceTypeName: "cloudfront-distribution",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudfrontDistributionAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = cloudfront.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudFrontDistribution
-		},
-		Lister: listCloudfrontDistributions,
-		Nuker:  resource.SequentialDeleter(nukeCloudfrontDistribution),
+		ConfigKey:      "CloudFrontDistribution",
+		DetailedLister: listCloudfrontDistributions,
+		Nuker:          resource.SequentialDeleter(nukeCloudfrontDistribution),
 	})
 }
 
 // listCloudfrontDistributions retrieves all CloudFront distributions that match the config filters.
-func listCloudfrontDistributions(ctx context.Context, client CloudfrontDistributionAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listCloudfrontDistributions(ctx context.Context, client CloudfrontDistributionAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	paginator := cloudfront.NewListDistributionsPaginator(client, &cloudfront.ListDistributionsInput{})
 
 	for paginator.HasMorePages() {
@@ -74,11 +73,12 @@ func listCloudfrontDistributions(ctx context.Context, client CloudfrontDistribut
 				tags = util.ConvertCloudFrontTagsToMap(tagsOutput.Tags.Items)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: item.Id,
 				Tags: tags,
-			}) {
-				ids = append(ids, item.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(item.Id, value).WithARN(item.ARN))
 			}
 		}
 	}
@@ -143,7 +143,7 @@ func TestCloudfrontDistribution_List(t *testing.T) {
 			ids, err := listCloudfrontDistributions(context.Background(), client, resource.Scope{}, tc.configObj)
 
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
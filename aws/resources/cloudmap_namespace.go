@@ -23,22 +23,22 @@ type CloudMapNamespacesAPI interface {
 func NewCloudMapNamespaces() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudMapNamespacesAPI]{
 		ResourceTypeName: "cloudmap-namespace",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"cloudmap-service"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudMapNamespacesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = servicediscovery.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudMapNamespace
-		},
-		Lister: listCloudMapNamespaces,
-		Nuker:  resource.SequentialDeleter(deleteCloudMapNamespace),
+		ConfigKey:      "CloudMapNamespace",
+		DetailedLister: listCloudMapNamespaces,
+		Nuker:          resource.SequentialDeleter(deleteCloudMapNamespace),
 	})
 }
 
 // listCloudMapNamespaces retrieves all Cloud Map namespaces that match the config filters.
-func listCloudMapNamespaces(ctx context.Context, client CloudMapNamespacesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var namespaceIds []*string
+func listCloudMapNamespaces(ctx context.Context, client CloudMapNamespacesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var namespaceIds []resource.ResourceDetails
 
 	paginator := servicediscovery.NewListNamespacesPaginator(client, &servicediscovery.ListNamespacesInput{})
 	for paginator.HasMorePages() {
@@ -53,12 +53,13 @@ func listCloudMapNamespaces(ctx context.Context, client CloudMapNamespacesAPI, s
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: namespace.Name,
 				Time: namespace.CreateDate,
 				Tags: tags,
-			}) {
-				namespaceIds = append(namespaceIds, namespace.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				namespaceIds = append(namespaceIds, resource.NewResourceDetails(namespace.Id, value).WithARN(namespace.Arn))
 			}
 		}
 	}
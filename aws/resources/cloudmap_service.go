@@ -26,22 +26,21 @@ type CloudMapServicesAPI interface {
 func NewCloudMapServices() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudMapServicesAPI]{
 		ResourceTypeName: "cloudmap-service",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudMapServicesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = servicediscovery.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudMapService
-		},
-		Lister: listCloudMapServices,
-		Nuker:  resource.SequentialDeleter(deleteCloudMapService),
+		ConfigKey:      "CloudMapService",
+		DetailedLister: listCloudMapServices,
+		Nuker:          resource.SequentialDeleter(deleteCloudMapService),
 	})
 }
 
 // listCloudMapServices retrieves all Cloud Map services matching the config filters.
-func listCloudMapServices(ctx context.Context, client CloudMapServicesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var serviceIds []*string
+func listCloudMapServices(ctx context.Context, client CloudMapServicesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var serviceIds []resource.ResourceDetails
 
 	paginator := servicediscovery.NewListServicesPaginator(client, &servicediscovery.ListServicesInput{})
 	for paginator.HasMorePages() {
@@ -58,12 +57,13 @@ func listCloudMapServices(ctx context.Context, client CloudMapServicesAPI, scope
 				tags = nil
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: service.Name,
 				Time: service.CreateDate,
 				Tags: tags,
-			}) {
-				serviceIds = append(serviceIds, service.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				serviceIds = append(serviceIds, resource.NewResourceDetails(service.Id, value).WithARN(service.Arn))
 			}
 		}
 	}
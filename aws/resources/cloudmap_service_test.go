@@ -130,7 +130,7 @@ func TestListCloudMapServices(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listCloudMapServices(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -20,22 +20,21 @@ type CloudtrailTrailAPI interface {
 func NewCloudtrailTrail() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudtrailTrailAPI]{
 		ResourceTypeName: "cloudtrail",
+		Categories:       []string{resource.CategoryObservability},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudtrailTrailAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudtrail.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudTrailTrail
-		},
-		Lister: listCloudtrailTrails,
-		Nuker:  resource.SimpleBatchDeleter(deleteCloudtrailTrail),
+		ConfigKey:      "CloudTrailTrail",
+		DetailedLister: listCloudtrailTrails,
+		Nuker:          resource.SimpleBatchDeleter(deleteCloudtrailTrail),
 	})
 }
 
 // listCloudtrailTrails retrieves all CloudTrail trails that match the config filters.
-func listCloudtrailTrails(ctx context.Context, client CloudtrailTrailAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var trailArns []*string
+func listCloudtrailTrails(ctx context.Context, client CloudtrailTrailAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var trailArns []resource.ResourceDetails
 	paginator := cloudtrail.NewListTrailsPaginator(client, &cloudtrail.ListTrailsInput{})
 
 	for paginator.HasMorePages() {
@@ -62,7 +61,7 @@ func listCloudtrailTrails(ctx context.Context, client CloudtrailTrailAPI, scope
 			}
 
 			if cfg.ShouldInclude(rv) {
-				trailArns = append(trailArns, trail.TrailARN)
+				trailArns = append(trailArns, resource.NewResourceDetails(trail.TrailARN, rv).WithARN(trail.TrailARN))
 			}
 		}
 	}
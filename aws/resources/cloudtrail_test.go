@@ -99,7 +99,7 @@ func TestListCloudtrailTrails(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listCloudtrailTrails(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
@@ -117,7 +117,7 @@ func TestListCloudtrailTrails_TagsError(t *testing.T) {
 
 	arns, err := listCloudtrailTrails(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{testArn}, aws.ToStringSlice(arns))
+	require.Equal(t, []string{testArn}, resource.IDs(arns))
 }
 
 func TestDeleteCloudtrailTrail(t *testing.T) {
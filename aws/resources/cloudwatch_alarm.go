@@ -25,23 +25,22 @@ type CloudWatchAlarmsAPI interface {
 func NewCloudWatchAlarms() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudWatchAlarmsAPI]{
 		ResourceTypeName: "cloudwatch-alarm",
+		Categories:       []string{resource.CategoryObservability},
 		// CloudWatch DeleteAlarms API accepts a maximum of 100 alarm names per call.
 		BatchSize: 99,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudWatchAlarmsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudwatch.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudWatchAlarm
-		},
-		Lister: listCloudWatchAlarms,
-		Nuker:  resource.SequentialDeleter(deleteCloudWatchAlarm),
+		ConfigKey:      "CloudWatchAlarm",
+		DetailedLister: listCloudWatchAlarms,
+		Nuker:          resource.SequentialDeleter(deleteCloudWatchAlarm),
 	})
 }
 
 // listCloudWatchAlarms retrieves all CloudWatch alarms that match the config filters.
-func listCloudWatchAlarms(ctx context.Context, client CloudWatchAlarmsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allAlarms []*string
+func listCloudWatchAlarms(ctx context.Context, client CloudWatchAlarmsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allAlarms []resource.ResourceDetails
 
 	paginator := cloudwatch.NewDescribeAlarmsPaginator(client, &cloudwatch.DescribeAlarmsInput{
 		AlarmTypes: []types.AlarmType{types.AlarmTypeMetricAlarm, types.AlarmTypeCompositeAlarm},
@@ -62,12 +61,13 @@ func listCloudWatchAlarms(ctx context.Context, client CloudWatchAlarmsAPI, scope
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: alarm.AlarmName,
 				Time: alarm.AlarmConfigurationUpdatedTimestamp,
 				Tags: util.ConvertCloudWatchTagsToMap(tagsOutput.Tags),
-			}) {
-				allAlarms = append(allAlarms, alarm.AlarmName)
+			}
+			if cfg.ShouldInclude(value) {
+				allAlarms = append(allAlarms, resource.NewResourceDetails(alarm.AlarmName, value).WithARN(alarm.AlarmArn))
 			}
 		}
 
@@ -80,12 +80,13 @@ func listCloudWatchAlarms(ctx context.Context, client CloudWatchAlarmsAPI, scope
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: alarm.AlarmName,
 				Time: alarm.AlarmConfigurationUpdatedTimestamp,
 				Tags: util.ConvertCloudWatchTagsToMap(tagsOutput.Tags),
-			}) {
-				allAlarms = append(allAlarms, alarm.AlarmName)
+			}
+			if cfg.ShouldInclude(value) {
+				allAlarms = append(allAlarms, resource.NewResourceDetails(alarm.AlarmName, value).WithARN(alarm.AlarmArn))
 			}
 		}
 	}
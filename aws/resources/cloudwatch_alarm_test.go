@@ -95,7 +95,7 @@ func TestListCloudWatchAlarms(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listCloudWatchAlarms(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -19,22 +19,22 @@ type CloudWatchDashboardsAPI interface {
 func NewCloudWatchDashboards() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudWatchDashboardsAPI]{
 		ResourceTypeName: "cloudwatch-dashboard",
+		Categories:       []string{resource.CategoryObservability},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CloudWatchDashboardsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudwatch.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudWatchDashboard
-		},
-		Lister: listCloudWatchDashboards,
-		Nuker:  resource.BulkDeleter(deleteCloudWatchDashboards),
+		ConfigKey:      "CloudWatchDashboard",
+		DetailedLister: listCloudWatchDashboards,
+		Nuker:          resource.BulkDeleter(deleteCloudWatchDashboards),
 	})
 }
 
 // listCloudWatchDashboards retrieves all CloudWatch dashboards that match the config filters.
-func listCloudWatchDashboards(ctx context.Context, client CloudWatchDashboardsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allDashboards []*string
+func listCloudWatchDashboards(ctx context.Context, client CloudWatchDashboardsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allDashboards []resource.ResourceDetails
 
 	paginator := cloudwatch.NewListDashboardsPaginator(client, &cloudwatch.ListDashboardsInput{})
 	for paginator.HasMorePages() {
@@ -44,11 +44,12 @@ func listCloudWatchDashboards(ctx context.Context, client CloudWatchDashboardsAP
 		}
 
 		for _, dashboard := range page.DashboardEntries {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: dashboard.DashboardName,
 				Time: dashboard.LastModified,
-			}) {
-				allDashboards = append(allDashboards, dashboard.DashboardName)
+			}
+			if cfg.ShouldInclude(value) {
+				allDashboards = append(allDashboards, resource.NewResourceDetails(dashboard.DashboardName, value).WithARN(dashboard.DashboardArn))
 			}
 		}
 	}
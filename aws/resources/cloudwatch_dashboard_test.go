@@ -42,7 +42,7 @@ func TestListCloudWatchDashboards(t *testing.T) {
 
 	names, err := listCloudWatchDashboards(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"dashboard1", "dashboard2"}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{"dashboard1", "dashboard2"}, resource.IDs(names))
 }
 
 func TestListCloudWatchDashboards_WithFilter(t *testing.T) {
@@ -66,7 +66,7 @@ func TestListCloudWatchDashboards_WithFilter(t *testing.T) {
 
 	names, err := listCloudWatchDashboards(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"dashboard1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"dashboard1"}, resource.IDs(names))
 }
 
 func TestDeleteCloudWatchDashboards(t *testing.T) {
@@ -22,6 +22,7 @@ type CloudWatchLogGroupsAPI interface {
 func NewCloudWatchLogGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[CloudWatchLogGroupsAPI]{
 		ResourceTypeName: "cloudwatch-loggroup",
+		Categories:       []string{resource.CategoryObservability},
 		// Tentative batch size to ensure AWS doesn't throttle. Note that CloudWatch Logs does not support bulk delete,
 		// so we will be deleting this many in parallel using go routines. We pick 35 here, which is half of what the
 		// AWS web console will do. We pick a conservative number here to avoid hitting AWS API rate limits.
@@ -30,17 +31,15 @@ func NewCloudWatchLogGroups() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = cloudwatchlogs.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudWatchLogGroup
-		},
-		Lister: listCloudWatchLogGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteCloudWatchLogGroup),
+		ConfigKey:      "CloudWatchLogGroup",
+		DetailedLister: listCloudWatchLogGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteCloudWatchLogGroup),
 	})
 }
 
 // listCloudWatchLogGroups retrieves all CloudWatch Log Groups that match the config filters.
-func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allLogGroups []*string
+func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allLogGroups []resource.ResourceDetails
 
 	paginator := cloudwatchlogs.NewDescribeLogGroupsPaginator(client, &cloudwatchlogs.DescribeLogGroupsInput{})
 	for paginator.HasMorePages() {
@@ -64,12 +63,13 @@ func listCloudWatchLogGroups(ctx context.Context, client CloudWatchLogGroupsAPI,
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: logGroup.LogGroupName,
 				Time: creationTime,
 				Tags: tagsOutput.Tags,
-			}) {
-				allLogGroups = append(allLogGroups, logGroup.LogGroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				allLogGroups = append(allLogGroups, resource.NewResourceDetails(logGroup.LogGroupName, value).WithARN(logGroup.Arn))
 			}
 		}
 	}
@@ -60,7 +60,7 @@ func TestListCloudWatchLogGroups(t *testing.T) {
 
 	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"log-group-1", "log-group-2"}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{"log-group-1", "log-group-2"}, resource.IDs(names))
 }
 
 func TestListCloudWatchLogGroups_WithFilter(t *testing.T) {
@@ -84,7 +84,7 @@ func TestListCloudWatchLogGroups_WithFilter(t *testing.T) {
 
 	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"log-group-1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"log-group-1"}, resource.IDs(names))
 }
 
 func TestListCloudWatchLogGroups_TagInclusionFilter(t *testing.T) {
@@ -110,7 +110,7 @@ func TestListCloudWatchLogGroups_TagInclusionFilter(t *testing.T) {
 
 	names, err := listCloudWatchLogGroups(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"log-group-2"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"log-group-2"}, resource.IDs(names))
 }
 
 func TestDeleteCloudWatchLogGroup(t *testing.T) {
@@ -25,22 +25,21 @@ type CodeDeployApplicationsAPI interface {
 func NewCodeDeployApplications() AwsResource {
 	return NewAwsResource(&resource.Resource[CodeDeployApplicationsAPI]{
 		ResourceTypeName: "codedeploy-application",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        100,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[CodeDeployApplicationsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = codedeploy.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CodeDeployApplications
-		},
-		Lister: listCodeDeployApplications,
-		Nuker:  resource.SimpleBatchDeleter(deleteCodeDeployApplication),
+		ConfigKey:      "CodeDeployApplications",
+		DetailedLister: listCodeDeployApplications,
+		Nuker:          resource.SimpleBatchDeleter(deleteCodeDeployApplication),
 	})
 }
 
 // listCodeDeployApplications retrieves all CodeDeploy applications that match the config filters.
-func listCodeDeployApplications(ctx context.Context, client CodeDeployApplicationsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listCodeDeployApplications(ctx context.Context, client CodeDeployApplicationsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	accountID, _ := ctx.Value(util.AccountIdKey).(string)
 
@@ -76,12 +75,13 @@ func listCodeDeployApplications(ctx context.Context, client CodeDeployApplicatio
 				}
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: app.ApplicationName,
 				Time: app.CreateTime,
 				Tags: tags,
-			}) {
-				result = append(result, app.ApplicationName)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(app.ApplicationName, value))
 			}
 		}
 	}
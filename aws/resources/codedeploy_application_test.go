@@ -124,7 +124,7 @@ func TestCodeDeployApplication_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listCodeDeployApplications(ctx, client, resource.Scope{Region: region}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
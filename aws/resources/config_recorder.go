@@ -19,32 +19,33 @@ type ConfigServiceRecordersAPI interface {
 func NewConfigServiceRecorders() AwsResource {
 	return NewAwsResource(&resource.Resource[ConfigServiceRecordersAPI]{
 		ResourceTypeName: "config-recorders",
+		Categories:       []string{resource.CategoryObservability},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ConfigServiceRecordersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = configservice.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ConfigServiceRecorder
-		},
-		Lister: listConfigServiceRecorders,
-		Nuker:  resource.SimpleBatchDeleter(deleteConfigServiceRecorder),
+		ConfigKey:      "ConfigServiceRecorder",
+		DetailedLister: listConfigServiceRecorders,
+		Nuker:          resource.SimpleBatchDeleter(deleteConfigServiceRecorder),
 	})
 }
 
 // listConfigServiceRecorders retrieves all Config Service Recorders that match the config filters.
-func listConfigServiceRecorders(ctx context.Context, client ConfigServiceRecordersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listConfigServiceRecorders(ctx context.Context, client ConfigServiceRecordersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.DescribeConfigurationRecorders(ctx, &configservice.DescribeConfigurationRecordersInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var recorderNames []*string
+	var recorderNames []resource.ResourceDetails
 	for _, recorder := range output.ConfigurationRecorders {
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: recorder.Name,
-		}) {
-			recorderNames = append(recorderNames, recorder.Name)
+		}
+		if cfg.ShouldInclude(value) {
+			recorderNames = append(recorderNames, resource.NewResourceDetails(recorder.Name, value).WithARN(recorder.Arn))
 		}
 	}
 
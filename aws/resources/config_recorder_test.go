@@ -40,7 +40,7 @@ func TestListConfigServiceRecorders(t *testing.T) {
 
 	names, err := listConfigServiceRecorders(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"test-recorder-1", "test-recorder-2"}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{"test-recorder-1", "test-recorder-2"}, resource.IDs(names))
 }
 
 func TestListConfigServiceRecorders_WithFilter(t *testing.T) {
@@ -63,7 +63,7 @@ func TestListConfigServiceRecorders_WithFilter(t *testing.T) {
 
 	names, err := listConfigServiceRecorders(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"test-recorder-2"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"test-recorder-2"}, resource.IDs(names))
 }
 
 func TestDeleteConfigServiceRecorder(t *testing.T) {
@@ -25,23 +25,22 @@ type ConfigServiceRuleAPI interface {
 func NewConfigServiceRules() AwsResource {
 	return NewAwsResource(&resource.Resource[ConfigServiceRuleAPI]{
 		ResourceTypeName: "config-rules",
+		Categories:       []string{resource.CategoryObservability},
 		// Simple single-call delete API with high throughput; can handle large batches.
 		BatchSize: 200,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ConfigServiceRuleAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = configservice.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ConfigServiceRule
-		},
-		Lister: listConfigServiceRules,
-		Nuker:  resource.SequentialDeleter(deleteConfigServiceRule),
+		ConfigKey:      "ConfigServiceRule",
+		DetailedLister: listConfigServiceRules,
+		Nuker:          resource.SequentialDeleter(deleteConfigServiceRule),
 	})
 }
 
 // listConfigServiceRules retrieves all Config Service rules that match the config filters.
-func listConfigServiceRules(ctx context.Context, client ConfigServiceRuleAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var configRuleNames []*string
+func listConfigServiceRules(ctx context.Context, client ConfigServiceRuleAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var configRuleNames []resource.ResourceDetails
 
 	paginator := configservice.NewDescribeConfigRulesPaginator(client, &configservice.DescribeConfigRulesInput{})
 	for paginator.HasMorePages() {
@@ -67,11 +66,12 @@ func listConfigServiceRules(ctx context.Context, client ConfigServiceRuleAPI, sc
 				tags = util.ConvertConfigServiceTagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: configRule.ConfigRuleName,
 				Tags: tags,
-			}) {
-				configRuleNames = append(configRuleNames, configRule.ConfigRuleName)
+			}
+			if cfg.ShouldInclude(value) {
+				configRuleNames = append(configRuleNames, resource.NewResourceDetails(configRule.ConfigRuleName, value).WithARN(configRule.ConfigRuleArn))
 			}
 		}
 	}
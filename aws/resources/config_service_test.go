@@ -92,7 +92,7 @@ func TestListConfigServiceRules(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listConfigServiceRules(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
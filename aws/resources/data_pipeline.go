@@ -23,21 +23,20 @@ type DataPipelineAPI interface {
 func NewDataPipeline() AwsResource {
 	return NewAwsResource(&resource.Resource[DataPipelineAPI]{
 		ResourceTypeName: "data-pipeline",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        20,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DataPipelineAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = datapipeline.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DataPipeline
-		},
-		Lister: listDataPipelines,
-		Nuker:  resource.SimpleBatchDeleter(deleteDataPipeline),
+		ConfigKey:      "DataPipeline",
+		DetailedLister: listDataPipelines,
+		Nuker:          resource.SimpleBatchDeleter(deleteDataPipeline),
 	})
 }
 
-func listDataPipelines(ctx context.Context, client DataPipelineAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listDataPipelines(ctx context.Context, client DataPipelineAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := datapipeline.NewListPipelinesPaginator(client, &datapipeline.ListPipelinesInput{})
 	for paginator.HasMorePages() {
@@ -88,7 +87,7 @@ func listDataPipelines(ctx context.Context, client DataPipelineAPI, scope resour
 				}
 
 				if cfg.ShouldInclude(rv) {
-					identifiers = append(identifiers, aws.String(pipelineID))
+					identifiers = append(identifiers, resource.NewResourceDetails(aws.String(pipelineID), rv))
 				}
 			}
 		}
@@ -160,7 +160,7 @@ func TestListDataPipelines(t *testing.T) {
 			mock := tc.mock
 			ids, err := listDataPipelines(context.Background(), &mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
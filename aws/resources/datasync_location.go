@@ -23,17 +23,17 @@ type DataSyncLocationAPI interface {
 func NewDataSyncLocation() AwsResource {
 	return NewAwsResource(&resource.Resource[DataSyncLocationAPI]{
 		ResourceTypeName: "data-sync-location",
+		Categories:       []string{resource.CategoryStorage},
+		DependsOn:        []string{"data-sync-task"},
 		// DataSync API limit is 20 requests; using 19 to stay safely under the limit.
 		BatchSize: 19,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DataSyncLocationAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = datasync.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DataSyncLocation
-		},
-		Lister: listDataSyncLocations,
-		Nuker:  resource.SimpleBatchDeleter(deleteDataSyncLocation),
+		ConfigKey:      "DataSyncLocation",
+		DetailedLister: listDataSyncLocations,
+		Nuker:          resource.SimpleBatchDeleter(deleteDataSyncLocation),
 	})
 }
 
@@ -41,8 +41,8 @@ func NewDataSyncLocation() AwsResource {
 // Note: The ListLocations API returns only LocationArn and LocationUri. It does not include
 // Name, CreationTime, or Tags. We use LocationUri as the name for filtering purposes since
 // it contains descriptive information about the location (e.g., "s3://bucket-name/prefix").
-func listDataSyncLocations(ctx context.Context, client DataSyncLocationAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listDataSyncLocations(ctx context.Context, client DataSyncLocationAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := datasync.NewListLocationsPaginator(client, &datasync.ListLocationsInput{
 		MaxResults: aws.Int32(100),
@@ -69,11 +69,12 @@ func listDataSyncLocations(ctx context.Context, client DataSyncLocationAPI, scop
 
 			// Use LocationUri as the name for filtering since LocationListEntry
 			// does not include a Name field or CreationTime.
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: location.LocationUri,
 				Tags: tags,
-			}) {
-				identifiers = append(identifiers, location.LocationArn)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(location.LocationArn, value).WithARN(location.LocationArn))
 			}
 		}
 	}
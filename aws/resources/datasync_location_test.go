@@ -90,7 +90,7 @@ func TestListDataSyncLocations(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listDataSyncLocations(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
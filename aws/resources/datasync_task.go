@@ -22,23 +22,22 @@ type DataSyncTaskAPI interface {
 func NewDataSyncTask() AwsResource {
 	return NewAwsResource(&resource.Resource[DataSyncTaskAPI]{
 		ResourceTypeName: "data-sync-task",
+		Categories:       []string{resource.CategoryStorage},
 		// DataSync API limit is 20 requests; using 19 to stay safely under the limit.
 		BatchSize: 19,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DataSyncTaskAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = datasync.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DataSyncTask
-		},
-		Lister: listDataSyncTasks,
-		Nuker:  resource.SimpleBatchDeleter(deleteDataSyncTask),
+		ConfigKey:      "DataSyncTask",
+		DetailedLister: listDataSyncTasks,
+		Nuker:          resource.SimpleBatchDeleter(deleteDataSyncTask),
 	})
 }
 
 // listDataSyncTasks retrieves all DataSync tasks that match the config filters.
-func listDataSyncTasks(ctx context.Context, client DataSyncTaskAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listDataSyncTasks(ctx context.Context, client DataSyncTaskAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := datasync.NewListTasksPaginator(client, &datasync.ListTasksInput{
 		MaxResults: aws.Int32(100),
@@ -63,11 +62,12 @@ func listDataSyncTasks(ctx context.Context, client DataSyncTaskAPI, scope resour
 				tags = util.ConvertDataSyncTagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: task.Name,
 				Tags: tags,
-			}) {
-				identifiers = append(identifiers, task.TaskArn)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(task.TaskArn, value).WithARN(task.TaskArn))
 			}
 		}
 	}
@@ -82,7 +82,7 @@ func TestListDataSyncTasks(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listDataSyncTasks(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
@@ -26,22 +26,21 @@ type DynamoDBAPI interface {
 func NewDynamoDB() AwsResource {
 	return NewAwsResource(&resource.Resource[DynamoDBAPI]{
 		ResourceTypeName: "dynamodb",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize, // Tentative batch size to ensure AWS doesn't throttle
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DynamoDBAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = dynamodb.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DynamoDB
-		},
-		Lister: listDynamoDBTables,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDynamoDBTable, waitForDynamoDBTablesDeleted),
+		ConfigKey:      "DynamoDB",
+		DetailedLister: listDynamoDBTables,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteDynamoDBTable, waitForDynamoDBTablesDeleted),
 	})
 }
 
 // listDynamoDBTables retrieves all DynamoDB tables that match the config filters.
-func listDynamoDBTables(ctx context.Context, client DynamoDBAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var tableNames []*string
+func listDynamoDBTables(ctx context.Context, client DynamoDBAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var tableNames []resource.ResourceDetails
 
 	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
 	for paginator.HasMorePages() {
@@ -64,12 +63,13 @@ func listDynamoDBTables(ctx context.Context, client DynamoDBAPI, scope resource.
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: tableDetail.Table.CreationDateTime,
 				Name: tableDetail.Table.TableName,
 				Tags: util.ConvertDynamoDBTagsToMap(tagsOutput.Tags),
-			}) {
-				tableNames = append(tableNames, aws.String(table))
+			}
+			if cfg.ShouldInclude(value) {
+				tableNames = append(tableNames, resource.NewResourceDetails(aws.String(table), value))
 			}
 		}
 	}
@@ -136,7 +136,7 @@ func TestListDynamoDBTables(t *testing.T) {
 			t.Parallel()
 			names, err := listDynamoDBTables(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
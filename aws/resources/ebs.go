@@ -23,15 +23,15 @@ type EBSVolumesAPI interface {
 func NewEBSVolumes() AwsResource {
 	return NewAwsResource(&resource.Resource[EBSVolumesAPI]{
 		ResourceTypeName: "ebs",
+		Categories:       []string{resource.CategoryStorage},
+		DependsOn:        []string{"ec2"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EBSVolumesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EBSVolume
-		},
-		Lister:             listEBSVolumes,
+		ConfigKey:          "EBSVolume",
+		DetailedLister:     listEBSVolumes,
 		Nuker:              resource.SequentialDeleteThenWaitAll(deleteEBSVolume, waitForEBSVolumesDeleted),
 		PermissionVerifier: verifyEBSVolumePermission,
 	})
@@ -39,8 +39,8 @@ func NewEBSVolumes() AwsResource {
 
 // listEBSVolumes retrieves all EBS volumes that match the config filters.
 // Only lists volumes in deletable states: available, creating, or error.
-func listEBSVolumes(ctx context.Context, client EBSVolumesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var volumeIds []*string
+func listEBSVolumes(ctx context.Context, client EBSVolumesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var volumes []resource.ResourceDetails
 
 	// Only list volumes eligible for deletion (not in-use or deleting)
 	statusFilter := types.Filter{
@@ -59,17 +59,23 @@ func listEBSVolumes(ctx context.Context, client EBSVolumesAPI, scope resource.Sc
 		}
 
 		for _, volume := range page.Volumes {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: util.GetEC2ResourceNameTagValue(volume.Tags),
 				Time: volume.CreateTime,
 				Tags: util.ConvertTypesTagsToMap(volume.Tags),
-			}) {
-				volumeIds = append(volumeIds, volume.VolumeId)
+				Attributes: map[string]interface{}{
+					"size_gib":    int64(aws.ToInt32(volume.Size)),
+					"volume_type": string(volume.VolumeType),
+					"state":       string(volume.State),
+				},
+			}
+			if cfg.ShouldInclude(value) {
+				volumes = append(volumes, resource.NewResourceDetails(volume.VolumeId, value))
 			}
 		}
 	}
 
-	return volumeIds, nil
+	return volumes, nil
 }
 
 // verifyEBSVolumePermission performs a dry-run delete to check permissions.
@@ -40,6 +40,7 @@ func TestListEBSVolumes(t *testing.T) {
 				{
 					VolumeId:   aws.String(testVolume1),
 					CreateTime: aws.Time(now),
+					Size:       aws.Int32(8),
 					Tags: []types.Tag{{
 						Key:   aws.String("Name"),
 						Value: aws.String("test-name1"),
@@ -48,6 +49,7 @@ func TestListEBSVolumes(t *testing.T) {
 				{
 					VolumeId:   aws.String(testVolume2),
 					CreateTime: aws.Time(now.Add(1 * time.Hour)),
+					Size:       aws.Int32(500),
 					Tags: []types.Tag{{
 						Key:   aws.String("Name"),
 						Value: aws.String("test-name2"),
@@ -57,6 +59,9 @@ func TestListEBSVolumes(t *testing.T) {
 		},
 	}
 
+	largeVolumes, err := config.ParseFilterExpression(`attributes.size_gib > 100`)
+	require.NoError(t, err)
+
 	tests := map[string]struct {
 		configObj config.ResourceType
 		expected  []string
@@ -81,13 +86,19 @@ func TestListEBSVolumes(t *testing.T) {
 			},
 			expected: []string{},
 		},
+		"attributeExpressionFilter": {
+			configObj: config.ResourceType{
+				IncludeRule: config.FilterRule{Expression: largeVolumes},
+			},
+			expected: []string{testVolume2},
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			ids, err := listEBSVolumes(context.Background(), mock, resource.Scope{}, tc.configObj)
+			volumes, err := listEBSVolumes(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(volumes))
 		})
 	}
 }
@@ -26,16 +26,15 @@ type EC2InstancesAPI interface {
 func NewEC2Instances() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2InstancesAPI]{
 		ResourceTypeName: "ec2",
+		Categories:       []string{resource.CategoryCompute},
 		// Tentative batch size to ensure AWS doesn't throttle
 		BatchSize: DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2InstancesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2
-		},
-		Lister: listEC2Instances,
+		ConfigKey:      "EC2",
+		DetailedLister: listEC2Instances,
 		Nuker: resource.MultiStepDeleter(
 			releaseInstanceEIPs,
 			terminateEC2Instance,
@@ -45,7 +44,7 @@ func NewEC2Instances() AwsResource {
 }
 
 // listEC2Instances retrieves all EC2 instances that match the config filters.
-func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	params := &ec2.DescribeInstancesInput{
 		Filters: []types.Filter{
 			{
@@ -58,7 +57,7 @@ func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resourc
 		},
 	}
 
-	var allInstanceIds []*string
+	var allInstances []resource.ResourceDetails
 	paginator := ec2.NewDescribeInstancesPaginator(client, params)
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -66,19 +65,19 @@ func listEC2Instances(ctx context.Context, client EC2InstancesAPI, scope resourc
 			return nil, errors.WithStackTrace(err)
 		}
 
-		instanceIds, err := filterOutProtectedInstances(ctx, client, page, cfg)
+		instances, err := filterOutProtectedInstances(ctx, client, page, cfg)
 		if err != nil {
 			return nil, errors.WithStackTrace(err)
 		}
-		allInstanceIds = append(allInstanceIds, instanceIds...)
+		allInstances = append(allInstances, instances...)
 	}
 
-	return allInstanceIds, nil
+	return allInstances, nil
 }
 
-// filterOutProtectedInstances returns only instance IDs of unprotected EC2 instances
-func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, output *ec2.DescribeInstancesOutput, cfg config.ResourceType) ([]*string, error) {
-	var filteredIds []*string
+// filterOutProtectedInstances returns only the details of unprotected EC2 instances
+func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, output *ec2.DescribeInstancesOutput, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var filtered []resource.ResourceDetails
 	for _, reservation := range output.Reservations {
 		for _, instance := range reservation.Instances {
 			instanceID := *instance.InstanceId
@@ -91,28 +90,29 @@ func filterOutProtectedInstances(ctx context.Context, client EC2InstancesAPI, ou
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if shouldIncludeInstanceId(instance, *attr.DisableApiTermination.Value, cfg) {
-				filteredIds = append(filteredIds, &instanceID)
+			if value, ok := shouldIncludeInstanceId(instance, *attr.DisableApiTermination.Value, cfg); ok {
+				filtered = append(filtered, resource.NewResourceDetails(&instanceID, value))
 			}
 		}
 	}
 
-	return filteredIds, nil
+	return filtered, nil
 }
 
-func shouldIncludeInstanceId(instance types.Instance, protected bool, cfg config.ResourceType) bool {
+func shouldIncludeInstanceId(instance types.Instance, protected bool, cfg config.ResourceType) (config.ResourceValue, bool) {
 	if protected {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
 	// Ignore this error and pass empty string to config.ShouldInclude
 	instanceName := util.GetEC2ResourceNameTagValue(instance.Tags)
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: instanceName,
 		Time: instance.LaunchTime,
 		Tags: util.ConvertTypesTagsToMap(instance.Tags),
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // releaseInstanceEIPs releases any Elastic IPs associated with a single EC2 instance.
@@ -24,22 +24,22 @@ type EC2DedicatedHostsAPI interface {
 func NewEC2DedicatedHosts() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2DedicatedHostsAPI]{
 		ResourceTypeName: "ec2-dedicated-hosts",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"ec2"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2DedicatedHostsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2DedicatedHosts
-		},
-		Lister: listEC2DedicatedHosts,
-		Nuker:  resource.BulkResultDeleter(releaseEC2DedicatedHosts),
+		ConfigKey:      "EC2DedicatedHosts",
+		DetailedLister: listEC2DedicatedHosts,
+		Nuker:          resource.BulkResultDeleter(releaseEC2DedicatedHosts),
 	})
 }
 
 // listEC2DedicatedHosts retrieves all EC2 dedicated hosts that match the config filters.
-func listEC2DedicatedHosts(ctx context.Context, client EC2DedicatedHostsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var hostIds []*string
+func listEC2DedicatedHosts(ctx context.Context, client EC2DedicatedHostsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var hostIds []resource.ResourceDetails
 	describeHostsInput := &ec2.DescribeHostsInput{
 		Filter: []types.Filter{
 			{
@@ -61,8 +61,8 @@ func listEC2DedicatedHosts(ctx context.Context, client EC2DedicatedHostsAPI, sco
 		}
 
 		for _, host := range page.Hosts {
-			if shouldIncludeHostId(&host, cfg) {
-				hostIds = append(hostIds, host.HostId)
+			if value, ok := shouldIncludeHostId(&host, cfg); ok {
+				hostIds = append(hostIds, resource.NewResourceDetails(host.HostId, value))
 			}
 		}
 	}
@@ -70,27 +70,28 @@ func listEC2DedicatedHosts(ctx context.Context, client EC2DedicatedHostsAPI, sco
 	return hostIds, nil
 }
 
-// shouldIncludeHostId determines if an EC2 dedicated host should be included for deletion.
-func shouldIncludeHostId(host *types.Host, cfg config.ResourceType) bool {
+// shouldIncludeHostId determines if an EC2 dedicated host should be included for deletion, returning the value it was filtered on.
+func shouldIncludeHostId(host *types.Host, cfg config.ResourceType) (config.ResourceValue, bool) {
 	if host == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// If an instance is using the host allocation we cannot release it
 	if len(host.Instances) != 0 {
 		logging.Debugf("Host %s has instance(s) still associated, unable to nuke.", *host.HostId)
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// If Name is unset, GetEC2ResourceNameTagValue returns error and zero value string
 	// Ignore this error and pass empty string to config.ShouldInclude
 	hostNameTagValue := util.GetEC2ResourceNameTagValue(host.Tags)
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: hostNameTagValue,
 		Time: host.AllocationTime,
 		Tags: util.ConvertTypesTagsToMap(host.Tags),
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // releaseEC2DedicatedHosts releases EC2 dedicated hosts and returns per-item results.
@@ -92,7 +92,7 @@ func TestListEC2DedicatedHosts(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listEC2DedicatedHosts(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
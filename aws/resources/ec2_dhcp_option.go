@@ -25,15 +25,15 @@ type EC2DhcpOptionAPI interface {
 func NewEC2DhcpOptions() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2DhcpOptionAPI]{
 		ResourceTypeName: "ec2-dhcp-option",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"vpc"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2DhcpOptionAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2DHCPOption // Fixed: Now correctly using EC2DHCPOption config
-		},
-		Lister: listEC2DhcpOptions,
+		ConfigKey:      "EC2DHCPOption",
+		DetailedLister: listEC2DhcpOptions,
 		Nuker: resource.MultiStepDeleter(
 			disassociateDhcpOption,
 			deleteDhcpOption,
@@ -50,8 +50,8 @@ func NewEC2DhcpOptions() AwsResource {
 
 // listEC2DhcpOptions returns a list of DHCP option IDs that are eligible for nuking.
 // It filters out DHCP options that are associated with default VPCs.
-func listEC2DhcpOptions(ctx context.Context, client EC2DhcpOptionAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var dhcpOptionIds []*string
+func listEC2DhcpOptions(ctx context.Context, client EC2DhcpOptionAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var dhcpOptionIds []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeDhcpOptionsPaginator(client, &ec2.DescribeDhcpOptionsInput{})
 	for paginator.HasMorePages() {
@@ -86,11 +86,12 @@ func listEC2DhcpOptions(ctx context.Context, client EC2DhcpOptionAPI, scope reso
 				}
 			}
 
-			if isEligibleForNuke && cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: dhcpOption.DhcpOptionsId,
 				Tags: util.ConvertTypesTagsToMap(dhcpOption.Tags),
-			}) {
-				dhcpOptionIds = append(dhcpOptionIds, dhcpOption.DhcpOptionsId)
+			}
+			if isEligibleForNuke && cfg.ShouldInclude(value) {
+				dhcpOptionIds = append(dhcpOptionIds, resource.NewResourceDetails(dhcpOption.DhcpOptionsId, value))
 			}
 		}
 	}
@@ -88,7 +88,7 @@ func TestEC2DhcpOption_List(t *testing.T) {
 
 			ids, err := listEC2DhcpOptions(context.Background(), client, resource.Scope{Region: "us-east-1"}, config.ResourceType{})
 			require.NoError(t, err)
-			require.Equal(t, tc.expectedIDs, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expectedIDs, resource.IDs(ids))
 		})
 	}
 }
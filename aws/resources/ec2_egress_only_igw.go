@@ -20,23 +20,22 @@ type EgressOnlyIGAPI interface {
 func NewEgressOnlyInternetGateway() AwsResource {
 	return NewAwsResource(&resource.Resource[EgressOnlyIGAPI]{
 		ResourceTypeName: "egress-only-internet-gateway",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EgressOnlyIGAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EgressOnlyInternetGateway
-		},
-		Lister:             listEgressOnlyInternetGateways,
+		ConfigKey:          "EgressOnlyInternetGateway",
+		DetailedLister:     listEgressOnlyInternetGateways,
 		Nuker:              resource.SimpleBatchDeleter(deleteEgressOnlyInternetGateway),
 		PermissionVerifier: verifyEgressOnlyInternetGatewayPermission,
 	})
 }
 
 // listEgressOnlyInternetGateways retrieves all Egress Only Internet Gateways that match the config filters.
-func listEgressOnlyInternetGateways(ctx context.Context, client EgressOnlyIGAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var gatewayIds []*string
+func listEgressOnlyInternetGateways(ctx context.Context, client EgressOnlyIGAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var gatewayIds []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeEgressOnlyInternetGatewaysPaginator(client, &ec2.DescribeEgressOnlyInternetGatewaysInput{})
 	for paginator.HasMorePages() {
@@ -46,11 +45,12 @@ func listEgressOnlyInternetGateways(ctx context.Context, client EgressOnlyIGAPI,
 		}
 
 		for _, gateway := range page.EgressOnlyInternetGateways {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: util.GetEC2ResourceNameTagValue(gateway.Tags),
 				Tags: util.ConvertTypesTagsToMap(gateway.Tags),
-			}) {
-				gatewayIds = append(gatewayIds, gateway.EgressOnlyInternetGatewayId)
+			}
+			if cfg.ShouldInclude(value) {
+				gatewayIds = append(gatewayIds, resource.NewResourceDetails(gateway.EgressOnlyInternetGatewayId, value))
 			}
 		}
 	}
@@ -50,7 +50,7 @@ func TestListEgressOnlyInternetGateways(t *testing.T) {
 
 	ids, err := listEgressOnlyInternetGateways(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"eigw-001", "eigw-002"}, aws.ToStringSlice(ids))
+	require.ElementsMatch(t, []string{"eigw-001", "eigw-002"}, resource.IDs(ids))
 }
 
 func TestListEgressOnlyInternetGateways_WithFilter(t *testing.T) {
@@ -83,7 +83,7 @@ func TestListEgressOnlyInternetGateways_WithFilter(t *testing.T) {
 
 	ids, err := listEgressOnlyInternetGateways(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"eigw-001"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{"eigw-001"}, resource.IDs(ids))
 }
 
 func TestDeleteEgressOnlyInternetGateway(t *testing.T) {
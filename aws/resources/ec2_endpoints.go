@@ -30,15 +30,18 @@ func NewEC2Endpoints() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.EC2Endpoint },
+		"EC2Endpoint",
 		listEC2Endpoints,
 		resource.ConcurrentDeleteThenWaitAll(deleteEC2Endpoint, waitForEndpointsDeleted),
-		&EC2ResourceOptions[EC2EndpointsAPI]{PermissionVerifier: verifyEC2EndpointPermission},
+		&EC2ResourceOptions[EC2EndpointsAPI]{
+			PermissionVerifier: verifyEC2EndpointPermission,
+			Categories:         []string{resource.CategoryNetwork},
+		},
 	)
 }
 
 // listEC2Endpoints retrieves all VPC endpoints that match the config filters.
-func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
 	// When defaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
 	if defaultOnly {
@@ -59,7 +62,7 @@ func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resourc
 		}
 	}
 
-	var result []*string
+	var result []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeVpcEndpointsPaginator(client, &ec2.DescribeVpcEndpointsInput{})
 	for paginator.HasMorePages() {
@@ -93,12 +96,13 @@ func listEC2Endpoints(ctx context.Context, client EC2EndpointsAPI, scope resourc
 				endpointName = name
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &endpointName,
 				Time: firstSeenTime,
 				Tags: tagMap,
-			}) {
-				result = append(result, endpoint.VpcEndpointId)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(endpoint.VpcEndpointId, value))
 			}
 		}
 	}
@@ -145,14 +145,14 @@ func TestListEC2Endpoints(t *testing.T) {
 		}
 		ids, err := listEC2Endpoints(ctx, mockWithReqManaged, resource.Scope{}, config.ResourceType{}, false)
 		require.NoError(t, err)
-		require.Equal(t, []string{endpoint1, nilReqManagedEndpoint}, aws.ToStringSlice(ids))
+		require.Equal(t, []string{endpoint1, nilReqManagedEndpoint}, resource.IDs(ids))
 	})
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listEC2Endpoints(ctx, mock, resource.Scope{}, tc.configObj, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -31,10 +31,14 @@ func NewInternetGateway() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.InternetGateway },
+		"InternetGateway",
 		listInternetGateways,
 		resource.MultiStepDeleter(detachInternetGateway, deleteInternetGateway),
-		&EC2ResourceOptions[InternetGatewayAPI]{PermissionVerifier: verifyInternetGatewayPermission},
+		&EC2ResourceOptions[InternetGatewayAPI]{
+			Categories:         []string{resource.CategoryNetwork},
+			PermissionVerifier: verifyInternetGatewayPermission,
+			DependsOn:          []string{"ec2", "eip", "nat-gateway"},
+		},
 	)
 }
 
@@ -49,7 +53,7 @@ func verifyInternetGatewayPermission(ctx context.Context, client InternetGateway
 
 // listInternetGateways retrieves all Internet Gateways that match the config filters.
 // When defaultOnly is true, only IGWs attached to default VPCs are returned (for defaults-aws command).
-func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
 	// When defaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
 	if defaultOnly {
@@ -71,7 +75,7 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 		}
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	paginator := ec2.NewDescribeInternetGatewaysPaginator(client, &ec2.DescribeInternetGatewaysInput{})
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -102,8 +106,8 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 				return nil, err
 			}
 
-			if shouldIncludeInternetGateway(ig, firstSeenTime, cfg) {
-				identifiers = append(identifiers, ig.InternetGatewayId)
+			if value, ok := shouldIncludeInternetGateway(ig, firstSeenTime, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(ig.InternetGatewayId, value))
 			}
 		}
 	}
@@ -111,19 +115,20 @@ func listInternetGateways(ctx context.Context, client InternetGatewayAPI, scope
 	return identifiers, nil
 }
 
-// shouldIncludeInternetGateway determines if an internet gateway should be included based on config filters.
-func shouldIncludeInternetGateway(ig types.InternetGateway, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeInternetGateway determines if an internet gateway should be included based on config filters, returning the value it was filtered on.
+func shouldIncludeInternetGateway(ig types.InternetGateway, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	tagMap := util.ConvertTypesTagsToMap(ig.Tags)
 	var name string
 	if n, ok := tagMap["Name"]; ok {
 		name = n
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // detachInternetGateway detaches an internet gateway from its VPC.
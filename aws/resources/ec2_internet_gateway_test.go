@@ -102,7 +102,7 @@ func TestInternetGateway_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listInternetGateways(ctx, mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -30,23 +30,23 @@ type EC2IPAMAPI interface {
 func NewEC2IPAM() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2IPAMAPI]{
 		ResourceTypeName: "ipam",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"ipam-scope", "ipam-pool", "ipam-resource-discovery", "ipam-byoasn"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAM
-		},
-		Lister:             listEC2IPAMs,
+		ConfigKey:          "EC2IPAM",
+		DetailedLister:     listEC2IPAMs,
 		Nuker:              resource.SequentialDeleter(nukeEC2IPAM),
 		PermissionVerifier: verifyEC2IPAMPermission,
 	})
 }
 
 // listEC2IPAMs retrieves all IPAMs that match the config filters.
-func listEC2IPAMs(ctx context.Context, client EC2IPAMAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listEC2IPAMs(ctx context.Context, client EC2IPAMAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeIpamsPaginator(client, &ec2.DescribeIpamsInput{
 		MaxResults: aws.Int32(10),
@@ -70,12 +70,13 @@ func listEC2IPAMs(ctx context.Context, client EC2IPAMAPI, scope resource.Scope,
 				ipamName = name
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &ipamName,
 				Time: firstSeenTime,
 				Tags: tagMap,
-			}) {
-				result = append(result, ipam.IpamId)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(ipam.IpamId, value).WithARN(ipam.IpamArn))
 			}
 		}
 	}
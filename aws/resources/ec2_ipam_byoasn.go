@@ -19,15 +19,15 @@ type EC2IPAMByoasnAPI interface {
 func NewEC2IPAMByoasn() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2IPAMByoasnAPI]{
 		ResourceTypeName: "ipam-byoasn",
+		Categories:       []string{resource.CategoryNetwork},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMByoasnAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAMByoasn
-		},
-		Lister:             listEC2IPAMByoasns,
+		ConfigKey:          "EC2IPAMByoasn",
+		DetailedLister:     listEC2IPAMByoasns,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2IPAMByoasn),
 		PermissionVerifier: verifyEC2IPAMByoasnPermission,
 	})
@@ -35,8 +35,8 @@ func NewEC2IPAMByoasn() AwsResource {
 
 // listEC2IPAMByoasns retrieves all IPAM BYOASNs.
 // Note: DescribeIpamByoasn does not support pagination.
-func listEC2IPAMByoasns(ctx context.Context, client EC2IPAMByoasnAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listEC2IPAMByoasns(ctx context.Context, client EC2IPAMByoasnAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	output, err := client.DescribeIpamByoasn(ctx, &ec2.DescribeIpamByoasnInput{
 		MaxResults: aws.Int32(10),
@@ -46,10 +46,11 @@ func listEC2IPAMByoasns(ctx context.Context, client EC2IPAMByoasnAPI, scope reso
 	}
 
 	for _, byoasn := range output.Byoasns {
-		if !cfg.ShouldInclude(config.ResourceValue{Name: byoasn.Asn}) {
+		value := config.ResourceValue{Name: byoasn.Asn}
+		if !cfg.ShouldInclude(value) {
 			continue
 		}
-		result = append(result, byoasn.Asn)
+		result = append(result, resource.NewResourceDetails(byoasn.Asn, value))
 	}
 
 	return result, nil
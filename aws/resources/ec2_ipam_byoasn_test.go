@@ -69,7 +69,7 @@ func TestListEC2IPAMByoasns(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listEC2IPAMByoasns(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -34,14 +34,14 @@ func NewEC2IPAMCustomAllocation() AwsResource {
 
 	return NewAwsResource(&resource.Resource[EC2IPAMCustomAllocationAPI]{
 		ResourceTypeName: "ipam-custom-allocation",
+		Categories:       []string{resource.CategoryNetwork},
+		NoTags:           true,
 		BatchSize:        1000,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMCustomAllocationAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAMCustomAllocation
-		},
+		ConfigKey: "EC2IPAMCustomAllocation",
 		Lister: func(ctx context.Context, client EC2IPAMCustomAllocationAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
 			return listEC2IPAMCustomAllocations(ctx, client, poolAndAllocationMap)
 		},
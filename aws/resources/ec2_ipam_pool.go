@@ -22,23 +22,23 @@ type EC2IPAMPoolAPI interface {
 func NewEC2IPAMPool() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2IPAMPoolAPI]{
 		ResourceTypeName: "ipam-pool",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"ipam-custom-allocation"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMPoolAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAMPool
-		},
-		Lister:             listEC2IPAMPools,
+		ConfigKey:          "EC2IPAMPool",
+		DetailedLister:     listEC2IPAMPools,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2IPAMPool),
 		PermissionVerifier: verifyEC2IPAMPoolPermission,
 	})
 }
 
 // listEC2IPAMPools retrieves all IPAM pools in "create-complete" state that match the config filters.
-func listEC2IPAMPools(ctx context.Context, client EC2IPAMPoolAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listEC2IPAMPools(ctx context.Context, client EC2IPAMPoolAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeIpamPoolsPaginator(client, &ec2.DescribeIpamPoolsInput{
 		MaxResults: aws.Int32(10),
@@ -69,12 +69,13 @@ func listEC2IPAMPools(ctx context.Context, client EC2IPAMPoolAPI, scope resource
 				poolName = name
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &poolName,
 				Time: firstSeenTime,
 				Tags: tagMap,
-			}) {
-				result = append(result, pool.IpamPoolId)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(pool.IpamPoolId, value).WithARN(pool.IpamPoolArn))
 			}
 		}
 	}
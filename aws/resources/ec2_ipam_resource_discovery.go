@@ -22,23 +22,22 @@ type EC2IPAMResourceDiscoveryAPI interface {
 func NewEC2IPAMResourceDiscovery() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2IPAMResourceDiscoveryAPI]{
 		ResourceTypeName: "ipam-resource-discovery",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMResourceDiscoveryAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAMResourceDiscovery
-		},
-		Lister:             listEC2IPAMResourceDiscoveries,
+		ConfigKey:          "EC2IPAMResourceDiscovery",
+		DetailedLister:     listEC2IPAMResourceDiscoveries,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2IPAMResourceDiscovery),
 		PermissionVerifier: verifyEC2IPAMResourceDiscoveryPermission,
 	})
 }
 
 // listEC2IPAMResourceDiscoveries retrieves all non-default IPAM resource discoveries that match the config filters.
-func listEC2IPAMResourceDiscoveries(ctx context.Context, client EC2IPAMResourceDiscoveryAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listEC2IPAMResourceDiscoveries(ctx context.Context, client EC2IPAMResourceDiscoveryAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeIpamResourceDiscoveriesPaginator(client, &ec2.DescribeIpamResourceDiscoveriesInput{
 		MaxResults: aws.Int32(10),
@@ -69,12 +68,13 @@ func listEC2IPAMResourceDiscoveries(ctx context.Context, client EC2IPAMResourceD
 				discoveryName = name
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &discoveryName,
 				Time: firstSeenTime,
 				Tags: tagMap,
-			}) {
-				result = append(result, discovery.IpamResourceDiscoveryId)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(discovery.IpamResourceDiscoveryId, value).WithARN(discovery.IpamResourceDiscoveryArn))
 			}
 		}
 	}
@@ -90,7 +90,7 @@ func TestListEC2IPAMResourceDiscoveries(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listEC2IPAMResourceDiscoveries(ctx, mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
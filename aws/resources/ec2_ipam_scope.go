@@ -22,23 +22,23 @@ type EC2IPAMScopeAPI interface {
 func NewEC2IPAMScope() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2IPAMScopeAPI]{
 		ResourceTypeName: "ipam-scope",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"ipam-pool"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2IPAMScopeAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2IPAMScope
-		},
-		Lister:             listEC2IPAMScopes,
+		ConfigKey:          "EC2IPAMScope",
+		DetailedLister:     listEC2IPAMScopes,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2IPAMScope),
 		PermissionVerifier: verifyEC2IPAMScopePermission,
 	})
 }
 
 // listEC2IPAMScopes retrieves all non-default IPAM scopes that match the config filters.
-func listEC2IPAMScopes(ctx context.Context, client EC2IPAMScopeAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listEC2IPAMScopes(ctx context.Context, client EC2IPAMScopeAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeIpamScopesPaginator(client, &ec2.DescribeIpamScopesInput{
 		MaxResults: aws.Int32(10),
@@ -69,12 +69,13 @@ func listEC2IPAMScopes(ctx context.Context, client EC2IPAMScopeAPI, scope resour
 				scopeName = name
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &scopeName,
 				Time: firstSeenTime,
 				Tags: tagMap,
-			}) {
-				result = append(result, ipamScope.IpamScopeId)
+			}
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(ipamScope.IpamScopeId, value).WithARN(ipamScope.IpamScopeArn))
 			}
 		}
 	}
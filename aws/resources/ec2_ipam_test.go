@@ -125,7 +125,7 @@ func TestListEC2IPAMs(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listEC2IPAMs(ctx, mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -20,36 +20,36 @@ type EC2KeyPairsAPI interface {
 func NewEC2KeyPairs() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2KeyPairsAPI]{
 		ResourceTypeName: "ec2-keypairs",
+		Categories:       []string{resource.CategoryCompute},
 		// Simple single-call delete API with high throughput; can handle large batches.
 		BatchSize: 200,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2KeyPairsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2KeyPairs
-		},
-		Lister:             listEC2KeyPairs,
+		ConfigKey:          "EC2KeyPairs",
+		DetailedLister:     listEC2KeyPairs,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2KeyPair),
 		PermissionVerifier: verifyEC2KeyPairPermission,
 	})
 }
 
 // listEC2KeyPairs retrieves all EC2 key pairs that match the config filters.
-func listEC2KeyPairs(ctx context.Context, client EC2KeyPairsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEC2KeyPairs(ctx context.Context, client EC2KeyPairsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	result, err := client.DescribeKeyPairs(ctx, &ec2.DescribeKeyPairsInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var ids []*string
+	var ids []resource.ResourceDetails
 	for _, keyPair := range result.KeyPairs {
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: keyPair.KeyName,
 			Time: keyPair.CreateTime,
 			Tags: util.ConvertTypesTagsToMap(keyPair.Tags),
-		}) {
-			ids = append(ids, keyPair.KeyPairId)
+		}
+		if cfg.ShouldInclude(value) {
+			ids = append(ids, resource.NewResourceDetails(keyPair.KeyPairId, value))
 		}
 	}
 
@@ -77,7 +77,7 @@ func TestListEC2KeyPairs(t *testing.T) {
 
 			ids, err := listEC2KeyPairs(context.Background(), mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
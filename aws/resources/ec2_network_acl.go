@@ -25,23 +25,22 @@ type NetworkACLAPI interface {
 func NewNetworkACL() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkACLAPI]{
 		ResourceTypeName: "network-acl",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkACLAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkACL
-		},
-		Lister:             listNetworkACLs,
+		ConfigKey:          "NetworkACL",
+		DetailedLister:     listNetworkACLs,
 		Nuker:              resource.MultiStepDeleter(replaceNetworkACLAssociations, deleteNetworkACL),
 		PermissionVerifier: verifyNetworkACLNukePermission,
 	})
 }
 
 // listNetworkACLs retrieves all non-default Network ACLs that match the config filters.
-func listNetworkACLs(ctx context.Context, client NetworkACLAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listNetworkACLs(ctx context.Context, client NetworkACLAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeNetworkAclsPaginator(client, &ec2.DescribeNetworkAclsInput{
 		Filters: []types.Filter{
@@ -67,8 +66,8 @@ func listNetworkACLs(ctx context.Context, client NetworkACLAPI, scope resource.S
 				continue
 			}
 
-			if shouldIncludeNetworkACL(&networkAcl, firstSeenTime, cfg) {
-				identifiers = append(identifiers, networkAcl.NetworkAclId)
+			if value, ok := shouldIncludeNetworkACL(&networkAcl, firstSeenTime, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(networkAcl.NetworkAclId, value))
 			}
 		}
 	}
@@ -76,18 +75,19 @@ func listNetworkACLs(ctx context.Context, client NetworkACLAPI, scope resource.S
 	return identifiers, nil
 }
 
-// shouldIncludeNetworkACL determines if a Network ACL should be included for deletion.
-func shouldIncludeNetworkACL(networkAcl *types.NetworkAcl, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeNetworkACL determines if a Network ACL should be included for deletion, returning the value it was filtered on.
+func shouldIncludeNetworkACL(networkAcl *types.NetworkAcl, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	var naclName string
 	tagMap := util.ConvertTypesTagsToMap(networkAcl.Tags)
 	if name, ok := tagMap["Name"]; ok {
 		naclName = name
 	}
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &naclName,
 		Tags: tagMap,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // verifyNetworkACLNukePermission performs a dry-run delete to check permissions.
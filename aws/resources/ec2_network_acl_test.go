@@ -102,7 +102,7 @@ func TestListNetworkACLs(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 			ids, err := listNetworkACLs(ctx, mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
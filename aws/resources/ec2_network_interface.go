@@ -40,15 +40,36 @@ func NewNetworkInterface() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.NetworkInterface },
+		"NetworkInterface",
 		listNetworkInterfaces,
 		resource.SequentialDeleter(deleteNetworkInterfaceWithDetach),
-		&EC2ResourceOptions[NetworkInterfaceAPI]{PermissionVerifier: verifyNetworkInterfacePermission},
+		&EC2ResourceOptions[NetworkInterfaceAPI]{
+			Categories:         []string{resource.CategoryNetwork},
+			PermissionVerifier: verifyNetworkInterfacePermission,
+			DependsOn: []string{
+				"ec2",
+				"ec2-endpoint",
+				"nat-gateway",
+				"elb",
+				"elbv2",
+				"lambda",
+				"eks-cluster",
+				"efs",
+				"elasticache-serverless",
+				"msk-cluster",
+				"mq-broker",
+				"opensearch-domain",
+				"rds-proxy",
+				"redshift",
+				"sagemaker-notebook-instance",
+				"sagemaker-studio",
+			},
+		},
 	)
 }
 
 // listNetworkInterfaces retrieves all Network Interfaces that match the config filters.
-func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
 	// When defaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
 	if defaultOnly {
@@ -69,7 +90,7 @@ func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scop
 		}
 	}
 
-	var interfaceIds []*string
+	var interfaceIds []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeNetworkInterfacesPaginator(client, &ec2.DescribeNetworkInterfacesInput{})
 	for paginator.HasMorePages() {
@@ -102,8 +123,8 @@ func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scop
 				continue
 			}
 
-			if shouldIncludeNetworkInterface(networkInterface, firstSeenTime, cfg) {
-				interfaceIds = append(interfaceIds, networkInterface.NetworkInterfaceId)
+			if value, ok := shouldIncludeNetworkInterface(networkInterface, firstSeenTime, cfg); ok {
+				interfaceIds = append(interfaceIds, resource.NewResourceDetails(networkInterface.NetworkInterfaceId, value))
 			}
 		}
 	}
@@ -111,18 +132,19 @@ func listNetworkInterfaces(ctx context.Context, client NetworkInterfaceAPI, scop
 	return interfaceIds, nil
 }
 
-// shouldIncludeNetworkInterface checks if a network interface should be included based on config filters.
-func shouldIncludeNetworkInterface(networkInterface types.NetworkInterface, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeNetworkInterface checks if a network interface should be included based on config filters, returning the value it was filtered on.
+func shouldIncludeNetworkInterface(networkInterface types.NetworkInterface, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	var interfaceName string
 	tagMap := util.ConvertTypesTagsToMap(networkInterface.TagSet)
 	if name, ok := tagMap["Name"]; ok {
 		interfaceName = name
 	}
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &interfaceName,
 		Tags: tagMap,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // verifyNetworkInterfacePermission performs a dry-run delete to check permissions.
@@ -224,7 +224,7 @@ func TestListNetworkInterfaces(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 			ids, err := listNetworkInterfaces(ctx, tc.mock, resource.Scope{}, tc.cfg, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
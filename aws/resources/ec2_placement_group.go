@@ -22,29 +22,29 @@ type EC2PlacementGroupsAPI interface {
 func NewEC2PlacementGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[EC2PlacementGroupsAPI]{
 		ResourceTypeName: "ec2-placement-groups",
+		Categories:       []string{resource.CategoryCompute},
+		DependsOn:        []string{"ec2"},
 		// Simple single-call delete API with high throughput; can handle large batches.
 		BatchSize: 200,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EC2PlacementGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EC2PlacementGroups
-		},
-		Lister:             listEC2PlacementGroups,
+		ConfigKey:          "EC2PlacementGroups",
+		DetailedLister:     listEC2PlacementGroups,
 		Nuker:              resource.SimpleBatchDeleter(deleteEC2PlacementGroup),
 		PermissionVerifier: verifyEC2PlacementGroupPermission,
 	})
 }
 
 // listEC2PlacementGroups retrieves all EC2 placement groups that match the config filters.
-func listEC2PlacementGroups(ctx context.Context, client EC2PlacementGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEC2PlacementGroups(ctx context.Context, client EC2PlacementGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	result, err := client.DescribePlacementGroups(ctx, &ec2.DescribePlacementGroupsInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var names []*string
+	var names []resource.ResourceDetails
 	for _, placementGroup := range result.PlacementGroups {
 		firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, placementGroup.GroupId, util.ConvertTypesTagsToMap(placementGroup.Tags))
 		if err != nil {
@@ -52,12 +52,13 @@ func listEC2PlacementGroups(ctx context.Context, client EC2PlacementGroupsAPI, s
 			return nil, err
 		}
 
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: placementGroup.GroupName,
 			Time: firstSeenTime,
 			Tags: util.ConvertTypesTagsToMap(placementGroup.Tags),
-		}) {
-			names = append(names, placementGroup.GroupName)
+		}
+		if cfg.ShouldInclude(value) {
+			names = append(names, resource.NewResourceDetails(placementGroup.GroupName, value).WithARN(placementGroup.GroupArn))
 		}
 	}
 
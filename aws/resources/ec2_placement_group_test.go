@@ -101,7 +101,7 @@ func TestListEC2PlacementGroups(t *testing.T) {
 
 			names, err := listEC2PlacementGroups(ctx, mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -32,10 +32,13 @@ func NewRouteTable() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.RouteTable },
+		"RouteTable",
 		listRouteTables,
 		resource.MultiStepDeleter(disassociateRouteTableSubnets, deleteRouteTable),
-		&EC2ResourceOptions[RouteTableAPI]{PermissionVerifier: verifyRouteTableNukePermission},
+		&EC2ResourceOptions[RouteTableAPI]{
+			PermissionVerifier: verifyRouteTableNukePermission,
+			Categories:         []string{resource.CategoryNetwork},
+		},
 	)
 }
 
@@ -51,7 +54,7 @@ func verifyRouteTableNukePermission(ctx context.Context, client RouteTableAPI, i
 // listRouteTables retrieves all non-main route tables that match the config filters.
 // Main route tables are automatically deleted when their VPC is deleted.
 // When defaultOnly is true, only route tables in default VPCs are returned (for defaults-aws command).
-func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
 	// When defaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
 	if defaultOnly {
@@ -73,7 +76,7 @@ func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.S
 		}
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeRouteTablesPaginator(client, &ec2.DescribeRouteTablesInput{})
 
@@ -102,8 +105,8 @@ func listRouteTables(ctx context.Context, client RouteTableAPI, scope resource.S
 				continue
 			}
 
-			if shouldIncludeRouteTable(rt, firstSeenTime, cfg) {
-				identifiers = append(identifiers, rt.RouteTableId)
+			if value, ok := shouldIncludeRouteTable(rt, firstSeenTime, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(rt.RouteTableId, value))
 			}
 		}
 	}
@@ -122,19 +125,20 @@ func isMainRouteTable(rt types.RouteTable) bool {
 	return false
 }
 
-// shouldIncludeRouteTable determines if a route table should be included for deletion.
-func shouldIncludeRouteTable(rt types.RouteTable, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeRouteTable determines if a route table should be included for deletion, returning the value it was filtered on.
+func shouldIncludeRouteTable(rt types.RouteTable, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	tagMap := util.ConvertTypesTagsToMap(rt.Tags)
 	var name string
 	if n, ok := tagMap["Name"]; ok {
 		name = n
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // disassociateRouteTableSubnets disassociates all subnet associations from a route table.
@@ -145,7 +145,7 @@ func TestListRouteTables(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 			result, err := listRouteTables(ctx, mock, resource.Scope{}, tc.config, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(result))
+			require.Equal(t, tc.expected, resource.IDs(result))
 		})
 	}
 }
@@ -27,16 +27,28 @@ func NewEC2Subnet() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.EC2Subnet },
+		"EC2Subnet",
 		listEC2Subnets,
 		resource.SimpleBatchDeleter(deleteSubnet),
-		&EC2ResourceOptions[EC2SubnetAPI]{PermissionVerifier: verifyEC2SubnetPermission},
+		&EC2ResourceOptions[EC2SubnetAPI]{
+			Categories:         []string{resource.CategoryNetwork},
+			PermissionVerifier: verifyEC2SubnetPermission,
+			DependsOn: []string{
+				"ec2",
+				"ec2-endpoint",
+				"nat-gateway",
+				"network-interface",
+				"network-acl",
+				"route-table",
+				"security-group",
+			},
+		},
 	)
 }
 
 // listEC2Subnets retrieves all EC2 Subnets that match the config filters.
-func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	var subnetIds []*string
+func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
+	var subnetIds []resource.ResourceDetails
 
 	// Configure filters for default subnets if requested
 	var filters []types.Filter
@@ -71,8 +83,8 @@ func listEC2Subnets(ctx context.Context, client EC2SubnetAPI, scope resource.Sco
 			// Get first seen time from tags
 			firstSeenTime := getEC2SubnetFirstSeenTime(tagMap)
 
-			if shouldIncludeEC2Subnet(subnet, firstSeenTime, cfg) {
-				subnetIds = append(subnetIds, subnet.SubnetId)
+			if value, ok := shouldIncludeEC2Subnet(subnet, firstSeenTime, cfg); ok {
+				subnetIds = append(subnetIds, resource.NewResourceDetails(subnet.SubnetId, value).WithARN(subnet.SubnetArn))
 			}
 		}
 	}
@@ -90,14 +102,15 @@ func getEC2SubnetFirstSeenTime(tagMap map[string]string) *time.Time {
 	return nil
 }
 
-// shouldIncludeEC2Subnet determines if a subnet should be included based on config filters.
-func shouldIncludeEC2Subnet(subnet types.Subnet, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeEC2Subnet determines if a subnet should be included based on config filters, returning the value it was filtered on.
+func shouldIncludeEC2Subnet(subnet types.Subnet, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	tagMap := util.ConvertTypesTagsToMap(subnet.Tags)
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: util.GetEC2ResourceNameTagValue(subnet.Tags),
 		Time: firstSeenTime,
 		Tags: tagMap,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // verifyEC2SubnetPermission performs a dry-run delete to check permissions.
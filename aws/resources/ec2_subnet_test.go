@@ -50,7 +50,7 @@ func TestListEC2Subnets(t *testing.T) {
 
 	ids, err := listEC2Subnets(context.Background(), mock, resource.Scope{}, config.ResourceType{}, false)
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"subnet-001", "subnet-002"}, aws.ToStringSlice(ids))
+	require.ElementsMatch(t, []string{"subnet-001", "subnet-002"}, resource.IDs(ids))
 }
 
 func TestListEC2Subnets_WithFilter(t *testing.T) {
@@ -83,7 +83,7 @@ func TestListEC2Subnets_WithFilter(t *testing.T) {
 
 	ids, err := listEC2Subnets(context.Background(), mock, resource.Scope{}, cfg, false)
 	require.NoError(t, err)
-	require.Equal(t, []string{"subnet-001"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{"subnet-001"}, resource.IDs(ids))
 }
 
 func TestListEC2Subnets_SkipsDefaultSubnets(t *testing.T) {
@@ -102,7 +102,7 @@ func TestListEC2Subnets_SkipsDefaultSubnets(t *testing.T) {
 	// defaultOnly=false: default subnets are skipped, non-default and nil are kept
 	ids, err := listEC2Subnets(context.Background(), mock, resource.Scope{}, config.ResourceType{}, false)
 	require.NoError(t, err)
-	require.Equal(t, []string{"subnet-custom", "subnet-nil"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{"subnet-custom", "subnet-nil"}, resource.IDs(ids))
 }
 
 func TestDeleteSubnet(t *testing.T) {
@@ -136,7 +136,7 @@ func TestListEC2Instances(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listEC2Instances(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -51,15 +51,30 @@ func NewEC2VPC() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.VPC },
+		"VPC",
 		listVPCs,
 		resource.MultiStepDeleter(cleanupVPCDependencies, deleteVPC),
-		nil,
+		&EC2ResourceOptions[EC2VpcAPI]{
+			Categories: []string{resource.CategoryNetwork},
+			DependsOn: []string{
+				"ec2-endpoint",
+				"nat-gateway",
+				"transit-gateway-attachment",
+				"vpc-peering-connection",
+				"egress-only-internet-gateway",
+				"route-table",
+				"network-interface",
+				"security-group",
+				"network-acl",
+				"ec2-subnet",
+				"internet-gateway",
+			},
+		},
 	)
 }
 
-func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	var ids []*string
+func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	paginator := ec2.NewDescribeVpcsPaginator(client, &ec2.DescribeVpcsInput{
 		Filters: []types.Filter{
 			{
@@ -82,12 +97,13 @@ func listVPCs(ctx context.Context, client EC2VpcAPI, scope resource.Scope, cfg c
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: firstSeenTime,
 				Name: util.GetEC2ResourceNameTagValue(vpc.Tags),
 				Tags: util.ConvertTypesTagsToMap(vpc.Tags),
-			}) {
-				ids = append(ids, vpc.VpcId)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(vpc.VpcId, value))
 			}
 		}
 	}
@@ -25,15 +25,14 @@ type VPCPeeringAPI interface {
 func NewVPCPeeringConnection() AwsResource {
 	return NewAwsResource(&resource.Resource[VPCPeeringAPI]{
 		ResourceTypeName: "vpc-peering-connection",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[VPCPeeringAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.VPCPeeringConnection
-		},
-		Lister:             listVPCPeeringConnections,
+		ConfigKey:          "VPCPeeringConnection",
+		DetailedLister:     listVPCPeeringConnections,
 		Nuker:              resource.SimpleBatchDeleter(deleteVpcPeeringConnection),
 		PermissionVerifier: verifyVPCPeeringNukePermission,
 	})
@@ -58,8 +57,8 @@ var terminalPeeringStates = map[types.VpcPeeringConnectionStateReasonCode]bool{
 }
 
 // listVPCPeeringConnections retrieves all active VPC peering connections that match config filters.
-func listVPCPeeringConnections(ctx context.Context, client VPCPeeringAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listVPCPeeringConnections(ctx context.Context, client VPCPeeringAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeVpcPeeringConnectionsPaginator(client, &ec2.DescribeVpcPeeringConnectionsInput{})
 
@@ -82,8 +81,8 @@ func listVPCPeeringConnections(ctx context.Context, client VPCPeeringAPI, scope
 				continue
 			}
 
-			if shouldIncludeVPCPeering(pcx, firstSeenTime, cfg) {
-				identifiers = append(identifiers, pcx.VpcPeeringConnectionId)
+			if value, ok := shouldIncludeVPCPeering(pcx, firstSeenTime, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(pcx.VpcPeeringConnectionId, value))
 			}
 		}
 	}
@@ -91,19 +90,20 @@ func listVPCPeeringConnections(ctx context.Context, client VPCPeeringAPI, scope
 	return identifiers, nil
 }
 
-// shouldIncludeVPCPeering determines if a VPC peering connection should be included for deletion.
-func shouldIncludeVPCPeering(pcx types.VpcPeeringConnection, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeVPCPeering determines if a VPC peering connection should be included for deletion, returning the value it was filtered on.
+func shouldIncludeVPCPeering(pcx types.VpcPeeringConnection, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	tagMap := util.ConvertTypesTagsToMap(pcx.Tags)
 	var name string
 	if n, ok := tagMap["Name"]; ok {
 		name = n
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &name,
 		Tags: tagMap,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteVpcPeeringConnection deletes a single VPC peering connection.
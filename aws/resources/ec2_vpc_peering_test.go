@@ -173,7 +173,7 @@ func TestListVPCPeeringConnections(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 			result, err := listVPCPeeringConnections(ctx, mock, resource.Scope{}, tc.config)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(result))
+			require.Equal(t, tc.expected, resource.IDs(result))
 		})
 	}
 }
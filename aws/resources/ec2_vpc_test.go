@@ -214,7 +214,7 @@ func TestListVPCs(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listVPCs(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.cfg, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -22,22 +22,21 @@ type ECRAPI interface {
 func NewECR() AwsResource {
 	return NewAwsResource(&resource.Resource[ECRAPI]{
 		ResourceTypeName: "ecr",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ECRAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ecr.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ECRRepository
-		},
-		Lister: listECRRepositories,
-		Nuker:  resource.SimpleBatchDeleter(deleteECRRepository),
+		ConfigKey:      "ECRRepository",
+		DetailedLister: listECRRepositories,
+		Nuker:          resource.SimpleBatchDeleter(deleteECRRepository),
 	})
 }
 
 // listECRRepositories retrieves all ECR repositories that match the config filters.
-func listECRRepositories(ctx context.Context, client ECRAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var repositoryNames []*string
+func listECRRepositories(ctx context.Context, client ECRAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var repositoryNames []resource.ResourceDetails
 
 	paginator := ecr.NewDescribeRepositoriesPaginator(client, &ecr.DescribeRepositoriesInput{})
 	for paginator.HasMorePages() {
@@ -55,12 +54,13 @@ func listECRRepositories(ctx context.Context, client ECRAPI, scope resource.Scop
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: repository.CreatedAt,
 				Name: repository.RepositoryName,
 				Tags: util.ConvertECRTagsToMap(tagsOutput.Tags),
-			}) {
-				repositoryNames = append(repositoryNames, repository.RepositoryName)
+			}
+			if cfg.ShouldInclude(value) {
+				repositoryNames = append(repositoryNames, resource.NewResourceDetails(repository.RepositoryName, value).WithARN(repository.RepositoryArn))
 			}
 		}
 	}
@@ -58,7 +58,7 @@ func TestListECRRepositories(t *testing.T) {
 
 	names, err := listECRRepositories(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{testName1, testName2}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{testName1, testName2}, resource.IDs(names))
 }
 
 func TestListECRRepositories_WithFilter(t *testing.T) {
@@ -91,7 +91,7 @@ func TestListECRRepositories_WithFilter(t *testing.T) {
 
 	names, err := listECRRepositories(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{testName1}, aws.ToStringSlice(names))
+	require.Equal(t, []string{testName1}, resource.IDs(names))
 }
 
 func TestListECRRepositories_TimeFilter(t *testing.T) {
@@ -124,7 +124,7 @@ func TestListECRRepositories_TimeFilter(t *testing.T) {
 
 	names, err := listECRRepositories(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{testName1}, aws.ToStringSlice(names))
+	require.Equal(t, []string{testName1}, resource.IDs(names))
 }
 
 func TestListECRRepositories_TagInclusionFilter(t *testing.T) {
@@ -161,7 +161,7 @@ func TestListECRRepositories_TagInclusionFilter(t *testing.T) {
 
 	names, err := listECRRepositories(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{testName2}, aws.ToStringSlice(names))
+	require.Equal(t, []string{testName2}, resource.IDs(names))
 }
 
 func TestDeleteECRRepository(t *testing.T) {
@@ -39,21 +39,21 @@ const describeClustersRequestBatchSize = 100
 func NewECSClusters() AwsResource {
 	return NewAwsResource(&resource.Resource[ECSClustersAPI]{
 		ResourceTypeName: "ecs-cluster",
+		Categories:       []string{resource.CategoryCompute},
+		DependsOn:        []string{"ecs-service", "ec2"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ECSClustersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ecs.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ECSCluster
-		},
-		Lister: listECSClusters,
-		Nuker:  resource.MultiStepDeleter(stopClusterRunningTasks, deregisterClusterContainerInstances, deleteECSCluster),
+		ConfigKey:      "ECSCluster",
+		DetailedLister: listECSClusters,
+		Nuker:          resource.MultiStepDeleter(stopClusterRunningTasks, deregisterClusterContainerInstances, deleteECSCluster),
 	})
 }
 
 // listECSClusters retrieves all ECS clusters that match the config filters.
-func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Get all cluster ARNs
 	allClusters, err := getAllEcsClusters(ctx, client)
 	if err != nil {
@@ -65,7 +65,7 @@ func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.
 		return nil, errors.WithStackTrace(err)
 	}
 
-	var result []*string
+	var result []resource.ResourceDetails
 	clusterList := aws.ToStringSlice(allClusters)
 	batches := util.Split(clusterList, describeClustersRequestBatchSize)
 
@@ -88,15 +88,16 @@ func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if !cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cluster.ClusterName,
 				Tags: tags,
-			}) {
+			}
+			if !cfg.ShouldInclude(value) {
 				continue
 			}
 
 			if excludeFirstSeenTag {
-				result = append(result, cluster.ClusterArn)
+				result = append(result, resource.NewResourceDetails(cluster.ClusterArn, value).WithARN(cluster.ClusterArn))
 				continue
 			}
 
@@ -112,12 +113,9 @@ func listECSClusters(ctx context.Context, client ECSClustersAPI, scope resource.
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
-				Time: firstSeenTime,
-				Name: cluster.ClusterName,
-				Tags: tags,
-			}) {
-				result = append(result, cluster.ClusterArn)
+			value.Time = firstSeenTime
+			if cfg.ShouldInclude(value) {
+				result = append(result, resource.NewResourceDetails(cluster.ClusterArn, value).WithARN(cluster.ClusterArn))
 			}
 		}
 	}
@@ -316,7 +316,7 @@ func TestListECSClusters(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listECSClusters(tc.ctx, mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -360,7 +360,7 @@ func TestListECSClusters_InactiveClusters(t *testing.T) {
 	names, err := listECSClusters(ctx, mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
 	// Only active cluster should be returned
-	require.Equal(t, []string{testArn2}, aws.ToStringSlice(names))
+	require.Equal(t, []string{testArn2}, resource.IDs(names))
 }
 
 func TestListECSClusters_NoFirstSeenTag(t *testing.T) {
@@ -585,7 +585,7 @@ func TestListECSClusters_MultipleRegexPatterns(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listECSClusters(ctx, mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
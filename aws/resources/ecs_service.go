@@ -33,7 +33,9 @@ func NewECSServices() AwsResource {
 	r := &ecsServicesResource{
 		Resource: &resource.Resource[ECSServicesAPI]{
 			ResourceTypeName: "ecs-service",
+			Categories:       []string{resource.CategoryCompute},
 			BatchSize:        DefaultBatchSize,
+			ConfigKey:        "ECSService",
 		},
 		serviceClusterMap: make(map[string]string),
 	}
@@ -45,11 +47,7 @@ func NewECSServices() AwsResource {
 		r.serviceClusterMap = make(map[string]string)
 	})
 
-	r.ConfigGetter = func(c config.Config) config.ResourceType {
-		return c.ECSService
-	}
-
-	r.Lister = func(ctx context.Context, client ECSServicesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+	r.DetailedLister = func(ctx context.Context, client ECSServicesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 		return listECSServices(ctx, client, scope, cfg, r.serviceClusterMap)
 	}
 
@@ -61,14 +59,14 @@ func NewECSServices() AwsResource {
 }
 
 // listECSServices returns all ECS Service ARNs and populates the service-to-cluster mapping.
-func listECSServices(ctx context.Context, client ECSServicesAPI, scope resource.Scope, cfg config.ResourceType, serviceClusterMap map[string]string) ([]*string, error) {
+func listECSServices(ctx context.Context, client ECSServicesAPI, scope resource.Scope, cfg config.ResourceType, serviceClusterMap map[string]string) ([]resource.ResourceDetails, error) {
 	// Get all cluster ARNs
 	clusterArns, err := listAllECSClusterArns(ctx, client)
 	if err != nil {
 		return nil, err
 	}
 
-	var serviceArns []*string
+	var services []resource.ResourceDetails
 	for _, clusterArn := range clusterArns {
 		paginator := ecs.NewListServicesPaginator(client, &ecs.ListServicesInput{Cluster: clusterArn})
 		for paginator.HasMorePages() {
@@ -82,14 +80,14 @@ func listECSServices(ctx context.Context, client ECSServicesAPI, scope resource.
 				return nil, err
 			}
 
-			for _, arn := range filtered {
-				serviceClusterMap[aws.ToString(arn)] = aws.ToString(clusterArn)
+			for _, svc := range filtered {
+				serviceClusterMap[svc.ID] = aws.ToString(clusterArn)
 			}
-			serviceArns = append(serviceArns, filtered...)
+			services = append(services, filtered...)
 		}
 	}
 
-	return serviceArns, nil
+	return services, nil
 }
 
 // listAllECSClusterArns returns all ECS cluster ARNs.
@@ -108,8 +106,8 @@ func listAllECSClusterArns(ctx context.Context, client ECSServicesAPI) ([]*strin
 
 // filterECSServices filters services based on config rules.
 // DescribeServices accepts max 10 services per call.
-func filterECSServices(ctx context.Context, client ECSServicesAPI, clusterArn *string, serviceArns []string, cfg config.ResourceType) ([]*string, error) {
-	var filtered []*string
+func filterECSServices(ctx context.Context, client ECSServicesAPI, clusterArn *string, serviceArns []string, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var filtered []resource.ResourceDetails
 	batches := util.Split(serviceArns, 10)
 
 	for _, batch := range batches {
@@ -123,12 +121,13 @@ func filterECSServices(ctx context.Context, client ECSServicesAPI, clusterArn *s
 		}
 
 		for _, svc := range output.Services {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: svc.ServiceName,
 				Time: svc.CreatedAt,
 				Tags: convertECSTagsToMap(svc.Tags),
-			}) {
-				filtered = append(filtered, svc.ServiceArn)
+			}
+			if cfg.ShouldInclude(value) {
+				filtered = append(filtered, resource.NewResourceDetails(svc.ServiceArn, value).WithARN(svc.ServiceArn))
 			}
 		}
 	}
@@ -120,7 +120,7 @@ func TestECSService_GetAll(t *testing.T) {
 			serviceClusterMap := make(map[string]string)
 			names, err := listECSServices(context.Background(), mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj, serviceClusterMap)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -28,15 +28,14 @@ type ElasticFileSystemAPI interface {
 func NewElasticFileSystem() AwsResource {
 	return NewAwsResource(&resource.Resource[ElasticFileSystemAPI]{
 		ResourceTypeName: "efs",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticFileSystemAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = efs.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElasticFileSystem
-		},
-		Lister: listElasticFileSystems,
+		ConfigKey:      "ElasticFileSystem",
+		DetailedLister: listElasticFileSystems,
 		// EFS deletion requires sequential steps: access points → mount targets → wait → delete
 		Nuker: resource.MultiStepDeleter(
 			deleteEFSAccessPoints,
@@ -48,8 +47,8 @@ func NewElasticFileSystem() AwsResource {
 }
 
 // listElasticFileSystems retrieves all Elastic File Systems that match the config filters.
-func listElasticFileSystems(ctx context.Context, client ElasticFileSystemAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allEfs []*string
+func listElasticFileSystems(ctx context.Context, client ElasticFileSystemAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allEfs []resource.ResourceDetails
 
 	paginator := efs.NewDescribeFileSystemsPaginator(client, &efs.DescribeFileSystemsInput{})
 	for paginator.HasMorePages() {
@@ -59,12 +58,13 @@ func listElasticFileSystems(ctx context.Context, client ElasticFileSystemAPI, sc
 		}
 
 		for _, system := range page.FileSystems {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: system.Name,
 				Time: system.CreationTime,
 				Tags: util.ConvertEFSTagsToMap(system.Tags),
-			}) {
-				allEfs = append(allEfs, system.FileSystemId)
+			}
+			if cfg.ShouldInclude(value) {
+				allEfs = append(allEfs, resource.NewResourceDetails(system.FileSystemId, value).WithARN(system.FileSystemArn))
 			}
 		}
 	}
@@ -109,7 +109,7 @@ func TestEFS_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listElasticFileSystems(context.Background(), client, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
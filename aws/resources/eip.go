@@ -22,28 +22,28 @@ type EIPAddressesAPI interface {
 func NewEIPAddresses() AwsResource {
 	return NewAwsResource(&resource.Resource[EIPAddressesAPI]{
 		ResourceTypeName: "eip",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"ec2"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EIPAddressesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElasticIP
-		},
-		Lister:             listEIPAddresses,
+		ConfigKey:          "ElasticIP",
+		DetailedLister:     listEIPAddresses,
 		Nuker:              resource.SimpleBatchDeleter(releaseEIPAddress),
 		PermissionVerifier: verifyEIPAddressPermission,
 	})
 }
 
 // listEIPAddresses retrieves all Elastic IP addresses that match the config filters.
-func listEIPAddresses(ctx context.Context, client EIPAddressesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEIPAddresses(ctx context.Context, client EIPAddressesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	result, err := client.DescribeAddresses(ctx, &ec2.DescribeAddressesInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var allocationIds []*string
+	var allocationIds []resource.ResourceDetails
 	for _, address := range result.Addresses {
 		firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, address.AllocationId, util.ConvertTypesTagsToMap(address.Tags))
 		if err != nil {
@@ -53,12 +53,13 @@ func listEIPAddresses(ctx context.Context, client EIPAddressesAPI, scope resourc
 
 		// If Name is unset, GetEC2ResourceNameTagValue returns nil
 		allocationName := util.GetEC2ResourceNameTagValue(address.Tags)
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Time: firstSeenTime,
 			Name: allocationName,
 			Tags: util.ConvertTypesTagsToMap(address.Tags),
-		}) {
-			allocationIds = append(allocationIds, address.AllocationId)
+		}
+		if cfg.ShouldInclude(value) {
+			allocationIds = append(allocationIds, resource.NewResourceDetails(address.AllocationId, value))
 		}
 	}
 
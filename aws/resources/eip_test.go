@@ -89,7 +89,7 @@ func TestListEIPAddresses(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listEIPAddresses(ctx, mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
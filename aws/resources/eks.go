@@ -39,6 +39,7 @@ type EKSClustersAPI interface {
 func NewEKSClusters() AwsResource {
 	return NewAwsResource(&resource.Resource[EKSClustersAPI]{
 		ResourceTypeName: "eks-cluster",
+		Categories:       []string{resource.CategoryCompute},
 		// Tentative batch size to ensure AWS doesn't throttle. Note that deleting EKS clusters involves deleting many
 		// associated sub resources in tight loops, and they happen in parallel in go routines. We conservatively pick 10
 		// here, both to limit overloading the runtime and to avoid AWS throttling with many API calls.
@@ -47,17 +48,15 @@ func NewEKSClusters() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = eks.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EKSCluster
-		},
-		Lister: listEKSClusters,
-		Nuker:  deleteEKSClusters,
+		ConfigKey:      "EKSCluster",
+		DetailedLister: listEKSClusters,
+		Nuker:          deleteEKSClusters,
 	})
 }
 
 // listEKSClusters retrieves all EKS clusters that match the config filters.
-func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allClusters []*string
+func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allClusters []resource.ResourceDetails
 
 	paginator := eks.NewListClustersPaginator(client, &eks.ListClustersInput{})
 	for paginator.HasMorePages() {
@@ -77,8 +76,8 @@ func listEKSClusters(ctx context.Context, client EKSClustersAPI, scope resource.
 }
 
 // filterEKSClusters filters EKS clusters based on the config.
-func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames []*string, cfg config.ResourceType) ([]*string, error) {
-	var filteredEksClusterNames []*string
+func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames []*string, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var filteredEksClusters []resource.ResourceDetails
 	for _, clusterName := range clusterNames {
 		describeResult, err := client.DescribeCluster(ctx, &eks.DescribeClusterInput{Name: clusterName})
 		if err != nil {
@@ -91,18 +90,19 @@ func filterEKSClusters(ctx context.Context, client EKSClustersAPI, clusterNames
 			return nil, goerrors.WithStackTrace(err)
 		}
 
-		if !cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: clusterName,
 			Time: describeResult.Cluster.CreatedAt,
 			Tags: describeResult.Cluster.Tags,
-		}) {
+		}
+		if !cfg.ShouldInclude(value) {
 			continue
 		}
 
-		filteredEksClusterNames = append(filteredEksClusterNames, clusterName)
+		filteredEksClusters = append(filteredEksClusters, resource.NewResourceDetails(clusterName, value).WithARN(describeResult.Cluster.Arn))
 	}
 
-	return filteredEksClusterNames, nil
+	return filteredEksClusters, nil
 }
 
 // deleteEKSClusters is a custom nuker function for EKS clusters.
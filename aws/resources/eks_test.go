@@ -158,7 +158,7 @@ func TestListEKSClusters(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -189,7 +189,7 @@ func TestListEKSClusters_GhostClusterSkipped(t *testing.T) {
 
 	names, err := listEKSClusters(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{realCluster}, aws.ToStringSlice(names))
+	require.Equal(t, []string{realCluster}, resource.IDs(names))
 }
 
 func TestDeleteEKSClusters(t *testing.T) {
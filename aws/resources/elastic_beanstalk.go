@@ -22,27 +22,26 @@ type EBApplicationsAPI interface {
 func NewEBApplications() AwsResource {
 	return NewAwsResource(&resource.Resource[EBApplicationsAPI]{
 		ResourceTypeName: "elastic-beanstalk",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EBApplicationsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticbeanstalk.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElasticBeanstalk
-		},
-		Lister: listEBApplications,
-		Nuker:  resource.SimpleBatchDeleter(deleteEBApplication),
+		ConfigKey:      "ElasticBeanstalk",
+		DetailedLister: listEBApplications,
+		Nuker:          resource.SimpleBatchDeleter(deleteEBApplication),
 	})
 }
 
 // listEBApplications retrieves all Elastic Beanstalk applications that match the config filters.
-func listEBApplications(ctx context.Context, client EBApplicationsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEBApplications(ctx context.Context, client EBApplicationsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.DescribeApplications(ctx, &elasticbeanstalk.DescribeApplicationsInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var appNames []*string
+	var appNames []resource.ResourceDetails
 	for _, app := range output.Applications {
 		var tags map[string]string
 		tagsOutput, err := client.ListTagsForResource(ctx, &elasticbeanstalk.ListTagsForResourceInput{
@@ -56,12 +55,13 @@ func listEBApplications(ctx context.Context, client EBApplicationsAPI, scope res
 			tags = util.ConvertElasticBeanstalkTagsToMap(tagsOutput.ResourceTags)
 		}
 
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: app.ApplicationName,
 			Time: app.DateCreated,
 			Tags: tags,
-		}) {
-			appNames = append(appNames, app.ApplicationName)
+		}
+		if cfg.ShouldInclude(value) {
+			appNames = append(appNames, resource.NewResourceDetails(app.ApplicationName, value).WithARN(app.ApplicationArn))
 		}
 	}
 
@@ -62,7 +62,7 @@ func TestListEBApplications(t *testing.T) {
 
 	names, err := listEBApplications(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{app1, app2}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{app1, app2}, resource.IDs(names))
 }
 
 func TestListEBApplications_WithFilter(t *testing.T) {
@@ -97,7 +97,7 @@ func TestListEBApplications_WithFilter(t *testing.T) {
 
 	names, err := listEBApplications(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{app1}, aws.ToStringSlice(names))
+	require.Equal(t, []string{app1}, resource.IDs(names))
 }
 
 func TestListEBApplications_TimeFilter(t *testing.T) {
@@ -130,7 +130,7 @@ func TestListEBApplications_TimeFilter(t *testing.T) {
 
 	names, err := listEBApplications(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{app1}, aws.ToStringSlice(names))
+	require.Equal(t, []string{app1}, resource.IDs(names))
 }
 
 func TestListEBApplications_TagFilter(t *testing.T) {
@@ -157,7 +157,7 @@ func TestListEBApplications_TagFilter(t *testing.T) {
 
 	names, err := listEBApplications(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{app1}, aws.ToStringSlice(names))
+	require.Equal(t, []string{app1}, resource.IDs(names))
 }
 
 func TestDeleteEBApplication(t *testing.T) {
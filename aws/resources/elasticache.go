@@ -28,24 +28,23 @@ type ElasticachesAPI interface {
 func NewElasticaches() AwsResource {
 	return NewAwsResource(&resource.Resource[ElasticachesAPI]{
 		ResourceTypeName: "elasticache",
+		Categories:       []string{resource.CategoryStorage},
 		// Tentative batch size to ensure AWS doesn't throttle
 		BatchSize: DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticachesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticache.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElastiCache
-		},
-		Lister: listElasticaches,
+		ConfigKey:      "ElastiCache",
+		DetailedLister: listElasticaches,
 		// Use SequentialDeleter since each deletion involves waiters
 		Nuker: resource.SequentialDeleter(deleteElasticacheCluster),
 	})
 }
 
 // listElasticaches retrieves all Elasticache clusters that match the config filters.
-func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var clusterIds []*string
+func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var clusterIds []resource.ResourceDetails
 
 	// First, get any cache clusters that are replication groups, which will be the case for all multi-node Redis clusters
 	replicationGroupsPaginator := elasticache.NewDescribeReplicationGroupsPaginator(client, &elasticache.DescribeReplicationGroupsInput{})
@@ -64,12 +63,13 @@ func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resourc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: replicationGroup.ReplicationGroupId,
 				Time: replicationGroup.ReplicationGroupCreateTime,
 				Tags: util.ConvertElastiCacheTagsToMap(tags.TagList),
-			}) {
-				clusterIds = append(clusterIds, replicationGroup.ReplicationGroupId)
+			}
+			if cfg.ShouldInclude(value) {
+				clusterIds = append(clusterIds, resource.NewResourceDetails(replicationGroup.ReplicationGroupId, value).WithARN(replicationGroup.ARN))
 			}
 		}
 	}
@@ -95,12 +95,13 @@ func listElasticaches(ctx context.Context, client ElasticachesAPI, scope resourc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cluster.CacheClusterId,
 				Time: cluster.CacheClusterCreateTime,
 				Tags: util.ConvertElastiCacheTagsToMap(tags.TagList),
-			}) {
-				clusterIds = append(clusterIds, cluster.CacheClusterId)
+			}
+			if cfg.ShouldInclude(value) {
+				clusterIds = append(clusterIds, resource.NewResourceDetails(cluster.CacheClusterId, value).WithARN(cluster.ARN))
 			}
 		}
 	}
@@ -25,22 +25,22 @@ type ElasticacheParameterGroupsAPI interface {
 func NewElasticacheParameterGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[ElasticacheParameterGroupsAPI]{
 		ResourceTypeName: "elasticache-parameter-group",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"elasticache"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticacheParameterGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticache.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElastiCacheParameterGroup
-		},
-		Lister: listElasticacheParameterGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteElasticacheParameterGroup),
+		ConfigKey:      "ElastiCacheParameterGroup",
+		DetailedLister: listElasticacheParameterGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteElasticacheParameterGroup),
 	})
 }
 
 // listElasticacheParameterGroups retrieves all Elasticache parameter groups that match the config filters.
-func listElasticacheParameterGroups(ctx context.Context, client ElasticacheParameterGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var paramGroupNames []*string
+func listElasticacheParameterGroups(ctx context.Context, client ElasticacheParameterGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var paramGroupNames []resource.ResourceDetails
 
 	paginator := elasticache.NewDescribeCacheParameterGroupsPaginator(client, &elasticache.DescribeCacheParameterGroupsInput{})
 	for paginator.HasMorePages() {
@@ -58,8 +58,8 @@ func listElasticacheParameterGroups(ctx context.Context, client ElasticacheParam
 				continue
 			}
 
-			if shouldIncludeElasticacheParameterGroup(&paramGroup, cfg, util.ConvertElastiCacheTagsToMap(tags.TagList)) {
-				paramGroupNames = append(paramGroupNames, paramGroup.CacheParameterGroupName)
+			if value, ok := shouldIncludeElasticacheParameterGroup(&paramGroup, cfg, util.ConvertElastiCacheTagsToMap(tags.TagList)); ok {
+				paramGroupNames = append(paramGroupNames, resource.NewResourceDetails(paramGroup.CacheParameterGroupName, value).WithARN(paramGroup.ARN))
 			}
 		}
 	}
@@ -67,19 +67,20 @@ func listElasticacheParameterGroups(ctx context.Context, client ElasticacheParam
 	return paramGroupNames, nil
 }
 
-func shouldIncludeElasticacheParameterGroup(paramGroup *types.CacheParameterGroup, cfg config.ResourceType, tags map[string]string) bool {
+func shouldIncludeElasticacheParameterGroup(paramGroup *types.CacheParameterGroup, cfg config.ResourceType, tags map[string]string) (config.ResourceValue, bool) {
 	if paramGroup == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 	// Exclude AWS managed resources. user defined resources are unable to begin with "default."
 	if strings.HasPrefix(aws.ToString(paramGroup.CacheParameterGroupName), "default.") {
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: paramGroup.CacheParameterGroupName,
 		Tags: tags,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteElasticacheParameterGroup deletes a single Elasticache parameter group.
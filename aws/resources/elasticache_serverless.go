@@ -24,23 +24,22 @@ type ElasticCacheServerlessAPI interface {
 func NewElasticCacheServerless() AwsResource {
 	return NewAwsResource(&resource.Resource[ElasticCacheServerlessAPI]{
 		ResourceTypeName: "elasticache-serverless",
+		Categories:       []string{resource.CategoryStorage},
 		// Tentative batch size to ensure AWS doesn't throttle
 		BatchSize: DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticCacheServerlessAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticache.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElastiCacheServerless
-		},
-		Lister: listElasticCacheServerless,
-		Nuker:  resource.SimpleBatchDeleter(deleteElasticCacheServerless),
+		ConfigKey:      "ElastiCacheServerless",
+		DetailedLister: listElasticCacheServerless,
+		Nuker:          resource.SimpleBatchDeleter(deleteElasticCacheServerless),
 	})
 }
 
 // listElasticCacheServerless retrieves all ElastiCache Serverless clusters that match the config filters.
-func listElasticCacheServerless(ctx context.Context, client ElasticCacheServerlessAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var output []*string
+func listElasticCacheServerless(ctx context.Context, client ElasticCacheServerlessAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var output []resource.ResourceDetails
 
 	paginator := elasticache.NewDescribeServerlessCachesPaginator(client, &elasticache.DescribeServerlessCachesInput{})
 	for paginator.HasMorePages() {
@@ -69,12 +68,13 @@ func listElasticCacheServerless(ctx context.Context, client ElasticCacheServerle
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: aws.String(name),
 				Time: cluster.CreateTime,
 				Tags: util.ConvertElastiCacheTagsToMap(tags.TagList),
-			}) {
-				output = append(output, aws.String(name))
+			}
+			if cfg.ShouldInclude(value) {
+				output = append(output, resource.NewResourceDetails(aws.String(name), value))
 			}
 		}
 	}
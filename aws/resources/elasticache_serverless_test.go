@@ -107,7 +107,7 @@ func TestElasticCacheServerless_GetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
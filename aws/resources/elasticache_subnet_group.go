@@ -24,22 +24,22 @@ type ElasticacheSubnetGroupsAPI interface {
 func NewElasticacheSubnetGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[ElasticacheSubnetGroupsAPI]{
 		ResourceTypeName: "elasticache-subnet-group",
+		Categories:       []string{resource.CategoryStorage, resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"elasticache"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ElasticacheSubnetGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticache.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ElastiCacheSubnetGroup
-		},
-		Lister: listElasticacheSubnetGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteElasticacheSubnetGroup),
+		ConfigKey:      "ElastiCacheSubnetGroup",
+		DetailedLister: listElasticacheSubnetGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteElasticacheSubnetGroup),
 	})
 }
 
 // listElasticacheSubnetGroups retrieves all Elasticache subnet groups that match the config filters.
-func listElasticacheSubnetGroups(ctx context.Context, client ElasticacheSubnetGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var subnetGroupNames []*string
+func listElasticacheSubnetGroups(ctx context.Context, client ElasticacheSubnetGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var subnetGroupNames []resource.ResourceDetails
 
 	paginator := elasticache.NewDescribeCacheSubnetGroupsPaginator(client, &elasticache.DescribeCacheSubnetGroupsInput{})
 	for paginator.HasMorePages() {
@@ -61,11 +61,12 @@ func listElasticacheSubnetGroups(ctx context.Context, client ElasticacheSubnetGr
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: subnetGroup.CacheSubnetGroupName,
 				Tags: util.ConvertElastiCacheTagsToMap(tags.TagList),
-			}) {
-				subnetGroupNames = append(subnetGroupNames, subnetGroup.CacheSubnetGroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				subnetGroupNames = append(subnetGroupNames, resource.NewResourceDetails(subnetGroup.CacheSubnetGroupName, value).WithARN(subnetGroup.ARN))
 			}
 		}
 	}
@@ -125,7 +125,7 @@ func TestListElasticaches(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listElasticaches(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
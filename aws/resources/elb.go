@@ -29,24 +29,23 @@ type LoadBalancersAPI interface {
 func NewLoadBalancers() AwsResource {
 	return NewAwsResource(&resource.Resource[LoadBalancersAPI]{
 		ResourceTypeName: "elb",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LoadBalancersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticloadbalancing.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ELBv1
-		},
-		Lister: listLoadBalancers,
-		Nuker:  resource.SequentialDeleter(deleteLoadBalancer),
+		ConfigKey:      "ELBv1",
+		DetailedLister: listLoadBalancers,
+		Nuker:          resource.SequentialDeleter(deleteLoadBalancer),
 	})
 }
 
 // listLoadBalancers retrieves all Classic ELB load balancers that match the config filters.
-func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	paginator := elasticloadbalancing.NewDescribeLoadBalancersPaginator(client.(LoadBalancersPaginatorAPI), &elasticloadbalancing.DescribeLoadBalancersInput{})
 
-	var names []*string
+	var names []resource.ResourceDetails
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
@@ -68,12 +67,13 @@ func listLoadBalancers(ctx context.Context, client LoadBalancersAPI, scope resou
 				}
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: balancer.LoadBalancerName,
 				Time: balancer.CreatedTime,
 				Tags: tagMap,
-			}) {
-				names = append(names, balancer.LoadBalancerName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(balancer.LoadBalancerName, value))
 			}
 		}
 	}
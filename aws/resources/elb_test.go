@@ -99,7 +99,7 @@ func TestElb_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listLoadBalancers(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -29,24 +29,23 @@ type LoadBalancersV2API interface {
 func NewLoadBalancersV2() AwsResource {
 	return NewAwsResource(&resource.Resource[LoadBalancersV2API]{
 		ResourceTypeName: "elbv2",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LoadBalancersV2API], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = elasticloadbalancingv2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ELBv2
-		},
-		Lister: listLoadBalancersV2,
-		Nuker:  resource.SequentialDeleter(resource.DeleteThenWait(deleteLoadBalancerV2, waitForLoadBalancerV2Deleted)),
+		ConfigKey:      "ELBv2",
+		DetailedLister: listLoadBalancersV2,
+		Nuker:          resource.SequentialDeleter(resource.DeleteThenWait(deleteLoadBalancerV2, waitForLoadBalancerV2Deleted)),
 	})
 }
 
 // listLoadBalancersV2 retrieves all ELBv2 load balancers that match the config filters.
-func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	paginator := elasticloadbalancingv2.NewDescribeLoadBalancersPaginator(client.(LoadBalancersV2PaginatorAPI), &elasticloadbalancingv2.DescribeLoadBalancersInput{})
 
-	var arns []*string
+	var arns []resource.ResourceDetails
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
 		if err != nil {
@@ -68,12 +67,13 @@ func listLoadBalancersV2(ctx context.Context, client LoadBalancersV2API, scope r
 				}
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: balancer.LoadBalancerName,
 				Time: balancer.CreatedTime,
 				Tags: tagMap,
-			}) {
-				arns = append(arns, balancer.LoadBalancerArn)
+			}
+			if cfg.ShouldInclude(value) {
+				arns = append(arns, resource.NewResourceDetails(balancer.LoadBalancerArn, value).WithARN(balancer.LoadBalancerArn))
 			}
 		}
 	}
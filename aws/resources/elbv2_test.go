@@ -106,7 +106,7 @@ func TestElbV2_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listLoadBalancersV2(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -23,22 +23,21 @@ func NewEventBridge() AwsResource {
 	return NewAwsResource(&resource.Resource[EventBridgeAPI]{
 		ResourceTypeName: "event-bridge",
 		BatchSize:        100,
+		DependsOn:        []string{"event-bridge-archive", "event-bridge-rule"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EventBridgeAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = eventbridge.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EventBridge
-		},
-		Lister: listEventBuses,
-		Nuker:  resource.SimpleBatchDeleter(deleteEventBus),
+		ConfigKey:      "EventBridge",
+		DetailedLister: listEventBuses,
+		Nuker:          resource.SimpleBatchDeleter(deleteEventBus),
 	})
 }
 
 // listEventBuses retrieves all EventBridge Buses that match the config filters.
 // Uses manual pagination since ListEventBuses does not have an SDK paginator.
-func listEventBuses(ctx context.Context, client EventBridgeAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listEventBuses(ctx context.Context, client EventBridgeAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	hasMorePages := true
 	params := &eventbridge.ListEventBusesInput{}
@@ -65,12 +64,13 @@ func listEventBuses(ctx context.Context, client EventBridgeAPI, scope resource.S
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: bus.Name,
 				Time: bus.CreationTime,
 				Tags: util.ConvertEventBridgeTagsToMap(tagsOutput.Tags),
-			}) {
-				identifiers = append(identifiers, bus.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(bus.Name, value).WithARN(bus.Arn))
 			}
 		}
 
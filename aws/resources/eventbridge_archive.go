@@ -28,17 +28,15 @@ func NewEventBridgeArchive() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = eventbridge.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EventBridgeArchive
-		},
-		Lister: listEventBridgeArchives,
-		Nuker:  resource.SimpleBatchDeleter(deleteEventBridgeArchive),
+		ConfigKey:      "EventBridgeArchive",
+		DetailedLister: listEventBridgeArchives,
+		Nuker:          resource.SimpleBatchDeleter(deleteEventBridgeArchive),
 	})
 }
 
 // listEventBridgeArchives retrieves all EventBridge Archives that match the config filters.
-func listEventBridgeArchives(ctx context.Context, client EventBridgeArchiveAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listEventBridgeArchives(ctx context.Context, client EventBridgeArchiveAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	params := eventbridge.ListArchivesInput{}
 	hasMorePages := true
@@ -73,12 +71,13 @@ func listEventBridgeArchives(ctx context.Context, client EventBridgeArchiveAPI,
 
 			tags := util.ConvertEventBridgeTagsToMap(tagsOutput.Tags)
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: archive.ArchiveName,
 				Time: archive.CreationTime,
 				Tags: tags,
-			}) {
-				identifiers = append(identifiers, archive.ArchiveName)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(archive.ArchiveName, value))
 			}
 		}
 
@@ -125,7 +125,7 @@ func Test_EventBridgeArchive_GetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(archives))
+			require.Equal(t, tc.expected, resource.IDs(archives))
 		})
 	}
 }
@@ -32,17 +32,15 @@ func NewEventBridgeRule() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = eventbridge.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EventBridgeRule
-		},
-		Lister: listEventBridgeRules,
-		Nuker:  resource.SequentialDeleter(deleteEventBridgeRule),
+		ConfigKey:      "EventBridgeRule",
+		DetailedLister: listEventBridgeRules,
+		Nuker:          resource.SequentialDeleter(deleteEventBridgeRule),
 	})
 }
 
 // listEventBridgeRules retrieves all EventBridge Rules that match the config filters.
 // Returns identifiers in "busName|ruleName" format.
-func listEventBridgeRules(ctx context.Context, client EventBridgeRuleAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listEventBridgeRules(ctx context.Context, client EventBridgeRuleAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// First, get all event bus names
 	busNames, err := listEventBusNames(ctx, client)
 	if err != nil {
@@ -50,7 +48,7 @@ func listEventBridgeRules(ctx context.Context, client EventBridgeRuleAPI, scope
 		return nil, err
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	for _, busName := range busNames {
 		// Manual pagination for ListRules (no SDK paginator available)
 		hasMorePages := true
@@ -77,11 +75,12 @@ func listEventBridgeRules(ctx context.Context, client EventBridgeRuleAPI, scope
 					continue
 				}
 
-				if cfg.ShouldInclude(config.ResourceValue{
+				value := config.ResourceValue{
 					Name: id,
 					Tags: util.ConvertEventBridgeTagsToMap(tagsOutput.Tags),
-				}) {
-					identifiers = append(identifiers, id)
+				}
+				if cfg.ShouldInclude(value) {
+					identifiers = append(identifiers, resource.NewResourceDetails(id, value))
 				}
 			}
 
@@ -126,7 +126,7 @@ func Test_EventBridgeRule_GetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(rules))
+			require.Equal(t, tc.expected, resource.IDs(rules))
 		})
 	}
 }
@@ -30,17 +30,15 @@ func NewEventBridgeSchedule() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = scheduler.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EventBridgeSchedule
-		},
-		Lister: listEventBridgeSchedules,
-		Nuker:  resource.SimpleBatchDeleter(deleteEventBridgeSchedule),
+		ConfigKey:      "EventBridgeSchedule",
+		DetailedLister: listEventBridgeSchedules,
+		Nuker:          resource.SimpleBatchDeleter(deleteEventBridgeSchedule),
 	})
 }
 
 // listEventBridgeSchedules retrieves all EventBridge schedules that match the config filters.
-func listEventBridgeSchedules(ctx context.Context, client EventBridgeScheduleAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listEventBridgeSchedules(ctx context.Context, client EventBridgeScheduleAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := scheduler.NewListSchedulesPaginator(client, &scheduler.ListSchedulesInput{})
 	for paginator.HasMorePages() {
@@ -60,12 +58,13 @@ func listEventBridgeSchedules(ctx context.Context, client EventBridgeScheduleAPI
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: id,
 				Time: schedule.CreationDate,
 				Tags: util.ConvertSchedulerTagsToMap(tagsOutput.Tags),
-			}) {
-				identifiers = append(identifiers, id)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(id, value))
 			}
 		}
 	}
@@ -24,21 +24,20 @@ func NewEventBridgeScheduleGroup() AwsResource {
 	return NewAwsResource(&resource.Resource[EventBridgeScheduleGroupAPI]{
 		ResourceTypeName: "event-bridge-schedule-group",
 		BatchSize:        100,
+		DependsOn:        []string{"event-bridge-schedule"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[EventBridgeScheduleGroupAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = scheduler.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.EventBridgeScheduleGroup
-		},
-		Lister: listEventBridgeScheduleGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteEventBridgeScheduleGroup),
+		ConfigKey:      "EventBridgeScheduleGroup",
+		DetailedLister: listEventBridgeScheduleGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteEventBridgeScheduleGroup),
 	})
 }
 
 // listEventBridgeScheduleGroups retrieves all EventBridge Schedule Groups that match the config filters.
-func listEventBridgeScheduleGroups(ctx context.Context, client EventBridgeScheduleGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listEventBridgeScheduleGroups(ctx context.Context, client EventBridgeScheduleGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 	paginator := scheduler.NewListScheduleGroupsPaginator(client, &scheduler.ListScheduleGroupsInput{})
 
 	for paginator.HasMorePages() {
@@ -67,12 +66,13 @@ func listEventBridgeScheduleGroups(ctx context.Context, client EventBridgeSchedu
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: group.Name,
 				Time: group.CreationDate,
 				Tags: util.ConvertSchedulerTagsToMap(tagsOutput.Tags),
-			}) {
-				identifiers = append(identifiers, group.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(group.Name, value).WithARN(group.Arn))
 			}
 		}
 	}
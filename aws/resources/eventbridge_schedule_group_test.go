@@ -107,7 +107,7 @@ func Test_EventBridgeScheduleGroup_GetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(groups))
+			require.Equal(t, tc.expected, resource.IDs(groups))
 		})
 	}
 }
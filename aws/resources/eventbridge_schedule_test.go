@@ -62,7 +62,7 @@ func TestListEventBridgeSchedules(t *testing.T) {
 
 	names, err := listEventBridgeSchedules(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{"default|schedule1", "custom|schedule2"}, aws.ToStringSlice(names))
+	require.ElementsMatch(t, []string{"default|schedule1", "custom|schedule2"}, resource.IDs(names))
 }
 
 func TestListEventBridgeSchedules_WithFilter(t *testing.T) {
@@ -86,7 +86,7 @@ func TestListEventBridgeSchedules_WithFilter(t *testing.T) {
 
 	names, err := listEventBridgeSchedules(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"default|schedule1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"default|schedule1"}, resource.IDs(names))
 }
 
 func TestListEventBridgeSchedules_TagFilter(t *testing.T) {
@@ -110,7 +110,7 @@ func TestListEventBridgeSchedules_TagFilter(t *testing.T) {
 
 	names, err := listEventBridgeSchedules(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{"default|schedule1"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"default|schedule1"}, resource.IDs(names))
 }
 
 func TestDeleteEventBridgeSchedule(t *testing.T) {
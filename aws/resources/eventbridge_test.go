@@ -106,7 +106,7 @@ func Test_EventBridge_GetAll(t *testing.T) {
 				tc.configObj,
 			)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(buses))
+			require.Equal(t, tc.expected, resource.IDs(buses))
 		})
 	}
 }
@@ -153,5 +153,5 @@ func Test_EventBridge_SkipsDefaultBus(t *testing.T) {
 	)
 	require.NoError(t, err)
 	// Should only include custom-bus, not default
-	require.Equal(t, []string{"custom-bus"}, aws.ToStringSlice(buses))
+	require.Equal(t, []string{"custom-bus"}, resource.IDs(buses))
 }
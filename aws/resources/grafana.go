@@ -32,28 +32,27 @@ type GrafanaAPI interface {
 func NewGrafana() AwsResource {
 	return NewAwsResource(&resource.Resource[GrafanaAPI]{
 		ResourceTypeName: "grafana",
+		Categories:       []string{resource.CategoryObservability},
 		BatchSize:        100,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[GrafanaAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = grafana.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Grafana
-		},
-		Lister: listGrafanaWorkspaces,
-		Nuker:  resource.SimpleBatchDeleter(deleteGrafanaWorkspace),
+		ConfigKey:      "Grafana",
+		DetailedLister: listGrafanaWorkspaces,
+		Nuker:          resource.SimpleBatchDeleter(deleteGrafanaWorkspace),
 	})
 }
 
 // listGrafanaWorkspaces retrieves all Grafana Workspaces that match the config filters.
-func listGrafanaWorkspaces(ctx context.Context, client GrafanaAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listGrafanaWorkspaces(ctx context.Context, client GrafanaAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Check if region supports Grafana
 	if !slices.Contains(GrafanaAllowedRegions, scope.Region) {
 		logging.Debugf("Region %s is not allowed for Grafana", scope.Region)
 		return nil, nil
 	}
 
-	var workspaceIDs []*string
+	var workspaceIDs []resource.ResourceDetails
 
 	paginator := grafana.NewListWorkspacesPaginator(client, &grafana.ListWorkspacesInput{})
 	for paginator.HasMorePages() {
@@ -73,12 +72,13 @@ func listGrafanaWorkspaces(ctx context.Context, client GrafanaAPI, scope resourc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: workspace.Name,
 				Time: workspace.Created,
 				Tags: workspace.Tags,
-			}) {
-				workspaceIDs = append(workspaceIDs, workspace.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				workspaceIDs = append(workspaceIDs, resource.NewResourceDetails(workspace.Id, value))
 			}
 		}
 	}
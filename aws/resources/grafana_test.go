@@ -88,7 +88,7 @@ func TestListGrafanaWorkspaces(t *testing.T) {
 			mock := &mockGrafanaClient{ListWorkspacesOutput: grafana.ListWorkspacesOutput{Workspaces: tc.workspaces}}
 			ids, err := listGrafanaWorkspaces(context.Background(), mock, resource.Scope{Region: tc.region}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -21,22 +21,21 @@ type GuardDutyAPI interface {
 func NewGuardDuty() AwsResource {
 	return NewAwsResource(&resource.Resource[GuardDutyAPI]{
 		ResourceTypeName: "guard-duty",
+		Categories:       []string{resource.CategoryObservability},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[GuardDutyAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = guardduty.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.GuardDuty
-		},
-		Lister: listGuardDutyDetectors,
-		Nuker:  resource.SimpleBatchDeleter(deleteGuardDutyDetector),
+		ConfigKey:      "GuardDuty",
+		DetailedLister: listGuardDutyDetectors,
+		Nuker:          resource.SimpleBatchDeleter(deleteGuardDutyDetector),
 	})
 }
 
 // listGuardDutyDetectors retrieves all GuardDuty detectors that match the config filters.
-func listGuardDutyDetectors(ctx context.Context, client GuardDutyAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var detectorIds []*string
+func listGuardDutyDetectors(ctx context.Context, client GuardDutyAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var detectorIds []resource.ResourceDetails
 
 	paginator := guardduty.NewListDetectorsPaginator(client, &guardduty.ListDetectorsInput{})
 	for paginator.HasMorePages() {
@@ -58,8 +57,9 @@ func listGuardDutyDetectors(ctx context.Context, client GuardDutyAPI, scope reso
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{Time: createdAt, Tags: detector.Tags}) {
-				detectorIds = append(detectorIds, aws.String(detectorId))
+			value := config.ResourceValue{Time: createdAt, Tags: detector.Tags}
+			if cfg.ShouldInclude(value) {
+				detectorIds = append(detectorIds, resource.NewResourceDetails(aws.String(detectorId), value))
 			}
 		}
 	}
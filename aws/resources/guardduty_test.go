@@ -55,7 +55,7 @@ func TestListGuardDutyDetectors(t *testing.T) {
 
 	ids, err := listGuardDutyDetectors(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.ElementsMatch(t, []string{testId1, testId2}, aws.ToStringSlice(ids))
+	require.ElementsMatch(t, []string{testId1, testId2}, resource.IDs(ids))
 }
 
 func TestListGuardDutyDetectors_TimeFilter(t *testing.T) {
@@ -109,7 +109,7 @@ func TestListGuardDutyDetectors_TagFilter(t *testing.T) {
 
 	ids, err := listGuardDutyDetectors(context.Background(), mock, resource.Scope{}, cfg)
 	require.NoError(t, err)
-	require.Equal(t, []string{testId1}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{testId1}, resource.IDs(ids))
 }
 
 func TestDeleteGuardDutyDetector(t *testing.T) {
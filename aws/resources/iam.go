@@ -46,23 +46,22 @@ type IAMUsersAPI interface {
 func NewIAMUsers() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMUsersAPI]{
 		ResourceTypeName: "iam-user",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMUsersAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMUsers
-		},
-		Lister: listIAMUsers,
+		ConfigKey:      "IAMUsers",
+		DetailedLister: listIAMUsers,
 		// IAM deletion requires sequential steps per user - use SequentialDeleter
 		Nuker: resource.SequentialDeleter(deleteIAMUser),
 	})
 }
 
 // listIAMUsers retrieves all IAM users that match the config filters.
-func listIAMUsers(ctx context.Context, client IAMUsersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var userNames []*string
+func listIAMUsers(ctx context.Context, client IAMUsersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var userNames []resource.ResourceDetails
 
 	input := &iam.ListUsersInput{}
 	paginator := iam.NewListUsersPaginator(client, input)
@@ -94,12 +93,13 @@ func listIAMUsers(ctx context.Context, client IAMUsersAPI, scope resource.Scope,
 				tags = append(tags, tagsPage.Tags...)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: user.UserName,
 				Time: user.CreateDate,
 				Tags: util.ConvertIAMTagsToMap(tags),
-			}) {
-				userNames = append(userNames, user.UserName)
+			}
+			if cfg.ShouldInclude(value) {
+				userNames = append(userNames, resource.NewResourceDetails(user.UserName, value).WithARN(user.Arn))
 			}
 		}
 	}
@@ -27,22 +27,23 @@ type IAMGroupsAPI interface {
 func NewIAMGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMGroupsAPI]{
 		ResourceTypeName: "iam-group",
+		Categories:       []string{resource.CategoryIAM},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"iam-user"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMGroups
-		},
-		Lister: listIAMGroups,
-		Nuker:  resource.SequentialDeleter(deleteIAMGroup),
+		ConfigKey:      "IAMGroups",
+		DetailedLister: listIAMGroups,
+		Nuker:          resource.SequentialDeleter(deleteIAMGroup),
 	})
 }
 
 // listIAMGroups retrieves all IAM groups that match the config filters.
-func listIAMGroups(ctx context.Context, client IAMGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allIamGroups []*string
+func listIAMGroups(ctx context.Context, client IAMGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allIamGroups []resource.ResourceDetails
 
 	paginator := iam.NewListGroupsPaginator(client, &iam.ListGroupsInput{})
 	for paginator.HasMorePages() {
@@ -52,11 +53,12 @@ func listIAMGroups(ctx context.Context, client IAMGroupsAPI, scope resource.Scop
 		}
 
 		for _, iamGroup := range page.Groups {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: iamGroup.CreateDate,
 				Name: iamGroup.GroupName,
-			}) {
-				allIamGroups = append(allIamGroups, iamGroup.GroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				allIamGroups = append(allIamGroups, resource.NewResourceDetails(iamGroup.GroupName, value).WithARN(iamGroup.Arn))
 			}
 		}
 	}
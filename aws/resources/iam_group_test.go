@@ -107,7 +107,7 @@ func TestIamGroups_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMGroups(context.Background(), client, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
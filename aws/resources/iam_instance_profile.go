@@ -23,23 +23,23 @@ type IAMInstanceProfilesAPI interface {
 func NewIAMInstanceProfiles() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMInstanceProfilesAPI]{
 		ResourceTypeName: "iam-instance-profile",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        20,
+		DependsOn:        []string{"iam-role"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMInstanceProfilesAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMInstanceProfiles
-		},
-		Lister: listIAMInstanceProfiles,
+		ConfigKey:      "IAMInstanceProfiles",
+		DetailedLister: listIAMInstanceProfiles,
 		// Instance profile deletion requires detaching roles first
 		Nuker: resource.SequentialDeleter(deleteIAMInstanceProfile),
 	})
 }
 
 // listIAMInstanceProfiles retrieves all IAM instance profiles that match the config filters.
-func listIAMInstanceProfiles(ctx context.Context, client IAMInstanceProfilesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listIAMInstanceProfiles(ctx context.Context, client IAMInstanceProfilesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := iam.NewListInstanceProfilesPaginator(client, &iam.ListInstanceProfilesInput{})
 	for paginator.HasMorePages() {
@@ -64,7 +64,7 @@ func listIAMInstanceProfiles(ctx context.Context, client IAMInstanceProfilesAPI,
 				continue
 			}
 
-			names = append(names, profile.InstanceProfileName)
+			names = append(names, resource.NewResourceDetails(profile.InstanceProfileName, rv).WithARN(profile.Arn))
 		}
 	}
 
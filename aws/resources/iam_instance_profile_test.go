@@ -102,7 +102,7 @@ func TestIAMInstanceProfiles_ListIAMInstanceProfiles(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMInstanceProfiles(context.Background(), client, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
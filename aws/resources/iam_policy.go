@@ -32,23 +32,23 @@ type IAMPoliciesAPI interface {
 func NewIAMPolicies() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMPoliciesAPI]{
 		ResourceTypeName: "iam-policy",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        20,
+		DependsOn:        []string{"iam-user", "iam-group", "iam-role"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMPoliciesAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMPolicies
-		},
-		Lister: listIAMPolicies,
+		ConfigKey:      "IAMPolicies",
+		DetailedLister: listIAMPolicies,
 		// IAM policy deletion requires multiple cleanup steps per policy
 		Nuker: resource.SequentialDeleter(deleteIAMPolicy),
 	})
 }
 
 // listIAMPolicies retrieves all customer-managed IAM policies that match the config filters.
-func listIAMPolicies(ctx context.Context, client IAMPoliciesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allIamPolicies []*string
+func listIAMPolicies(ctx context.Context, client IAMPoliciesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allIamPolicies []resource.ResourceDetails
 
 	paginator := iam.NewListPoliciesPaginator(client, &iam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
 	for paginator.HasMorePages() {
@@ -67,12 +67,13 @@ func listIAMPolicies(ctx context.Context, client IAMPoliciesAPI, scope resource.
 			}
 			tags := tagsOut.Tags
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: policy.PolicyName,
 				Time: policy.CreateDate,
 				Tags: util.ConvertIAMTagsToMap(tags),
-			}) {
-				allIamPolicies = append(allIamPolicies, policy.Arn)
+			}
+			if cfg.ShouldInclude(value) {
+				allIamPolicies = append(allIamPolicies, resource.NewResourceDetails(policy.Arn, value).WithARN(policy.Arn))
 			}
 		}
 	}
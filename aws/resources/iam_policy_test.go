@@ -154,7 +154,7 @@ func TestIAMPolicy_ListIAMPolicies(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMPolicies(context.Background(), client, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
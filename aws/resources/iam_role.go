@@ -32,22 +32,21 @@ type IAMRolesAPI interface {
 func NewIAMRoles() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMRolesAPI]{
 		ResourceTypeName: "iam-role",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        20,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMRolesAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMRoles
-		},
-		Lister: listIAMRoles,
-		Nuker:  resource.SequentialDeleter(deleteIAMRole),
+		ConfigKey:      "IAMRoles",
+		DetailedLister: listIAMRoles,
+		Nuker:          resource.SequentialDeleter(deleteIAMRole),
 	})
 }
 
 // listIAMRoles retrieves all IAM roles that match the config filters.
-func listIAMRoles(ctx context.Context, client IAMRolesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allIAMRoles []*string
+func listIAMRoles(ctx context.Context, client IAMRolesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allIAMRoles []resource.ResourceDetails
 
 	paginator := iam.NewListRolesPaginator(client, &iam.ListRolesInput{})
 	for paginator.HasMorePages() {
@@ -66,8 +65,8 @@ func listIAMRoles(ctx context.Context, client IAMRolesAPI, scope resource.Scope,
 			}
 			tags := tagsOut.Tags
 
-			if shouldIncludeIAMRole(&iamRole, cfg, tags) {
-				allIAMRoles = append(allIAMRoles, iamRole.RoleName)
+			if value, ok := shouldIncludeIAMRole(&iamRole, cfg, tags); ok {
+				allIAMRoles = append(allIAMRoles, resource.NewResourceDetails(iamRole.RoleName, value).WithARN(iamRole.Arn))
 			}
 		}
 	}
@@ -75,23 +74,23 @@ func listIAMRoles(ctx context.Context, client IAMRolesAPI, scope resource.Scope,
 	return allIAMRoles, nil
 }
 
-// shouldIncludeIAMRole determines if an IAM role should be included for deletion.
-func shouldIncludeIAMRole(iamRole *types.Role, cfg config.ResourceType, tags []types.Tag) bool {
+// shouldIncludeIAMRole determines if an IAM role should be included for deletion, returning the value it was filtered on.
+func shouldIncludeIAMRole(iamRole *types.Role, cfg config.ResourceType, tags []types.Tag) (config.ResourceValue, bool) {
 	if iamRole == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// The OrganizationAccountAccessRole is a special role that is created by AWS Organizations, and is used to allow
 	// users to access the AWS account. We should not delete this role, so we can filter it out of the Roles found and
 	// managed by cloud-nuke.
 	if strings.Contains(aws.ToString(iamRole.RoleName), "OrganizationAccountAccessRole") {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// The ARNs of AWS-reserved IAM roles, which can only be modified or deleted by AWS, contain "aws-reserved", so we can filter them out
 	// of the Roles found and managed by cloud-nuke
 	if strings.Contains(aws.ToString(iamRole.Arn), "aws-reserved") {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// The IAM roles with names starting with "AWSServiceRoleFor" are AWS Service-Linked Roles.
@@ -99,14 +98,15 @@ func shouldIncludeIAMRole(iamRole *types.Role, cfg config.ResourceType, tags []t
 	// Hence, we filter them out from cloud-nuke operations.
 	if strings.HasPrefix(aws.ToString(iamRole.RoleName), "AWSServiceRoleFor") {
 		logging.Debugf("Filtering out service linked role %s", aws.ToString(iamRole.RoleName))
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: iamRole.RoleName,
 		Time: iamRole.CreateDate,
 		Tags: util.ConvertIAMTagsToMap(tags),
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteIAMRole deletes a single IAM role and all its dependencies.
@@ -136,11 +136,38 @@ func TestIAMRoles_ListIAMRoles(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMRoles(context.Background(), mockClient, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
 
+func TestIAMRoles_ListIAMRoles_Details(t *testing.T) {
+	t.Parallel()
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockClient := mockedIAMRoles{
+		ListRolesOutput: iam.ListRolesOutput{
+			Roles: []types.Role{{
+				RoleName:   aws.String("test-role"),
+				Arn:        aws.String("arn:aws:iam::111111111111:role/test-role"),
+				CreateDate: aws.Time(created),
+			}},
+		},
+		ListRoleTagsOutputByName: map[string]*iam.ListRoleTagsOutput{
+			"test-role": {Tags: []types.Tag{{Key: aws.String("team"), Value: aws.String("qa")}}},
+		},
+	}
+
+	roles, err := listIAMRoles(context.Background(), mockClient, resource.Scope{}, config.ResourceType{})
+	require.NoError(t, err)
+	require.Equal(t, []resource.ResourceDetails{{
+		ID:        "test-role",
+		ARN:       "arn:aws:iam::111111111111:role/test-role",
+		Name:      "test-role",
+		CreatedAt: aws.Time(created),
+		Tags:      map[string]string{"team": "qa"},
+	}}, roles)
+}
+
 func TestIAMRoles_ListIAMRoles_DefaultExclusionTag(t *testing.T) {
 	t.Parallel()
 	testName1 := "test-role-excluded"
@@ -171,7 +198,7 @@ func TestIAMRoles_ListIAMRoles_DefaultExclusionTag(t *testing.T) {
 
 	require.NoError(t, err)
 	// testName1 should be excluded due to cloud-nuke-excluded tag, only testName2 should be returned
-	require.Equal(t, []string{testName2}, aws.ToStringSlice(names))
+	require.Equal(t, []string{testName2}, resource.IDs(names))
 }
 
 func TestIAMRoles_DeleteIAMRole(t *testing.T) {
@@ -284,7 +311,7 @@ func TestIAMRoles_ShouldIncludeIAMRole(t *testing.T) {
 			if tt.role.RoleName != nil || tt.role.Arn != nil {
 				rolePtr = &tt.role
 			}
-			result := shouldIncludeIAMRole(rolePtr, cfg, []types.Tag{})
+			_, result := shouldIncludeIAMRole(rolePtr, cfg, []types.Tag{})
 			require.Equal(t, tt.expected, result)
 		})
 	}
@@ -332,5 +359,5 @@ func TestIAMRoles_ListIAMRoles_ServiceLinkedRolesFiltered(t *testing.T) {
 	require.NoError(t, err)
 	// Should only return custom roles, not service-linked roles
 	expected := []string{"MyCustomRole", "AnotherCustomRole"}
-	require.Equal(t, expected, aws.ToStringSlice(roles))
+	require.Equal(t, expected, resource.IDs(roles))
 }
@@ -27,23 +27,23 @@ type IAMServiceLinkedRolesAPI interface {
 func NewIAMServiceLinkedRoles() AwsResource {
 	return NewAwsResource(&resource.Resource[IAMServiceLinkedRolesAPI]{
 		ResourceTypeName: "iam-service-linked-role",
+		Categories:       []string{resource.CategoryIAM},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[IAMServiceLinkedRolesAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.IAMServiceLinkedRoles
-		},
-		Lister: listIAMServiceLinkedRoles,
+		ConfigKey:      "IAMServiceLinkedRoles",
+		DetailedLister: listIAMServiceLinkedRoles,
 		// Use SequentialDeleter because each deletion requires async polling for status
 		Nuker: resource.SequentialDeleter(deleteIAMServiceLinkedRole),
 	})
 }
 
 // listIAMServiceLinkedRoles retrieves all IAM Service Linked Roles that match the config filters.
-func listIAMServiceLinkedRoles(ctx context.Context, client IAMServiceLinkedRolesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allRoles []*string
+func listIAMServiceLinkedRoles(ctx context.Context, client IAMServiceLinkedRolesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allRoles []resource.ResourceDetails
 
 	paginator := iam.NewListRolesPaginator(client, &iam.ListRolesInput{})
 	for paginator.HasMorePages() {
@@ -58,11 +58,12 @@ func listIAMServiceLinkedRoles(ctx context.Context, client IAMServiceLinkedRoles
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: role.CreateDate,
 				Name: role.RoleName,
-			}) {
-				allRoles = append(allRoles, role.RoleName)
+			}
+			if cfg.ShouldInclude(value) {
+				allRoles = append(allRoles, resource.NewResourceDetails(role.RoleName, value).WithARN(role.Arn))
 			}
 		}
 	}
@@ -89,7 +89,7 @@ func TestIAMServiceLinkedRoles_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMServiceLinkedRoles(context.Background(), client, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
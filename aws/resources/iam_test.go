@@ -172,7 +172,7 @@ func TestIAMUsers_ListIAMUsers(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listIAMUsers(context.Background(), client, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -29,17 +29,15 @@ func NewKinesisFirehose() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = firehose.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.KinesisFirehose
-		},
-		Lister: listKinesisFirehose,
-		Nuker:  resource.SimpleBatchDeleter(deleteKinesisFirehose),
+		ConfigKey:      "KinesisFirehose",
+		DetailedLister: listKinesisFirehose,
+		Nuker:          resource.SimpleBatchDeleter(deleteKinesisFirehose),
 	})
 }
 
 // listKinesisFirehose retrieves all Kinesis Firehose delivery streams that match the config filters.
-func listKinesisFirehose(ctx context.Context, client KinesisFirehoseAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listKinesisFirehose(ctx context.Context, client KinesisFirehoseAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	var exclusiveStartName *string
 
 	for {
@@ -59,11 +57,12 @@ func listKinesisFirehose(ctx context.Context, client KinesisFirehoseAPI, scope r
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: aws.String(stream),
 				Tags: util.ConvertFirehoseTagsToMap(tagsOutput.Tags),
-			}) {
-				ids = append(ids, aws.String(stream))
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(aws.String(stream), value))
 			}
 		}
 
@@ -78,7 +78,7 @@ func TestListKinesisFirehose(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listKinesisFirehose(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
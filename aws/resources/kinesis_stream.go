@@ -22,23 +22,22 @@ type KinesisStreamsAPI interface {
 func NewKinesisStreams() AwsResource {
 	return NewAwsResource(&resource.Resource[KinesisStreamsAPI]{
 		ResourceTypeName: "kinesis-stream",
+		DependsOn:        []string{"kinesis-firehose"},
 		// Conservative batch size to avoid AWS API rate limiting for sequential delete calls.
 		BatchSize: 35,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[KinesisStreamsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = kinesis.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.KinesisStream
-		},
-		Lister: listKinesisStreams,
-		Nuker:  resource.SimpleBatchDeleter(deleteKinesisStream),
+		ConfigKey:      "KinesisStream",
+		DetailedLister: listKinesisStreams,
+		Nuker:          resource.SimpleBatchDeleter(deleteKinesisStream),
 	})
 }
 
 // listKinesisStreams retrieves all Kinesis streams that match the config filters.
-func listKinesisStreams(ctx context.Context, client KinesisStreamsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allStreams []*string
+func listKinesisStreams(ctx context.Context, client KinesisStreamsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allStreams []resource.ResourceDetails
 
 	paginator := kinesis.NewListStreamsPaginator(client, &kinesis.ListStreamsInput{})
 	for paginator.HasMorePages() {
@@ -56,11 +55,12 @@ func listKinesisStreams(ctx context.Context, client KinesisStreamsAPI, scope res
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: aws.String(stream),
 				Tags: util.ConvertKinesisTagsToMap(tagsOutput.Tags),
-			}) {
-				allStreams = append(allStreams, aws.String(stream))
+			}
+			if cfg.ShouldInclude(value) {
+				allStreams = append(allStreams, resource.NewResourceDetails(aws.String(stream), value))
 			}
 		}
 	}
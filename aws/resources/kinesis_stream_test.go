@@ -80,7 +80,7 @@ func TestKinesisStreams_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listKinesisStreams(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -36,17 +36,18 @@ func NewKmsCustomerKeys() AwsResource {
 
 	return NewAwsResource(&resource.Resource[KmsCustomerKeysAPI]{
 		ResourceTypeName: "kms-customer-key",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[KmsCustomerKeysAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = kms.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
+		ConfigKey: "KMSCustomerKeys",
+		OnConfig: func(c config.Config) {
 			// Capture the IncludeUnaliasedKeys setting for use in the lister
 			kmsResource.includeUnaliasedKeys = c.KMSCustomerKeys.IncludeUnaliasedKeys
-			return c.KMSCustomerKeys.ResourceType
 		},
-		Lister: func(ctx context.Context, client KmsCustomerKeysAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+		DetailedLister: func(ctx context.Context, client KmsCustomerKeysAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 			return listKmsCustomerKeys(ctx, client, cfg, kmsResource.includeUnaliasedKeys)
 		},
 		Nuker: resource.SimpleBatchDeleter(deleteKmsCustomerKey),
@@ -54,7 +55,7 @@ func NewKmsCustomerKeys() AwsResource {
 }
 
 // listKmsCustomerKeys retrieves all KMS customer keys that match the config filters.
-func listKmsCustomerKeys(ctx context.Context, client KmsCustomerKeysAPI, cfg config.ResourceType, includeUnaliasedKeys bool) ([]*string, error) {
+func listKmsCustomerKeys(ctx context.Context, client KmsCustomerKeysAPI, cfg config.ResourceType, includeUnaliasedKeys bool) ([]resource.ResourceDetails, error) {
 	// Collect all keys using pagination
 	keys, err := getAllKeys(ctx, client)
 	if err != nil {
@@ -68,16 +69,16 @@ func listKmsCustomerKeys(ctx context.Context, client KmsCustomerKeysAPI, cfg con
 	}
 
 	// Filter keys based on configuration
-	var result []*string
+	var result []resource.ResourceDetails
 	for _, keyId := range keys {
-		shouldInclude, err := shouldIncludeKey(ctx, client, keyId, keyAliases[keyId], cfg, includeUnaliasedKeys)
+		value, shouldInclude, err := shouldIncludeKey(ctx, client, keyId, keyAliases[keyId], cfg, includeUnaliasedKeys)
 		if err != nil {
 			logging.Debugf("Error checking KMS key %s: %v", keyId, err)
 			continue
 		}
 		if shouldInclude {
 			id := keyId // Create a copy for the pointer
-			result = append(result, &id)
+			result = append(result, resource.NewResourceDetails(&id, value))
 		}
 	}
 
@@ -125,49 +126,51 @@ func getKeyAliasesMap(ctx context.Context, client KmsCustomerKeysAPI) (map[strin
 	return keyAliases, nil
 }
 
-// shouldIncludeKey determines if a key should be included for deletion.
-func shouldIncludeKey(ctx context.Context, client KmsCustomerKeysAPI, keyId string, aliases []string, cfg config.ResourceType, includeUnaliasedKeys bool) (bool, error) {
+// shouldIncludeKey determines if a key should be included for deletion, and returns the value it was filtered on.
+func shouldIncludeKey(ctx context.Context, client KmsCustomerKeysAPI, keyId string, aliases []string, cfg config.ResourceType, includeUnaliasedKeys bool) (config.ResourceValue, bool, error) {
 	// Skip keys without aliases unless explicitly configured to include them
 	if len(aliases) == 0 && !includeUnaliasedKeys {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Check if any alias matches the name filter
 	matchedByName := len(aliases) == 0 && includeUnaliasedKeys // Unaliased keys pass if configured
+	var matchedAlias *string
 	for _, alias := range aliases {
 		if config.ShouldInclude(&alias, cfg.IncludeRule.NamesRegExp, cfg.ExcludeRule.NamesRegExp) {
 			matchedByName = true
+			matchedAlias = &alias
 			break
 		}
 	}
 	if !matchedByName {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Get key metadata to check additional filters
 	details, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: &keyId})
 	if err != nil {
-		return false, err
+		return config.ResourceValue{}, false, err
 	}
 
 	metadata := details.KeyMetadata
 	if metadata == nil {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Only include customer-managed keys (not AWS-managed)
 	if metadata.KeyManager != types.KeyManagerTypeCustomer {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Skip keys already scheduled for deletion
 	if metadata.DeletionDate != nil || metadata.PendingDeletionWindowInDays != nil {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Check time-based filtering
 	if metadata.CreationDate != nil && !cfg.ShouldIncludeBasedOnTime(*metadata.CreationDate) {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
 	// Check tag-based filtering
@@ -178,10 +181,20 @@ func shouldIncludeKey(ctx context.Context, client KmsCustomerKeysAPI, keyId stri
 		tags = map[string]string{}
 	}
 	if !cfg.ShouldIncludeBasedOnTag(tags) {
-		return false, nil
+		return config.ResourceValue{}, false, nil
 	}
 
-	return true, nil
+	// Check expression-based filtering, with the alias that matched the name filter as the name of the key
+	value := config.ResourceValue{
+		Name: matchedAlias,
+		Time: metadata.CreationDate,
+		Tags: tags,
+	}
+	if !cfg.ShouldIncludeBasedOnExpression(value) {
+		return config.ResourceValue{}, false, nil
+	}
+
+	return value, true, nil
 }
 
 // getKmsKeyTags retrieves all tags for a KMS key as a map.
@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/stretchr/testify/require"
 )
 
@@ -108,13 +109,29 @@ func TestListKmsCustomerKeys(t *testing.T) {
 			},
 			expected: []string{key1},
 		},
+		"expressionInclusionFilter": {
+			configObj: config.ResourceType{
+				IncludeRule: config.FilterRule{
+					Expression: mustParseFilterExpression(t, `name.endsWith("key2")`),
+				},
+			},
+			expected: []string{key2},
+		},
+		"expressionExclusionFilter": {
+			configObj: config.ResourceType{
+				ExcludeRule: config.FilterRule{
+					Expression: mustParseFilterExpression(t, `time > now + duration("30m")`),
+				},
+			},
+			expected: []string{key1},
+		},
 	}
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
 			names, err := listKmsCustomerKeys(context.Background(), mock, tc.configObj, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -170,7 +187,7 @@ func TestListKmsCustomerKeys_IncludeUnaliasedKeys(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listKmsCustomerKeys(context.Background(), mock, config.ResourceType{}, tc.includeUnaliased)
 			require.NoError(t, err)
-			require.ElementsMatch(t, tc.expected, aws.ToStringSlice(names))
+			require.ElementsMatch(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -210,7 +227,7 @@ func TestListKmsCustomerKeys_FiltersAwsManagedKeys(t *testing.T) {
 
 	names, err := listKmsCustomerKeys(context.Background(), mock, config.ResourceType{}, false)
 	require.NoError(t, err)
-	require.Equal(t, []string{customerKey}, aws.ToStringSlice(names))
+	require.Equal(t, []string{customerKey}, resource.IDs(names))
 }
 
 func TestListKmsCustomerKeys_SkipsPendingDeletion(t *testing.T) {
@@ -251,7 +268,7 @@ func TestListKmsCustomerKeys_SkipsPendingDeletion(t *testing.T) {
 
 	names, err := listKmsCustomerKeys(context.Background(), mock, config.ResourceType{}, false)
 	require.NoError(t, err)
-	require.Equal(t, []string{activeKey}, aws.ToStringSlice(names))
+	require.Equal(t, []string{activeKey}, resource.IDs(names))
 }
 
 func TestDeleteKmsCustomerKey(t *testing.T) {
@@ -264,3 +281,9 @@ func TestDeleteKmsCustomerKey(t *testing.T) {
 	err := deleteKmsCustomerKey(context.Background(), mock, aws.String("test-key"))
 	require.NoError(t, err)
 }
+
+func mustParseFilterExpression(t *testing.T, source string) *config.FilterExpression {
+	expression, err := config.ParseFilterExpression(source)
+	require.NoError(t, err)
+	return expression
+}
@@ -23,22 +23,21 @@ type LambdaFunctionsAPI interface {
 func NewLambdaFunctions() AwsResource {
 	return NewAwsResource(&resource.Resource[LambdaFunctionsAPI]{
 		ResourceTypeName: "lambda",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LambdaFunctionsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = lambda.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.LambdaFunction
-		},
-		Lister: listLambdaFunctions,
-		Nuker:  resource.SimpleBatchDeleter(deleteLambdaFunction),
+		ConfigKey:      "LambdaFunction",
+		DetailedLister: listLambdaFunctions,
+		Nuker:          resource.SimpleBatchDeleter(deleteLambdaFunction),
 	})
 }
 
 // listLambdaFunctions retrieves all Lambda functions that match the config filters.
-func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var functions []resource.ResourceDetails
 
 	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
 	for paginator.HasMorePages() {
@@ -48,19 +47,23 @@ func listLambdaFunctions(ctx context.Context, client LambdaFunctionsAPI, scope r
 		}
 
 		for _, fn := range page.Functions {
-			if shouldIncludeLambdaFunction(ctx, client, &fn, cfg) {
-				names = append(names, fn.FunctionName)
+			value, ok := shouldIncludeLambdaFunction(ctx, client, &fn, cfg)
+			if !ok {
+				continue
 			}
+			functions = append(functions, resource.NewResourceDetails(fn.FunctionName, value).WithARN(fn.FunctionArn))
 		}
 	}
 
-	return names, nil
+	return functions, nil
 }
 
-// shouldIncludeLambdaFunction determines if a Lambda function should be included for deletion.
-func shouldIncludeLambdaFunction(ctx context.Context, client LambdaFunctionsAPI, lambdaFn *types.FunctionConfiguration, cfg config.ResourceType) bool {
+// shouldIncludeLambdaFunction determines if a Lambda function should be included for deletion,
+// returning the value it was filtered on.
+func shouldIncludeLambdaFunction(ctx context.Context, client LambdaFunctionsAPI, lambdaFn *types.FunctionConfiguration,
+	cfg config.ResourceType) (config.ResourceValue, bool) {
 	if lambdaFn == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	fnLastModified := aws.ToString(lambdaFn.LastModified)
@@ -68,7 +71,7 @@ func shouldIncludeLambdaFunction(ctx context.Context, client LambdaFunctionsAPI,
 	lastModifiedDateTime, err := time.Parse(awsLambdaTimeFormat, fnLastModified)
 	if err != nil {
 		logging.Debugf("Could not parse last modified timestamp (%s) of Lambda function %s. Excluding from delete.", fnLastModified, *fnName)
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	params := &lambda.ListTagsInput{
@@ -84,11 +87,17 @@ func shouldIncludeLambdaFunction(ctx context.Context, client LambdaFunctionsAPI,
 		tags = tagsOutput.Tags
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Time: &lastModifiedDateTime,
 		Name: fnName,
 		Tags: tags,
-	})
+		Attributes: map[string]interface{}{
+			"runtime":         string(lambdaFn.Runtime),
+			"last_modified":   fnLastModified,
+			"code_size_bytes": lambdaFn.CodeSize,
+		},
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteLambdaFunction deletes a single Lambda function.
@@ -28,23 +28,22 @@ type LambdaLayersAPI interface {
 func NewLambdaLayers() AwsResource {
 	return NewAwsResource(&resource.Resource[LambdaLayersAPI]{
 		ResourceTypeName: "lambda-layer",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LambdaLayersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = lambda.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.LambdaLayer
-		},
-		Lister: listLambdaLayers,
-		Nuker:  resource.SimpleBatchDeleter(deleteLambdaLayerVersion),
+		ConfigKey:      "LambdaLayer",
+		DetailedLister: listLambdaLayers,
+		Nuker:          resource.SimpleBatchDeleter(deleteLambdaLayerVersion),
 	})
 }
 
 // listLambdaLayers retrieves all Lambda layers that match the config filters.
 // Returns composite identifiers in the format "layerName:versionNumber" for each layer version.
-func listLambdaLayers(ctx context.Context, client LambdaLayersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listLambdaLayers(ctx context.Context, client LambdaLayersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := lambda.NewListLayersPaginator(client, &lambda.ListLayersInput{})
 	for paginator.HasMorePages() {
@@ -64,7 +63,8 @@ func listLambdaLayers(ctx context.Context, client LambdaLayersAPI, scope resourc
 				continue
 			}
 
-			if !shouldIncludeLambdaLayer(&layer, cfg, tagsOutput.Tags) {
+			value, ok := shouldIncludeLambdaLayer(&layer, cfg, tagsOutput.Tags)
+			if !ok {
 				continue
 			}
 
@@ -82,7 +82,7 @@ func listLambdaLayers(ctx context.Context, client LambdaLayersAPI, scope resourc
 					// Create composite identifier: layerName:versionNumber
 					id := fmt.Sprintf("%s:%d", *layer.LayerName, version.Version)
 					logging.Debugf("Found layer version: %s", id)
-					identifiers = append(identifiers, aws.String(id))
+					identifiers = append(identifiers, resource.NewResourceDetails(aws.String(id), value).WithARN(version.LayerVersionArn))
 				}
 			}
 		}
@@ -91,13 +91,13 @@ func listLambdaLayers(ctx context.Context, client LambdaLayersAPI, scope resourc
 	return identifiers, nil
 }
 
-func shouldIncludeLambdaLayer(lambdaLayer *types.LayersListItem, cfg config.ResourceType, tags map[string]string) bool {
+func shouldIncludeLambdaLayer(lambdaLayer *types.LayersListItem, cfg config.ResourceType, tags map[string]string) (config.ResourceValue, bool) {
 	if lambdaLayer == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	if lambdaLayer.LatestMatchingVersion == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// Lambda layers are immutable, so the created date of the latest version
@@ -107,14 +107,15 @@ func shouldIncludeLambdaLayer(lambdaLayer *types.LayersListItem, cfg config.Reso
 	lastModifiedDateTime, err := time.Parse(awsLambdaTimeFormat, fnLastModified)
 	if err != nil {
 		logging.Debugf("Could not parse last modified timestamp (%s) of Lambda layer %s. Excluding from delete.", fnLastModified, *fnName)
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Time: &lastModifiedDateTime,
 		Name: fnName,
 		Tags: tags,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteLambdaLayerVersion deletes a single Lambda layer version.
@@ -132,7 +132,7 @@ func TestListLambdaLayers(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listLambdaLayers(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -145,7 +145,7 @@ func TestShouldIncludeLambdaLayer_NilLatestMatchingVersion(t *testing.T) {
 		LatestMatchingVersion: nil, // nil version should be handled gracefully
 	}
 
-	result := shouldIncludeLambdaLayer(layer, config.ResourceType{}, nil)
+	_, result := shouldIncludeLambdaLayer(layer, config.ResourceType{}, nil)
 	require.False(t, result)
 }
 
@@ -49,6 +49,9 @@ func TestListLambdaFunctions(t *testing.T) {
 	testTime, err := time.Parse("2006-01-02T15:04:05.000+0000", testTimeStr)
 	require.NoError(t, err)
 
+	goFunctions, err := config.ParseFilterExpression(`attributes.runtime == "go1.x" && attributes.code_size_bytes < 1024`)
+	require.NoError(t, err)
+
 	tests := map[string]struct {
 		mock      *mockLambdaClient
 		configObj config.ResourceType
@@ -119,12 +122,26 @@ func TestListLambdaFunctions(t *testing.T) {
 			},
 			expected: []string{testName2},
 		},
+		"attributeExpressionFilter": {
+			mock: &mockLambdaClient{
+				ListFunctionsOutput: lambda.ListFunctionsOutput{
+					Functions: []types.FunctionConfiguration{
+						{FunctionName: aws.String(testName1), FunctionArn: aws.String(testArn1), LastModified: aws.String(testTimeStr), Runtime: types.RuntimeGo1x, CodeSize: 512},
+						{FunctionName: aws.String(testName2), FunctionArn: aws.String(testArn2), LastModified: aws.String(testTimeStr), Runtime: types.RuntimePython312, CodeSize: 512},
+					},
+				},
+			},
+			configObj: config.ResourceType{
+				IncludeRule: config.FilterRule{Expression: goFunctions},
+			},
+			expected: []string{testName1},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			names, err := listLambdaFunctions(context.Background(), tc.mock, resource.Scope{}, tc.configObj)
+			functions, err := listLambdaFunctions(context.Background(), tc.mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(functions))
 		})
 	}
 }
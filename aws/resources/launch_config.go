@@ -20,22 +20,23 @@ type LaunchConfigsAPI interface {
 func NewLaunchConfigs() AwsResource {
 	return NewAwsResource(&resource.Resource[LaunchConfigsAPI]{
 		ResourceTypeName: "launch-configuration",
+		Categories:       []string{resource.CategoryCompute},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"asg"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LaunchConfigsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = autoscaling.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.LaunchConfiguration
-		},
-		Lister: listLaunchConfigs,
-		Nuker:  resource.SimpleBatchDeleter(deleteLaunchConfig),
+		ConfigKey:      "LaunchConfiguration",
+		DetailedLister: listLaunchConfigs,
+		Nuker:          resource.SimpleBatchDeleter(deleteLaunchConfig),
 	})
 }
 
 // listLaunchConfigs retrieves all launch configurations that match the config filters.
-func listLaunchConfigs(ctx context.Context, client LaunchConfigsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listLaunchConfigs(ctx context.Context, client LaunchConfigsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := autoscaling.NewDescribeLaunchConfigurationsPaginator(client, &autoscaling.DescribeLaunchConfigurationsInput{})
 	for paginator.HasMorePages() {
@@ -45,11 +46,12 @@ func listLaunchConfigs(ctx context.Context, client LaunchConfigsAPI, scope resou
 		}
 
 		for _, lc := range page.LaunchConfigurations {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: lc.CreatedTime,
 				Name: lc.LaunchConfigurationName,
-			}) {
-				names = append(names, lc.LaunchConfigurationName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(lc.LaunchConfigurationName, value).WithARN(lc.LaunchConfigurationARN))
 			}
 		}
 	}
@@ -82,7 +82,7 @@ func TestLaunchConfigs_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listLaunchConfigs(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -20,22 +20,22 @@ type LaunchTemplatesAPI interface {
 func NewLaunchTemplates() AwsResource {
 	return NewAwsResource(&resource.Resource[LaunchTemplatesAPI]{
 		ResourceTypeName: "launch-template",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"asg", "ec2"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[LaunchTemplatesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.LaunchTemplate
-		},
-		Lister: listLaunchTemplates,
-		Nuker:  resource.SimpleBatchDeleter(deleteLaunchTemplate),
+		ConfigKey:      "LaunchTemplate",
+		DetailedLister: listLaunchTemplates,
+		Nuker:          resource.SimpleBatchDeleter(deleteLaunchTemplate),
 	})
 }
 
 // listLaunchTemplates retrieves all launch templates that match the config filters.
-func listLaunchTemplates(ctx context.Context, client LaunchTemplatesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listLaunchTemplates(ctx context.Context, client LaunchTemplatesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeLaunchTemplatesPaginator(client, &ec2.DescribeLaunchTemplatesInput{})
 	for paginator.HasMorePages() {
@@ -45,12 +45,13 @@ func listLaunchTemplates(ctx context.Context, client LaunchTemplatesAPI, scope r
 		}
 
 		for _, template := range page.LaunchTemplates {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: template.LaunchTemplateName,
 				Time: template.CreateTime,
 				Tags: util.ConvertTypesTagsToMap(template.Tags),
-			}) {
-				names = append(names, template.LaunchTemplateName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(template.LaunchTemplateName, value))
 			}
 		}
 	}
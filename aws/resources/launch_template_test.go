@@ -91,7 +91,7 @@ func TestListLaunchTemplates(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listLaunchTemplates(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -29,23 +29,22 @@ type MacieMemberAPI interface {
 func NewMacieMember() AwsResource {
 	return NewAwsResource(&resource.Resource[MacieMemberAPI]{
 		ResourceTypeName: "macie-member",
+		Categories:       []string{resource.CategoryObservability},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[MacieMemberAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = macie2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.MacieMember
-		},
-		Lister: listMacieSessions,
-		Nuker:  deleteMacieSessions,
+		ConfigKey:      "MacieMember",
+		DetailedLister: listMacieSessions,
+		Nuker:          deleteMacieSessions,
 	})
 }
 
 // listMacieSessions retrieves Macie sessions that match the config filters.
 // Unlike most resources, Macie has a single session per region, so we return
 // the status as an identifier if the session exists and matches filters.
-func listMacieSessions(ctx context.Context, client MacieMemberAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listMacieSessions(ctx context.Context, client MacieMemberAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.GetMacieSession(ctx, &macie2.GetMacieSessionInput{})
 	if err != nil {
 		// If Macie is not enabled, the API returns an error indicating so.
@@ -79,8 +78,9 @@ func listMacieSessions(ctx context.Context, client MacieMemberAPI, scope resourc
 	}
 
 	// Use status as identifier since Macie doesn't have a unique resource ID
-	if cfg.ShouldInclude(config.ResourceValue{Time: output.CreatedAt, Tags: tags}) {
-		return []*string{aws.String(string(output.Status))}, nil
+	value := config.ResourceValue{Time: output.CreatedAt, Tags: tags}
+	if cfg.ShouldInclude(value) {
+		return []resource.ResourceDetails{resource.NewResourceDetails(aws.String(string(output.Status)), value)}, nil
 	}
 
 	return nil, nil
@@ -110,7 +110,7 @@ func TestListMacieSessions(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			results, err := listMacieSessions(context.Background(), tc.mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(results))
+			require.Equal(t, tc.expected, resource.IDs(results))
 		})
 	}
 }
@@ -195,7 +195,7 @@ func TestListMacieSessions_TimeInclusionFilter(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Len(t, results, 1)
-	require.Equal(t, "ENABLED", aws.ToString(results[0]))
+	require.Equal(t, "ENABLED", results[0].ID)
 }
 
 func TestListMacieSessions_TagInclusionFilter(t *testing.T) {
@@ -235,5 +235,5 @@ func TestListMacieSessions_TagInclusionFilter(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Len(t, results, 1)
-	require.Equal(t, "ENABLED", aws.ToString(results[0]))
+	require.Equal(t, "ENABLED", results[0].ID)
 }
@@ -20,22 +20,21 @@ type ManagedPrometheusAPI interface {
 func NewManagedPrometheus() AwsResource {
 	return NewAwsResource(&resource.Resource[ManagedPrometheusAPI]{
 		ResourceTypeName: "managed-prometheus",
+		Categories:       []string{resource.CategoryObservability},
 		BatchSize:        100,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[ManagedPrometheusAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = amp.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ManagedPrometheus
-		},
-		Lister: listManagedPrometheusWorkspaces,
-		Nuker:  resource.SimpleBatchDeleter(deleteManagedPrometheusWorkspace),
+		ConfigKey:      "ManagedPrometheus",
+		DetailedLister: listManagedPrometheusWorkspaces,
+		Nuker:          resource.SimpleBatchDeleter(deleteManagedPrometheusWorkspace),
 	})
 }
 
 // listManagedPrometheusWorkspaces retrieves all Managed Prometheus Workspaces that match the config filters.
-func listManagedPrometheusWorkspaces(ctx context.Context, client ManagedPrometheusAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var workspaceIDs []*string
+func listManagedPrometheusWorkspaces(ctx context.Context, client ManagedPrometheusAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var workspaceIDs []resource.ResourceDetails
 
 	paginator := amp.NewListWorkspacesPaginator(client, &amp.ListWorkspacesInput{})
 	for paginator.HasMorePages() {
@@ -49,12 +48,13 @@ func listManagedPrometheusWorkspaces(ctx context.Context, client ManagedPromethe
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: workspace.Alias,
 				Time: workspace.CreatedAt,
 				Tags: workspace.Tags,
-			}) {
-				workspaceIDs = append(workspaceIDs, workspace.WorkspaceId)
+			}
+			if cfg.ShouldInclude(value) {
+				workspaceIDs = append(workspaceIDs, resource.NewResourceDetails(workspace.WorkspaceId, value).WithARN(workspace.Arn))
 			}
 		}
 	}
@@ -88,7 +88,7 @@ func Test_ManagedPrometheus_List(t *testing.T) {
 			}
 			workspaces, err := listManagedPrometheusWorkspaces(context.Background(), client, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			assert.Equal(t, tc.expected, aws.ToStringSlice(workspaces))
+			assert.Equal(t, tc.expected, resource.IDs(workspaces))
 		})
 	}
 }
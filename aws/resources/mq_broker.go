@@ -27,17 +27,15 @@ func NewMQBroker() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = mq.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.MQBroker
-		},
-		Lister: listMQBrokers,
-		Nuker:  resource.SimpleBatchDeleter(deleteMQBroker),
+		ConfigKey:      "MQBroker",
+		DetailedLister: listMQBrokers,
+		Nuker:          resource.SimpleBatchDeleter(deleteMQBroker),
 	})
 }
 
 // listMQBrokers retrieves all Amazon MQ brokers that match the config filters.
-func listMQBrokers(ctx context.Context, client MQBrokerAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listMQBrokers(ctx context.Context, client MQBrokerAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 	paginator := mq.NewListBrokersPaginator(client, &mq.ListBrokersInput{
 		MaxResults: aws.Int32(100),
 	})
@@ -65,12 +63,13 @@ func listMQBrokers(ctx context.Context, client MQBrokerAPI, scope resource.Scope
 				tags = tagsOutput.Tags
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: broker.BrokerName,
 				Time: broker.Created,
 				Tags: tags,
-			}) {
-				identifiers = append(identifiers, broker.BrokerId)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(broker.BrokerId, value).WithARN(broker.BrokerArn))
 			}
 		}
 	}
@@ -111,7 +111,7 @@ func TestListMQBrokers(t *testing.T) {
 			}
 			ids, err := listMQBrokers(context.Background(), client, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
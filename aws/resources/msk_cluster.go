@@ -25,17 +25,15 @@ func NewMSKCluster() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = kafka.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.MSKCluster
-		},
-		Lister: listMSKClusters,
-		Nuker:  resource.SimpleBatchDeleter(deleteMSKCluster),
+		ConfigKey:      "MSKCluster",
+		DetailedLister: listMSKClusters,
+		Nuker:          resource.SimpleBatchDeleter(deleteMSKCluster),
 	})
 }
 
 // listMSKClusters retrieves all MSK clusters that match the config filters.
-func listMSKClusters(ctx context.Context, client MSKClusterAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var clusterArns []*string
+func listMSKClusters(ctx context.Context, client MSKClusterAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var clusterArns []resource.ResourceDetails
 
 	paginator := kafka.NewListClustersV2Paginator(client, &kafka.ListClustersV2Input{})
 	for paginator.HasMorePages() {
@@ -45,8 +43,8 @@ func listMSKClusters(ctx context.Context, client MSKClusterAPI, scope resource.S
 		}
 
 		for _, cluster := range page.ClusterInfoList {
-			if shouldIncludeMSKCluster(cluster, cfg) {
-				clusterArns = append(clusterArns, cluster.ClusterArn)
+			if value, ok := shouldIncludeMSKCluster(cluster, cfg); ok {
+				clusterArns = append(clusterArns, resource.NewResourceDetails(cluster.ClusterArn, value).WithARN(cluster.ClusterArn))
 			}
 		}
 	}
@@ -54,19 +52,20 @@ func listMSKClusters(ctx context.Context, client MSKClusterAPI, scope resource.S
 	return clusterArns, nil
 }
 
-// shouldIncludeMSKCluster determines if a cluster should be included based on state and config.
+// shouldIncludeMSKCluster determines if a cluster should be included based on state and config, returning the value it was filtered on.
 // Skips clusters in non-deletable states: DELETING, CREATING, MAINTENANCE.
-func shouldIncludeMSKCluster(cluster types.Cluster, cfg config.ResourceType) bool {
+func shouldIncludeMSKCluster(cluster types.Cluster, cfg config.ResourceType) (config.ResourceValue, bool) {
 	switch cluster.State {
 	case types.ClusterStateDeleting, types.ClusterStateCreating, types.ClusterStateMaintenance:
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: cluster.ClusterName,
 		Time: cluster.CreationTime,
 		Tags: cluster.Tags,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteMSKCluster deletes a single MSK cluster.
@@ -87,7 +87,7 @@ func TestMSKCluster_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listMSKClusters(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -138,7 +138,7 @@ func TestMSKCluster_ShouldInclude(t *testing.T) {
 
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			result := shouldIncludeMSKCluster(tc.cluster, config.ResourceType{})
+			_, result := shouldIncludeMSKCluster(tc.cluster, config.ResourceType{})
 			require.Equal(t, tc.expected, result)
 		})
 	}
@@ -31,15 +31,15 @@ func NewNatGateways() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		func(c config.Config) config.EC2ResourceType { return c.NATGateway },
+		"NATGateway",
 		listNatGateways,
 		resource.ConcurrentDeleteThenWaitAll(deleteNatGateway, waitForNatGatewaysDeleted),
-		nil,
+		&EC2ResourceOptions[NatGatewaysAPI]{Categories: []string{resource.CategoryNetwork}},
 	)
 }
 
 // listNatGateways retrieves all NAT Gateways that match the config filters.
-func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
+func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.Scope, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
 	// When defaultOnly is true, get the list of default VPC IDs to filter by
 	var defaultVpcIds map[string]bool
 	if defaultOnly {
@@ -60,7 +60,7 @@ func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.
 		}
 	}
 
-	var allNatGateways []*string
+	var allNatGateways []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeNatGatewaysPaginator(client, &ec2.DescribeNatGatewaysInput{})
 
@@ -76,8 +76,8 @@ func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.
 				continue
 			}
 
-			if shouldIncludeNatGateway(gateway, cfg) {
-				allNatGateways = append(allNatGateways, gateway.NatGatewayId)
+			if value, ok := shouldIncludeNatGateway(gateway, cfg); ok {
+				allNatGateways = append(allNatGateways, resource.NewResourceDetails(gateway.NatGatewayId, value))
 			}
 		}
 	}
@@ -85,16 +85,17 @@ func listNatGateways(ctx context.Context, client NatGatewaysAPI, scope resource.
 	return allNatGateways, nil
 }
 
-func shouldIncludeNatGateway(ngw types.NatGateway, cfg config.ResourceType) bool {
+func shouldIncludeNatGateway(ngw types.NatGateway, cfg config.ResourceType) (config.ResourceValue, bool) {
 	if ngw.State == types.NatGatewayStateDeleted || ngw.State == types.NatGatewayStateDeleting {
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Time: ngw.CreateTime,
 		Name: getNatGatewayName(ngw),
 		Tags: util.ConvertTypesTagsToMap(ngw.Tags),
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 func getNatGatewayName(ngw types.NatGateway) *string {
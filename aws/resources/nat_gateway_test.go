@@ -93,7 +93,7 @@ func TestListNatGateways(t *testing.T) {
 
 			result, err := listNatGateways(context.Background(), mock, resource.Scope{}, tc.config, false)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(result))
+			require.Equal(t, tc.expected, resource.IDs(result))
 		})
 	}
 }
@@ -24,23 +24,22 @@ type NetworkFirewallAPI interface {
 func NewNetworkFirewalls() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkFirewallAPI]{
 		ResourceTypeName: "network-firewall",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkFirewallAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = networkfirewall.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkFirewall
-		},
-		Lister:             listNetworkFirewalls,
+		ConfigKey:          "NetworkFirewall",
+		DetailedLister:     listNetworkFirewalls,
 		Nuker:              resource.SimpleBatchDeleter(deleteNetworkFirewall),
 		PermissionVerifier: verifyNetworkFirewallPermissions,
 	})
 }
 
 // listNetworkFirewalls retrieves all Network Firewalls that match the config filters.
-func listNetworkFirewalls(ctx context.Context, client NetworkFirewallAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listNetworkFirewalls(ctx context.Context, client NetworkFirewallAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := networkfirewall.NewListFirewallsPaginator(client, &networkfirewall.ListFirewallsInput{})
 
@@ -71,8 +70,8 @@ func listNetworkFirewalls(ctx context.Context, client NetworkFirewallAPI, scope
 				continue
 			}
 
-			if shouldIncludeNetworkFirewall(output.Firewall, firstSeenTime, cfg) {
-				identifiers = append(identifiers, firewall.FirewallName)
+			if value, ok := shouldIncludeNetworkFirewall(output.Firewall, firstSeenTime, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(firewall.FirewallName, value).WithARN(firewall.FirewallArn))
 			}
 		}
 	}
@@ -80,19 +79,20 @@ func listNetworkFirewalls(ctx context.Context, client NetworkFirewallAPI, scope
 	return identifiers, nil
 }
 
-// shouldIncludeNetworkFirewall determines if a firewall should be included based on config filters.
-func shouldIncludeNetworkFirewall(firewall *types.Firewall, firstSeenTime *time.Time, cfg config.ResourceType) bool {
+// shouldIncludeNetworkFirewall determines if a firewall should be included based on config filters, returning the value it was filtered on.
+func shouldIncludeNetworkFirewall(firewall *types.Firewall, firstSeenTime *time.Time, cfg config.ResourceType) (config.ResourceValue, bool) {
 	tags := util.ConvertNetworkFirewallTagsToMap(firewall.Tags)
 	identifierName := aws.ToString(firewall.FirewallName)
 	if v, ok := tags["Name"]; ok {
 		identifierName = v
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: &identifierName,
 		Tags: tags,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // verifyNetworkFirewallPermissions checks if the firewall has delete protection enabled.
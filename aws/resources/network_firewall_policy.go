@@ -24,22 +24,22 @@ type NetworkFirewallPolicyAPI interface {
 func NewNetworkFirewallPolicy() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkFirewallPolicyAPI]{
 		ResourceTypeName: "network-firewall-policy",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
+		DependsOn:        []string{"network-firewall"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkFirewallPolicyAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = networkfirewall.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkFirewallPolicy
-		},
-		Lister: listNetworkFirewallPolicies,
-		Nuker:  resource.SimpleBatchDeleter(deleteNetworkFirewallPolicy),
+		ConfigKey:      "NetworkFirewallPolicy",
+		DetailedLister: listNetworkFirewallPolicies,
+		Nuker:          resource.SimpleBatchDeleter(deleteNetworkFirewallPolicy),
 	})
 }
 
 // listNetworkFirewallPolicies retrieves all Network Firewall Policies that match the config filters.
-func listNetworkFirewallPolicies(ctx context.Context, client NetworkFirewallPolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listNetworkFirewallPolicies(ctx context.Context, client NetworkFirewallPolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := networkfirewall.NewListFirewallPoliciesPaginator(client, &networkfirewall.ListFirewallPoliciesInput{})
 
@@ -63,8 +63,8 @@ func listNetworkFirewallPolicies(ctx context.Context, client NetworkFirewallPoli
 				continue
 			}
 
-			if shouldIncludeNetworkFirewallPolicy(ctx, client, policy.Arn, output.FirewallPolicyResponse, cfg) {
-				identifiers = append(identifiers, policy.Name)
+			if value, ok := shouldIncludeNetworkFirewallPolicy(ctx, client, policy.Arn, output.FirewallPolicyResponse, cfg); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(policy.Name, value).WithARN(policy.Arn))
 			}
 		}
 	}
@@ -72,12 +72,12 @@ func listNetworkFirewallPolicies(ctx context.Context, client NetworkFirewallPoli
 	return identifiers, nil
 }
 
-// shouldIncludeNetworkFirewallPolicy determines if a firewall policy should be included for deletion.
-func shouldIncludeNetworkFirewallPolicy(ctx context.Context, client NetworkFirewallPolicyAPI, arn *string, policy *types.FirewallPolicyResponse, cfg config.ResourceType) bool {
+// shouldIncludeNetworkFirewallPolicy determines if a firewall policy should be included for deletion, returning the value it was filtered on.
+func shouldIncludeNetworkFirewallPolicy(ctx context.Context, client NetworkFirewallPolicyAPI, arn *string, policy *types.FirewallPolicyResponse, cfg config.ResourceType) (config.ResourceValue, bool) {
 	// Skip policies that are still in use
 	if aws.ToInt32(policy.NumberOfAssociations) > 0 {
 		logging.Debugf("[Skipping] policy %s is still in use", aws.ToString(policy.FirewallPolicyName))
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	tags := util.ConvertNetworkFirewallTagsToMap(policy.Tags)
@@ -85,14 +85,15 @@ func shouldIncludeNetworkFirewallPolicy(ctx context.Context, client NetworkFirew
 	firstSeenTime, err := util.GetOrCreateFirstSeen(ctx, client, arn, tags)
 	if err != nil {
 		logging.Errorf("Unable to retrieve first seen time for policy %s: %v", aws.ToString(policy.FirewallPolicyName), err)
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: getNetworkFirewallPolicyName(tags),
 		Tags: tags,
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // getNetworkFirewallPolicyName returns the Name tag value if present, otherwise nil.
@@ -114,7 +114,7 @@ func TestNetworkFirewallPolicy_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listNetworkFirewallPolicies(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -27,22 +27,22 @@ type NetworkFirewallResourcePolicyAPI interface {
 func NewNetworkFirewallResourcePolicy() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkFirewallResourcePolicyAPI]{
 		ResourceTypeName: "network-firewall-resource-policy",
+		Categories:       []string{resource.CategoryNetwork},
+		NoTags:           true,
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkFirewallResourcePolicyAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = networkfirewall.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkFirewallResourcePolicy
-		},
-		Lister: listNetworkFirewallResourcePolicies,
-		Nuker:  resource.SimpleBatchDeleter(deleteNetworkFirewallResourcePolicy),
+		ConfigKey:      "NetworkFirewallResourcePolicy",
+		DetailedLister: listNetworkFirewallResourcePolicies,
+		Nuker:          resource.SimpleBatchDeleter(deleteNetworkFirewallResourcePolicy),
 	})
 }
 
 // listNetworkFirewallResourcePolicies retrieves all resource policy ARNs by finding firewall policies
 // and rule groups that have resource policies attached.
-func listNetworkFirewallResourcePolicies(ctx context.Context, client NetworkFirewallResourcePolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listNetworkFirewallResourcePolicies(ctx context.Context, client NetworkFirewallResourcePolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Collect all resource ARNs (firewall policies + rule groups)
 	resourceArns, err := collectResourceArns(ctx, client)
 	if err != nil {
@@ -50,7 +50,7 @@ func listNetworkFirewallResourcePolicies(ctx context.Context, client NetworkFire
 	}
 
 	// Filter to only those with resource policies attached
-	var ids []*string
+	var ids []resource.ResourceDetails
 	for _, arn := range resourceArns {
 		output, err := client.DescribeResourcePolicy(ctx, &networkfirewall.DescribeResourcePolicyInput{
 			ResourceArn: arn,
@@ -61,8 +61,9 @@ func listNetworkFirewallResourcePolicies(ctx context.Context, client NetworkFire
 
 		// Include if a policy exists and passes the filter
 		if output != nil && output.Policy != nil {
-			if cfg.ShouldInclude(config.ResourceValue{Name: arn}) {
-				ids = append(ids, arn)
+			value := config.ResourceValue{Name: arn}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(arn, value))
 			}
 		}
 	}
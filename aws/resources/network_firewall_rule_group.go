@@ -23,22 +23,22 @@ type NetworkFirewallRuleGroupAPI interface {
 func NewNetworkFirewallRuleGroup() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkFirewallRuleGroupAPI]{
 		ResourceTypeName: "network-firewall-rule-group",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
+		DependsOn:        []string{"network-firewall-policy"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkFirewallRuleGroupAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = networkfirewall.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkFirewallRuleGroup
-		},
-		Lister: listNetworkFirewallRuleGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteNetworkFirewallRuleGroup),
+		ConfigKey:      "NetworkFirewallRuleGroup",
+		DetailedLister: listNetworkFirewallRuleGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteNetworkFirewallRuleGroup),
 	})
 }
 
 // listNetworkFirewallRuleGroups retrieves all Network Firewall rule groups that match the config filters.
-func listNetworkFirewallRuleGroups(ctx context.Context, client NetworkFirewallRuleGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listNetworkFirewallRuleGroups(ctx context.Context, client NetworkFirewallRuleGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := networkfirewall.NewListRuleGroupsPaginator(client, &networkfirewall.ListRuleGroupsInput{})
 
@@ -82,16 +82,17 @@ func listNetworkFirewallRuleGroups(ctx context.Context, client NetworkFirewallRu
 				continue
 			}
 
-			if !cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &identifierName,
 				Tags: tags,
 				Time: firstSeenTime,
-			}) {
+			}
+			if !cfg.ShouldInclude(value) {
 				continue
 			}
 
 			// Use ARN as identifier - DeleteRuleGroup can use ARN without requiring Type
-			identifiers = append(identifiers, group.Arn)
+			identifiers = append(identifiers, resource.NewResourceDetails(group.Arn, value).WithARN(group.Arn))
 		}
 	}
 
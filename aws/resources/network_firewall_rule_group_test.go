@@ -112,7 +112,7 @@ func TestNetworkFirewallRuleGroup_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			arns, err := listNetworkFirewallRuleGroups(ctx, mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(arns))
+			require.Equal(t, tc.expected, resource.IDs(arns))
 		})
 	}
 }
@@ -111,7 +111,7 @@ func TestListNetworkFirewalls(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listNetworkFirewalls(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -23,22 +23,22 @@ type NetworkFirewallTLSConfigAPI interface {
 func NewNetworkFirewallTLSConfig() AwsResource {
 	return NewAwsResource(&resource.Resource[NetworkFirewallTLSConfigAPI]{
 		ResourceTypeName: "network-firewall-tls-config",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"network-firewall"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[NetworkFirewallTLSConfigAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = networkfirewall.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.NetworkFirewallTLSConfig
-		},
-		Lister: listNetworkFirewallTLSConfigs,
-		Nuker:  resource.SimpleBatchDeleter(deleteNetworkFirewallTLSConfig),
+		ConfigKey:      "NetworkFirewallTLSConfig",
+		DetailedLister: listNetworkFirewallTLSConfigs,
+		Nuker:          resource.SimpleBatchDeleter(deleteNetworkFirewallTLSConfig),
 	})
 }
 
 // listNetworkFirewallTLSConfigs retrieves all TLS inspection configurations that match the config filters.
-func listNetworkFirewallTLSConfigs(ctx context.Context, client NetworkFirewallTLSConfigAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listNetworkFirewallTLSConfigs(ctx context.Context, client NetworkFirewallTLSConfigAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := networkfirewall.NewListTLSInspectionConfigurationsPaginator(client, &networkfirewall.ListTLSInspectionConfigurationsInput{})
 	for paginator.HasMorePages() {
@@ -74,12 +74,13 @@ func listNetworkFirewallTLSConfigs(ctx context.Context, client NetworkFirewallTL
 				identifierName = nameTag
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: &identifierName,
 				Tags: tags,
 				Time: firstSeenTime,
-			}) {
-				identifiers = append(identifiers, tlsConfig.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(tlsConfig.Name, value).WithARN(tlsConfig.Arn))
 			}
 		}
 	}
@@ -107,7 +107,7 @@ func TestListNetworkFirewallTLSConfigs(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listNetworkFirewallTLSConfigs(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
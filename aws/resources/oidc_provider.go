@@ -28,16 +28,15 @@ type OIDCProvidersAPI interface {
 func NewOIDCProviders() AwsResource {
 	return NewAwsResource(&resource.Resource[OIDCProvidersAPI]{
 		ResourceTypeName: "oidc-provider",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[OIDCProvidersAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = iam.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.OIDCProvider
-		},
-		Lister: listOIDCProviders,
-		Nuker:  resource.SimpleBatchDeleter(deleteOIDCProvider),
+		ConfigKey:      "OIDCProvider",
+		DetailedLister: listOIDCProviders,
+		Nuker:          resource.SimpleBatchDeleter(deleteOIDCProvider),
 	})
 }
 
@@ -53,7 +52,7 @@ type oidcProviderDetail struct {
 // listOIDCProviders retrieves all OIDC providers that match the config filters.
 // Note: ListOpenIDConnectProviders does not support pagination as it returns all providers in one call.
 // Details must be fetched individually using GetOpenIDConnectProvider.
-func listOIDCProviders(ctx context.Context, client OIDCProvidersAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listOIDCProviders(ctx context.Context, client OIDCProvidersAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
@@ -70,14 +69,15 @@ func listOIDCProviders(ctx context.Context, client OIDCProvidersAPI, _ resource.
 		return nil, err
 	}
 
-	var result []*string
+	var result []resource.ResourceDetails
 	for _, provider := range providers {
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: provider.ProviderURL,
 			Time: provider.CreateTime,
 			Tags: provider.Tags,
-		}) {
-			result = append(result, provider.ARN)
+		}
+		if cfg.ShouldInclude(value) {
+			result = append(result, resource.NewResourceDetails(provider.ARN, value).WithARN(provider.ARN))
 		}
 	}
 
@@ -104,7 +104,7 @@ func TestOIDCProvider_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			result, err := listOIDCProviders(context.Background(), client, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(result))
+			require.Equal(t, tc.expected, resource.IDs(result))
 		})
 	}
 }
@@ -33,22 +33,21 @@ type OpenSearchDomainsAPI interface {
 func NewOpenSearchDomains() AwsResource {
 	return NewAwsResource(&resource.Resource[OpenSearchDomainsAPI]{
 		ResourceTypeName: "opensearch-domain",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[OpenSearchDomainsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = opensearch.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.OpenSearchDomain
-		},
-		Lister: listOpenSearchDomains,
-		Nuker:  resource.ConcurrentDeleteThenWaitAll(deleteOpenSearchDomain, waitForOpenSearchDomainsDeleted),
+		ConfigKey:      "OpenSearchDomain",
+		DetailedLister: listOpenSearchDomains,
+		Nuker:          resource.ConcurrentDeleteThenWaitAll(deleteOpenSearchDomain, waitForOpenSearchDomainsDeleted),
 	})
 }
 
 // listOpenSearchDomains queries AWS for all active domains that meet the nuking criteria.
 // OpenSearch Domains do not have resource timestamps, so we use the first-seen tagging pattern.
-func listOpenSearchDomains(ctx context.Context, client OpenSearchDomainsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listOpenSearchDomains(ctx context.Context, client OpenSearchDomainsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	domains, err := getAllActiveOpenSearchDomains(ctx, client)
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
@@ -59,7 +58,7 @@ func listOpenSearchDomains(ctx context.Context, client OpenSearchDomainsAPI, sco
 		return nil, errors.WithStackTrace(err)
 	}
 
-	var domainsToNuke []*string
+	var domainsToNuke []resource.ResourceDetails
 	for _, domain := range domains {
 		var firstSeenTime *time.Time
 
@@ -81,12 +80,13 @@ func listOpenSearchDomains(ctx context.Context, client OpenSearchDomainsAPI, sco
 			}
 		}
 
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: domain.DomainName,
 			Time: firstSeenTime,
 			Tags: tags,
-		}) {
-			domainsToNuke = append(domainsToNuke, domain.DomainName)
+		}
+		if cfg.ShouldInclude(value) {
+			domainsToNuke = append(domainsToNuke, resource.NewResourceDetails(domain.DomainName, value).WithARN(domain.ARN))
 		}
 	}
 
@@ -105,7 +105,7 @@ func TestOpenSearch_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listOpenSearchDomains(ctx, mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -134,7 +134,7 @@ func TestOpenSearch_GetAll_FiltersDeletedDomains(t *testing.T) {
 
 	names, err := listOpenSearchDomains(ctx, mockClient, resource.Scope{Region: "us-east-1"}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"active-domain"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"active-domain"}, resource.IDs(names))
 }
 
 func TestOpenSearch_Nuke(t *testing.T) {
@@ -186,5 +186,5 @@ func TestOpenSearch_GetAll_TagFetchErrorContinues(t *testing.T) {
 	names, err := listOpenSearchDomains(ctx, mockClient, resource.Scope{Region: "us-east-1"}, config.ResourceType{})
 	require.NoError(t, err)
 	// Domain is still included with empty tags (no tag filter configured)
-	require.Equal(t, []string{"domain-with-tag-error"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"domain-with-tag-error"}, resource.IDs(names))
 }
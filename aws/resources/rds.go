@@ -24,22 +24,21 @@ type DBInstancesAPI interface {
 func NewDBInstances() AwsResource {
 	return NewAwsResource(&resource.Resource[DBInstancesAPI]{
 		ResourceTypeName: "rds-instance",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBInstancesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBInstances.ResourceType
-		},
-		Lister: listDBInstances,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDBInstance, waitForDBInstancesDeleted),
+		ConfigKey:      "DBInstances",
+		DetailedLister: listDBInstances,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteDBInstance, waitForDBInstancesDeleted),
 	})
 }
 
 // listDBInstances retrieves all RDS DB instances that match the config filters.
-func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := rds.NewDescribeDBInstancesPaginator(client, &rds.DescribeDBInstancesInput{})
 	for paginator.HasMorePages() {
@@ -49,12 +48,13 @@ func listDBInstances(ctx context.Context, client DBInstancesAPI, scope resource.
 		}
 
 		for _, db := range page.DBInstances {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: db.InstanceCreateTime,
 				Name: db.DBInstanceIdentifier,
 				Tags: util.ConvertRDSTypeTagsToMap(db.TagList),
-			}) {
-				names = append(names, db.DBInstanceIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(db.DBInstanceIdentifier, value).WithARN(db.DBInstanceArn))
 			}
 		}
 	}
@@ -24,22 +24,22 @@ type DBClustersAPI interface {
 func NewDBClusters() AwsResource {
 	return NewAwsResource(&resource.Resource[DBClustersAPI]{
 		ResourceTypeName: "rds-cluster",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"rds-global-cluster-membership", "rds-instance"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBClustersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBClusters.ResourceType
-		},
-		Lister: listDBClusters,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDBCluster, waitForDBClustersDeleted),
+		ConfigKey:      "DBClusters",
+		DetailedLister: listDBClusters,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteDBCluster, waitForDBClustersDeleted),
 	})
 }
 
 // listDBClusters retrieves all RDS DB Clusters that match the config filters.
-func listDBClusters(ctx context.Context, client DBClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listDBClusters(ctx context.Context, client DBClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := rds.NewDescribeDBClustersPaginator(client, &rds.DescribeDBClustersInput{})
 
 	for paginator.HasMorePages() {
@@ -49,12 +49,13 @@ func listDBClusters(ctx context.Context, client DBClustersAPI, scope resource.Sc
 		}
 
 		for _, cluster := range page.DBClusters {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cluster.DBClusterIdentifier,
 				Time: cluster.ClusterCreateTime,
 				Tags: util.ConvertRDSTypeTagsToMap(cluster.TagList),
-			}) {
-				names = append(names, cluster.DBClusterIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(cluster.DBClusterIdentifier, value).WithARN(cluster.DBClusterArn))
 			}
 		}
 	}
@@ -21,22 +21,21 @@ type RdsClusterSnapshotAPI interface {
 func NewRdsClusterSnapshot() AwsResource {
 	return NewAwsResource(&resource.Resource[RdsClusterSnapshotAPI]{
 		ResourceTypeName: "rds-cluster-snapshot",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RdsClusterSnapshotAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSClusterSnapshot
-		},
-		Lister: listRdsClusterSnapshots,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsClusterSnapshot),
+		ConfigKey:      "RDSClusterSnapshot",
+		DetailedLister: listRdsClusterSnapshots,
+		Nuker:          resource.SimpleBatchDeleter(deleteRdsClusterSnapshot),
 	})
 }
 
 // listRdsClusterSnapshots retrieves all RDS cluster snapshots that match the config filters.
-func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := rds.NewDescribeDBClusterSnapshotsPaginator(client, &rds.DescribeDBClusterSnapshotsInput{})
 
@@ -56,12 +55,13 @@ func listRdsClusterSnapshots(ctx context.Context, client RdsClusterSnapshotAPI,
 			if status != "available" && status != "failed" {
 				continue
 			}
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: s.DBClusterSnapshotIdentifier,
 				Time: s.SnapshotCreateTime,
 				Tags: util.ConvertRDSTypeTagsToMap(s.TagList),
-			}) {
-				identifiers = append(identifiers, s.DBClusterSnapshotIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(s.DBClusterSnapshotIdentifier, value).WithARN(s.DBClusterSnapshotArn))
 			}
 		}
 	}
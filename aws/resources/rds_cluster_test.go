@@ -108,7 +108,7 @@ func TestListDBClusters(t *testing.T) {
 
 			clusters, err := listDBClusters(context.Background(), mock, resource.Scope{}, tt.cfg)
 			require.NoError(t, err)
-			assert.Equal(t, tt.expected, aws.ToStringSlice(clusters))
+			assert.Equal(t, tt.expected, resource.IDs(clusters))
 		})
 	}
 }
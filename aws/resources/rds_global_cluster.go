@@ -27,22 +27,22 @@ type DBGlobalClustersAPI interface {
 func NewDBGlobalClusters() AwsResource {
 	return NewAwsResource(&resource.Resource[DBGlobalClustersAPI]{
 		ResourceTypeName: "rds-global-cluster",
+		Categories:       []string{resource.CategoryStorage},
+		DependsOn:        []string{"rds-global-cluster-membership"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBGlobalClustersAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBGlobalClusters
-		},
-		Lister: listDBGlobalClusters,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteDBGlobalCluster, waitForDBGlobalClustersDeleted),
+		ConfigKey:      "DBGlobalClusters",
+		DetailedLister: listDBGlobalClusters,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteDBGlobalCluster, waitForDBGlobalClustersDeleted),
 	})
 }
 
 // listDBGlobalClusters retrieves all RDS Global Clusters that match the config filters.
-func listDBGlobalClusters(ctx context.Context, client DBGlobalClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listDBGlobalClusters(ctx context.Context, client DBGlobalClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := rds.NewDescribeGlobalClustersPaginator(client, &rds.DescribeGlobalClustersInput{})
 
 	for paginator.HasMorePages() {
@@ -60,11 +60,12 @@ func listDBGlobalClusters(ctx context.Context, client DBGlobalClustersAPI, scope
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cluster.GlobalClusterIdentifier,
 				Tags: util.ConvertRDSTypeTagsToMap(tags.TagList),
-			}) {
-				names = append(names, cluster.GlobalClusterIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(cluster.GlobalClusterIdentifier, value).WithARN(cluster.GlobalClusterArn))
 			}
 		}
 	}
@@ -26,22 +26,21 @@ type DBGlobalClusterMembershipsAPI interface {
 func NewDBGlobalClusterMemberships() AwsResource {
 	return NewAwsResource(&resource.Resource[DBGlobalClusterMembershipsAPI]{
 		ResourceTypeName: "rds-global-cluster-membership",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBGlobalClusterMembershipsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBGlobalClusterMemberships
-		},
-		Lister: listDBGlobalClusterMemberships,
-		Nuker:  nukeDBGlobalClusterMemberships,
+		ConfigKey:      "DBGlobalClusterMemberships",
+		DetailedLister: listDBGlobalClusterMemberships,
+		Nuker:          nukeDBGlobalClusterMemberships,
 	})
 }
 
 // listDBGlobalClusterMemberships retrieves all RDS Global Clusters that have members.
-func listDBGlobalClusterMemberships(ctx context.Context, client DBGlobalClusterMembershipsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listDBGlobalClusterMemberships(ctx context.Context, client DBGlobalClusterMembershipsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := rds.NewDescribeGlobalClustersPaginator(client, &rds.DescribeGlobalClustersInput{})
 
 	for paginator.HasMorePages() {
@@ -59,11 +58,12 @@ func listDBGlobalClusterMemberships(ctx context.Context, client DBGlobalClusterM
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: cluster.GlobalClusterIdentifier,
 				Tags: util.ConvertRDSTypeTagsToMap(tags.TagList),
-			}) {
-				names = append(names, cluster.GlobalClusterIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(cluster.GlobalClusterIdentifier, value).WithARN(cluster.GlobalClusterArn))
 			}
 		}
 	}
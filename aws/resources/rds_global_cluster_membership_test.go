@@ -113,7 +113,7 @@ func TestListDBGlobalClusterMemberships(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listDBGlobalClusterMemberships(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
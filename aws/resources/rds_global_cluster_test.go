@@ -85,7 +85,7 @@ func TestListDBGlobalClusters(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listDBGlobalClusters(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -24,22 +24,22 @@ type RdsParameterGroupAPI interface {
 func NewRdsParameterGroup() AwsResource {
 	return NewAwsResource(&resource.Resource[RdsParameterGroupAPI]{
 		ResourceTypeName: "rds-parameter-group",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"rds-instance", "rds-cluster"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RdsParameterGroupAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSParameterGroup
-		},
-		Lister: listRdsParameterGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsParameterGroup),
+		ConfigKey:      "RDSParameterGroup",
+		DetailedLister: listRdsParameterGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteRdsParameterGroup),
 	})
 }
 
 // listRdsParameterGroups retrieves all RDS parameter groups that match the config filters.
-func listRdsParameterGroups(ctx context.Context, client RdsParameterGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listRdsParameterGroups(ctx context.Context, client RdsParameterGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	// Initialize the paginator
 	paginator := rds.NewDescribeDBParameterGroupsPaginator(client, &rds.DescribeDBParameterGroupsInput{})
@@ -68,11 +68,12 @@ func listRdsParameterGroups(ctx context.Context, client RdsParameterGroupAPI, sc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: parameterGroup.DBParameterGroupName,
 				Tags: util.ConvertRDSTypeTagsToMap(tags.TagList),
-			}) {
-				names = append(names, parameterGroup.DBParameterGroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(parameterGroup.DBParameterGroupName, value).WithARN(parameterGroup.DBParameterGroupArn))
 			}
 		}
 	}
@@ -105,7 +105,7 @@ func TestListRdsParameterGroups(t *testing.T) {
 
 			require.Equal(t, len(tc.expected), len(names))
 			for _, name := range names {
-				require.Contains(t, tc.expected, *name)
+				require.Contains(t, tc.expected, name.ID)
 			}
 		})
 	}
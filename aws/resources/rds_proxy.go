@@ -23,22 +23,21 @@ type RdsProxyAPI interface {
 func NewRdsProxy() AwsResource {
 	return NewAwsResource(&resource.Resource[RdsProxyAPI]{
 		ResourceTypeName: "rds-proxy",
+		Categories:       []string{resource.CategoryStorage, resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RdsProxyAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSProxy
-		},
-		Lister: listRdsProxies,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsProxy),
+		ConfigKey:      "RDSProxy",
+		DetailedLister: listRdsProxies,
+		Nuker:          resource.SimpleBatchDeleter(deleteRdsProxy),
 	})
 }
 
 // listRdsProxies retrieves all RDS Proxies that match the config filters.
-func listRdsProxies(ctx context.Context, client RdsProxyAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listRdsProxies(ctx context.Context, client RdsProxyAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := rds.NewDescribeDBProxiesPaginator(client, &rds.DescribeDBProxiesInput{})
 
 	for paginator.HasMorePages() {
@@ -56,12 +55,13 @@ func listRdsProxies(ctx context.Context, client RdsProxyAPI, scope resource.Scop
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: proxy.DBProxyName,
 				Time: proxy.CreatedDate,
 				Tags: util.ConvertRDSTypeTagsToMap(tags.TagList),
-			}) {
-				names = append(names, proxy.DBProxyName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(proxy.DBProxyName, value).WithARN(proxy.DBProxyArn))
 			}
 		}
 	}
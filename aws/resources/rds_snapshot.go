@@ -21,22 +21,21 @@ type RdsSnapshotAPI interface {
 func NewRdsSnapshot() AwsResource {
 	return NewAwsResource(&resource.Resource[RdsSnapshotAPI]{
 		ResourceTypeName: "rds-snapshot",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RdsSnapshotAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RDSSnapshot
-		},
-		Lister: listRdsSnapshots,
-		Nuker:  resource.SimpleBatchDeleter(deleteRdsSnapshot),
+		ConfigKey:      "RDSSnapshot",
+		DetailedLister: listRdsSnapshots,
+		Nuker:          resource.SimpleBatchDeleter(deleteRdsSnapshot),
 	})
 }
 
 // listRdsSnapshots retrieves all RDS snapshots that match the config filters.
-func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := rds.NewDescribeDBSnapshotsPaginator(client, &rds.DescribeDBSnapshotsInput{})
 
@@ -56,12 +55,13 @@ func listRdsSnapshots(ctx context.Context, client RdsSnapshotAPI, scope resource
 			if status != "available" && status != "failed" {
 				continue
 			}
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: s.DBSnapshotIdentifier,
 				Time: s.SnapshotCreateTime,
 				Tags: util.ConvertRDSTypeTagsToMap(s.TagList),
-			}) {
-				identifiers = append(identifiers, s.DBSnapshotIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(s.DBSnapshotIdentifier, value).WithARN(s.DBSnapshotArn))
 			}
 		}
 	}
@@ -83,7 +83,7 @@ func TestListRdsSnapshots(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -119,7 +119,7 @@ func TestListRdsSnapshots_SkipsAutomated(t *testing.T) {
 
 	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"manual-snap", "backup-snap"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"manual-snap", "backup-snap"}, resource.IDs(names))
 }
 
 func TestListRdsSnapshots_SkipsNonDeletableStates(t *testing.T) {
@@ -138,7 +138,7 @@ func TestListRdsSnapshots_SkipsNonDeletableStates(t *testing.T) {
 
 	names, err := listRdsSnapshots(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"available-snap", "failed-snap"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"available-snap", "failed-snap"}, resource.IDs(names))
 }
 
 func TestDeleteRdsSnapshot(t *testing.T) {
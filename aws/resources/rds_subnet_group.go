@@ -22,22 +22,22 @@ type DBSubnetGroupsAPI interface {
 func NewDBSubnetGroups() AwsResource {
 	return NewAwsResource(&resource.Resource[DBSubnetGroupsAPI]{
 		ResourceTypeName: "rds-subnet-group",
+		Categories:       []string{resource.CategoryStorage, resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"rds-instance", "rds-cluster"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[DBSubnetGroupsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = rds.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.DBSubnetGroups
-		},
-		Lister: listDBSubnetGroups,
-		Nuker:  resource.SimpleBatchDeleter(deleteDBSubnetGroup),
+		ConfigKey:      "DBSubnetGroups",
+		DetailedLister: listDBSubnetGroups,
+		Nuker:          resource.SimpleBatchDeleter(deleteDBSubnetGroup),
 	})
 }
 
 // listDBSubnetGroups retrieves all RDS DB Subnet Groups that match the config filters.
-func listDBSubnetGroups(ctx context.Context, client DBSubnetGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listDBSubnetGroups(ctx context.Context, client DBSubnetGroupsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 	paginator := rds.NewDescribeDBSubnetGroupsPaginator(client, &rds.DescribeDBSubnetGroupsInput{})
 
 	for paginator.HasMorePages() {
@@ -55,11 +55,12 @@ func listDBSubnetGroups(ctx context.Context, client DBSubnetGroupsAPI, scope res
 				return nil, errors.WithStackTrace(err)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: subnetGroup.DBSubnetGroupName,
 				Tags: util.ConvertRDSTypeTagsToMap(tags.TagList),
-			}) {
-				names = append(names, subnetGroup.DBSubnetGroupName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(subnetGroup.DBSubnetGroupName, value).WithARN(subnetGroup.DBSubnetGroupArn))
 			}
 		}
 	}
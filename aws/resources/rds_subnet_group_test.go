@@ -84,7 +84,7 @@ func TestListDBSubnetGroups(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listDBSubnetGroups(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
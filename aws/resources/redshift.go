@@ -22,22 +22,21 @@ type RedshiftClustersAPI interface {
 func NewRedshiftClusters() AwsResource {
 	return NewAwsResource(&resource.Resource[RedshiftClustersAPI]{
 		ResourceTypeName: "redshift",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RedshiftClustersAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = redshift.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Redshift
-		},
-		Lister: listRedshiftClusters,
-		Nuker:  resource.SequentialDeleter(resource.DeleteThenWait(deleteRedshiftCluster, waitForRedshiftClusterDeleted)),
+		ConfigKey:      "Redshift",
+		DetailedLister: listRedshiftClusters,
+		Nuker:          resource.SequentialDeleter(resource.DeleteThenWait(deleteRedshiftCluster, waitForRedshiftClusterDeleted)),
 	})
 }
 
 // listRedshiftClusters retrieves all Redshift clusters that match the config filters.
-func listRedshiftClusters(ctx context.Context, client RedshiftClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var clusterIds []*string
+func listRedshiftClusters(ctx context.Context, client RedshiftClustersAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var clusterIds []resource.ResourceDetails
 
 	paginator := redshift.NewDescribeClustersPaginator(client, &redshift.DescribeClustersInput{})
 	for paginator.HasMorePages() {
@@ -47,12 +46,13 @@ func listRedshiftClusters(ctx context.Context, client RedshiftClustersAPI, scope
 		}
 
 		for _, cluster := range page.Clusters {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: cluster.ClusterCreateTime,
 				Name: cluster.ClusterIdentifier,
 				Tags: util.ConvertRedshiftTagsToMap(cluster.Tags),
-			}) {
-				clusterIds = append(clusterIds, cluster.ClusterIdentifier)
+			}
+			if cfg.ShouldInclude(value) {
+				clusterIds = append(clusterIds, resource.NewResourceDetails(cluster.ClusterIdentifier, value))
 			}
 		}
 	}
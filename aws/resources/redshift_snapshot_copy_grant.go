@@ -20,22 +20,21 @@ type RedshiftSnapshotCopyGrantsAPI interface {
 func NewRedshiftSnapshotCopyGrants() AwsResource {
 	return NewAwsResource(&resource.Resource[RedshiftSnapshotCopyGrantsAPI]{
 		ResourceTypeName: "redshift-snapshot-copy-grant",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RedshiftSnapshotCopyGrantsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = redshift.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.RedshiftSnapshotCopyGrant
-		},
-		Lister: listRedshiftSnapshotCopyGrants,
-		Nuker:  resource.SimpleBatchDeleter(deleteRedshiftSnapshotCopyGrant),
+		ConfigKey:      "RedshiftSnapshotCopyGrant",
+		DetailedLister: listRedshiftSnapshotCopyGrants,
+		Nuker:          resource.SimpleBatchDeleter(deleteRedshiftSnapshotCopyGrant),
 	})
 }
 
 // listRedshiftSnapshotCopyGrants retrieves all Redshift Snapshot Copy Grants that match the config filters.
-func listRedshiftSnapshotCopyGrants(ctx context.Context, client RedshiftSnapshotCopyGrantsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRedshiftSnapshotCopyGrants(ctx context.Context, client RedshiftSnapshotCopyGrantsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := redshift.NewDescribeSnapshotCopyGrantsPaginator(client, &redshift.DescribeSnapshotCopyGrantsInput{})
 
@@ -46,11 +45,12 @@ func listRedshiftSnapshotCopyGrants(ctx context.Context, client RedshiftSnapshot
 		}
 
 		for _, grant := range page.SnapshotCopyGrants {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: grant.SnapshotCopyGrantName,
 				Tags: util.ConvertRedshiftTagsToMap(grant.Tags),
-			}) {
-				identifiers = append(identifiers, grant.SnapshotCopyGrantName)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(grant.SnapshotCopyGrantName, value))
 			}
 		}
 	}
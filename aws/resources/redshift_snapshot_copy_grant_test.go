@@ -63,7 +63,7 @@ func TestListRedshiftSnapshotCopyGrants(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listRedshiftSnapshotCopyGrants(context.Background(), mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -20,22 +20,21 @@ type RAMResourceShareAPI interface {
 func NewResourceShares() AwsResource {
 	return NewAwsResource(&resource.Resource[RAMResourceShareAPI]{
 		ResourceTypeName: "resource-share",
+		Categories:       []string{resource.CategoryIAM},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[RAMResourceShareAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ram.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ResourceShare
-		},
-		Lister: listResourceShares,
-		Nuker:  resource.SimpleBatchDeleter(deleteResourceShare),
+		ConfigKey:      "ResourceShare",
+		DetailedLister: listResourceShares,
+		Nuker:          resource.SimpleBatchDeleter(deleteResourceShare),
 	})
 }
 
 // listResourceShares retrieves all RAM Resource Shares that match the config filters.
-func listResourceShares(ctx context.Context, client RAMResourceShareAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listResourceShares(ctx context.Context, client RAMResourceShareAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 	paginator := ram.NewGetResourceSharesPaginator(client, &ram.GetResourceSharesInput{ResourceOwner: types.ResourceOwnerSelf})
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -47,12 +46,13 @@ func listResourceShares(ctx context.Context, client RAMResourceShareAPI, _ resou
 			if resourceShare.Status != types.ResourceShareStatusActive {
 				continue
 			}
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: resourceShare.Name,
 				Time: resourceShare.CreationTime,
 				Tags: convertRAMTagsToMap(resourceShare.Tags),
-			}) {
-				identifiers = append(identifiers, resourceShare.ResourceShareArn)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(resourceShare.ResourceShareArn, value).WithARN(resourceShare.ResourceShareArn))
 			}
 		}
 	}
@@ -131,7 +131,7 @@ func TestResourceShareList(t *testing.T) {
 
 			ids, err := listResourceShares(context.Background(), mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -158,7 +158,7 @@ func TestResourceShareListPagination(t *testing.T) {
 
 	ids, err := listResourceShares(context.Background(), mock, resource.Scope{Region: "us-east-1"}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"arn:1", "arn:2"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{"arn:1", "arn:2"}, resource.IDs(ids))
 }
 
 func TestDeleteResourceShare(t *testing.T) {
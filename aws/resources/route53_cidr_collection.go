@@ -24,22 +24,23 @@ type Route53CidrCollectionAPI interface {
 func NewRoute53CidrCollections() AwsResource {
 	return NewAwsResource(&resource.Resource[Route53CidrCollectionAPI]{
 		ResourceTypeName: "route53-cidr-collection",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"route53-hosted-zone"},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[Route53CidrCollectionAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = route53.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Route53CIDRCollection
-		},
-		Lister: listRoute53CidrCollections,
-		Nuker:  resource.MultiStepDeleter(nukeCidrBlocks, deleteRoute53CidrCollection),
+		ConfigKey:      "Route53CIDRCollection",
+		DetailedLister: listRoute53CidrCollections,
+		Nuker:          resource.MultiStepDeleter(nukeCidrBlocks, deleteRoute53CidrCollection),
 	})
 }
 
 // listRoute53CidrCollections retrieves all CIDR collections that match the config filters.
-func listRoute53CidrCollections(ctx context.Context, client Route53CidrCollectionAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRoute53CidrCollections(ctx context.Context, client Route53CidrCollectionAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := route53.NewListCidrCollectionsPaginator(client, &route53.ListCidrCollectionsInput{})
 	for paginator.HasMorePages() {
@@ -49,10 +50,11 @@ func listRoute53CidrCollections(ctx context.Context, client Route53CidrCollectio
 		}
 
 		for _, collection := range page.CidrCollections {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: collection.Name,
-			}) {
-				identifiers = append(identifiers, collection.Id)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(collection.Id, value).WithARN(collection.Arn))
 			}
 		}
 	}
@@ -85,7 +85,7 @@ func TestListRoute53CidrCollections(t *testing.T) {
 
 			ids, err := listRoute53CidrCollections(context.Background(), client, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			assert.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			assert.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
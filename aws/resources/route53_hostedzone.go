@@ -28,24 +28,23 @@ type Route53HostedZoneAPI interface {
 func NewRoute53HostedZone() AwsResource {
 	return NewAwsResource(&resource.Resource[Route53HostedZoneAPI]{
 		ResourceTypeName: "route53-hosted-zone",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[Route53HostedZoneAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = route53.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Route53HostedZone
-		},
-		Lister: listRoute53HostedZones,
-		Nuker:  resource.SequentialDeleter(deleteRoute53HostedZone),
+		ConfigKey:      "Route53HostedZone",
+		DetailedLister: listRoute53HostedZones,
+		Nuker:          resource.SequentialDeleter(deleteRoute53HostedZone),
 	})
 }
 
 // listRoute53HostedZones retrieves all Route53 hosted zones that match the config filters.
 // Returns identifiers in format "zoneId|domainName" since deletion needs the domain name
 // to identify which NS/SOA records to skip.
-func listRoute53HostedZones(ctx context.Context, client Route53HostedZoneAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRoute53HostedZones(ctx context.Context, client Route53HostedZoneAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := route53.NewListHostedZonesPaginator(client, &route53.ListHostedZonesInput{})
 	for paginator.HasMorePages() {
@@ -88,13 +87,14 @@ func listRoute53HostedZones(ctx context.Context, client Route53HostedZoneAPI, sc
 			zoneId := strings.TrimPrefix(aws.ToString(zone.Id), "/hostedzone/")
 			tags := util.ConvertRoute53TagsToMap(tagsByZoneId[zoneId])
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: zone.Name,
 				Tags: tags,
-			}) {
+			}
+			if cfg.ShouldInclude(value) {
 				// Encode both zone ID and domain name in the identifier
 				identifier := fmt.Sprintf("%s|%s", aws.ToString(zone.Id), aws.ToString(zone.Name))
-				identifiers = append(identifiers, aws.String(identifier))
+				identifiers = append(identifiers, resource.NewResourceDetails(aws.String(identifier), value))
 			}
 		}
 	}
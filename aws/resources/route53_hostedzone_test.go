@@ -157,7 +157,7 @@ func TestRoute53HostedZone_List(t *testing.T) {
 
 			ids, err := listRoute53HostedZones(context.Background(), client, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			assert.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			assert.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -188,7 +188,7 @@ func TestRoute53HostedZone_ListBatching(t *testing.T) {
 
 	ids, err := listRoute53HostedZones(context.Background(), client, resource.Scope{Region: "global"}, config.ResourceType{})
 	require.NoError(t, err)
-	assert.Equal(t, expected, aws.ToStringSlice(ids))
+	assert.Equal(t, expected, resource.IDs(ids))
 
 	// Also verify tag-based filtering works across batch boundaries (zone11 is in batch 2)
 	client2 := mockedRoute53HostedZone{
@@ -201,7 +201,7 @@ func TestRoute53HostedZone_ListBatching(t *testing.T) {
 		},
 	})
 	require.NoError(t, err)
-	assert.Equal(t, []string{"/hostedzone/zone11|test11.example.com."}, aws.ToStringSlice(ids2))
+	assert.Equal(t, []string{"/hostedzone/zone11|test11.example.com."}, resource.IDs(ids2))
 }
 
 func TestRoute53HostedZone_Delete(t *testing.T) {
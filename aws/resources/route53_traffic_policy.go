@@ -22,23 +22,23 @@ type Route53TrafficPolicyAPI interface {
 func NewRoute53TrafficPolicies() AwsResource {
 	return NewAwsResource(&resource.Resource[Route53TrafficPolicyAPI]{
 		ResourceTypeName: "route53-traffic-policy",
+		Categories:       []string{resource.CategoryNetwork},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[Route53TrafficPolicyAPI], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = route53.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Route53TrafficPolicy
-		},
-		Lister: listRoute53TrafficPolicies,
-		Nuker:  resource.SequentialDeleter(deleteRoute53TrafficPolicy),
+		ConfigKey:      "Route53TrafficPolicy",
+		DetailedLister: listRoute53TrafficPolicies,
+		Nuker:          resource.SequentialDeleter(deleteRoute53TrafficPolicy),
 	})
 }
 
 // listRoute53TrafficPolicies retrieves all Route53 Traffic Policies that match the config filters.
 // Returns identifiers in format "id:version" since DeleteTrafficPolicy requires both.
-func listRoute53TrafficPolicies(ctx context.Context, client Route53TrafficPolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listRoute53TrafficPolicies(ctx context.Context, client Route53TrafficPolicyAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	// ListTrafficPolicies doesn't have a paginator in the SDK, so we handle pagination manually
 	var trafficPolicyIdMarker *string
@@ -51,12 +51,13 @@ func listRoute53TrafficPolicies(ctx context.Context, client Route53TrafficPolicy
 		}
 
 		for _, summary := range result.TrafficPolicySummaries {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: summary.Name,
-			}) {
+			}
+			if cfg.ShouldInclude(value) {
 				// Encode both ID and version in the identifier since DeleteTrafficPolicy requires both
 				identifier := fmt.Sprintf("%s:%d", aws.ToString(summary.Id), aws.ToInt32(summary.LatestVersion))
-				identifiers = append(identifiers, aws.String(identifier))
+				identifiers = append(identifiers, resource.NewResourceDetails(aws.String(identifier), value))
 			}
 		}
 
@@ -85,7 +85,7 @@ func TestListRoute53TrafficPolicies(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listRoute53TrafficPolicies(context.Background(), mock, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -116,7 +116,7 @@ func TestListRoute53TrafficPolicies_Pagination(t *testing.T) {
 
 	ids, err := listRoute53TrafficPolicies(context.Background(), mock, resource.Scope{Region: "global"}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{testId1 + ":1", testId2 + ":3"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{testId1 + ":1", testId2 + ":3"}, resource.IDs(ids))
 }
 
 func TestDeleteRoute53TrafficPolicy(t *testing.T) {
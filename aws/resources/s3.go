@@ -20,7 +20,10 @@ import (
 
 const (
 	// AwsResourceExclusionTagKey is the tag key used to exclude resources from deletion.
-	AwsResourceExclusionTagKey = "cloud-nuke-excluded"
+	//
+	// Deprecated: S3 buckets are protected like every resource type, by config.DefaultAwsResourceExclusionTagKey and
+	// the protection tags of the config, see config.ProtectionTag.
+	AwsResourceExclusionTagKey = config.DefaultAwsResourceExclusionTagKey
 
 	// s3BucketDeletionRetries is the maximum number of retries for waiting on bucket deletion.
 	s3BucketDeletionRetries = 3
@@ -54,25 +57,34 @@ type s3BucketInfo struct {
 	InvalidReason string
 }
 
+// value returns the value the config filters the bucket on.
+func (info *s3BucketInfo) value() config.ResourceValue {
+	return config.ResourceValue{
+		Time: &info.CreationDate,
+		Name: &info.Name,
+		Tags: info.Tags,
+	}
+}
+
 // NewS3Buckets creates a new S3Buckets resource using the generic resource pattern.
 func NewS3Buckets() AwsResource {
 	return NewAwsResource(&resource.Resource[S3API]{
 		ResourceTypeName: "s3",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        500,
+		DependsOn:        []string{"s3-multi-region-access-point"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3API], cfg aws.Config) {
 			r.Scope.Region = "global"
 			r.Client = s3.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.S3
-		},
-		Lister: listS3Buckets,
-		Nuker:  resource.MultiStepDeleter(emptyBucket, deleteBucketPolicy, deleteBucketLifecycle, deleteBucketWithWait),
+		ConfigKey:      "S3",
+		DetailedLister: listS3Buckets,
+		Nuker:          resource.MultiStepDeleter(emptyBucket, deleteBucketPolicy, deleteBucketLifecycle, deleteBucketWithWait),
 	})
 }
 
 // listS3Buckets retrieves all S3 buckets that match the config filters.
-func listS3Buckets(ctx context.Context, client S3API, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listS3Buckets(ctx context.Context, client S3API, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.ListBuckets(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
@@ -84,7 +96,7 @@ func listS3Buckets(ctx context.Context, client S3API, scope resource.Scope, cfg
 
 	// Process buckets concurrently in batches
 	const batchSize = 100
-	var allNames []*string
+	var allBuckets []resource.ResourceDetails
 
 	for batchStart := 0; batchStart < len(output.Buckets); batchStart += batchSize {
 		batchEnd := batchStart + batchSize
@@ -93,16 +105,15 @@ func listS3Buckets(ctx context.Context, client S3API, scope resource.Scope, cfg
 		}
 
 		targetBuckets := output.Buckets[batchStart:batchEnd]
-		names := getBucketNamesForBatch(ctx, client, scope, targetBuckets, cfg)
-		allNames = append(allNames, names...)
+		allBuckets = append(allBuckets, getBucketDetailsForBatch(ctx, client, scope, targetBuckets, cfg)...)
 	}
 
-	return allNames, nil
+	return allBuckets, nil
 }
 
-// getBucketNamesForBatch processes a batch of buckets concurrently and returns valid bucket names.
-func getBucketNamesForBatch(ctx context.Context, client S3API, scope resource.Scope, buckets []types.Bucket, cfg config.ResourceType) []*string {
-	var names []*string
+// getBucketDetailsForBatch processes a batch of buckets concurrently and returns the details of valid buckets.
+func getBucketDetailsForBatch(ctx context.Context, client S3API, scope resource.Scope, buckets []types.Bucket, cfg config.ResourceType) []resource.ResourceDetails {
+	var details []resource.ResourceDetails
 	resultCh := make(chan *s3BucketInfo, len(buckets))
 	var wg sync.WaitGroup
 
@@ -129,10 +140,10 @@ func getBucketNamesForBatch(ctx context.Context, client S3API, scope resource.Sc
 			logging.Debugf("Skipping bucket %s: %s", info.Name, info.InvalidReason)
 			continue
 		}
-		names = append(names, aws.String(info.Name))
+		details = append(details, resource.NewResourceDetails(aws.String(info.Name), info.value()))
 	}
 
-	return names
+	return details
 }
 
 // getBucketInfo retrieves information about a single bucket.
@@ -165,11 +176,7 @@ func getBucketInfo(ctx context.Context, client S3API, scope resource.Scope, buck
 	info.Tags = tags
 
 	// Apply config filters
-	if !cfg.ShouldInclude(config.ResourceValue{
-		Time: &info.CreationDate,
-		Name: &info.Name,
-		Tags: tags,
-	}) {
+	if !cfg.ShouldInclude(info.value()) {
 		info.InvalidReason = "filtered by config"
 		return info
 	}
@@ -23,29 +23,30 @@ type S3ControlAccessPointAPI interface {
 func NewS3AccessPoints() AwsResource {
 	return NewAwsResource(&resource.Resource[S3ControlAccessPointAPI]{
 		ResourceTypeName: "s3-access-point",
+		Categories:       []string{resource.CategoryStorage},
+		DependsOn:        []string{"s3-object-lambda-access-point"},
+		NoTags:           true,
 		// S3 Control API has tight rate limits; keep batch size low to avoid throttling.
 		BatchSize: 5,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3ControlAccessPointAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = s3control.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.S3AccessPoint
-		},
-		Lister: listS3AccessPoints,
-		Nuker:  nukeS3AccessPoints,
+		ConfigKey:      "S3AccessPoint",
+		DetailedLister: listS3AccessPoints,
+		Nuker:          nukeS3AccessPoints,
 	})
 }
 
 // listS3AccessPoints retrieves all S3 Access Points that match the config filters.
-func listS3AccessPoints(ctx context.Context, client S3ControlAccessPointAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listS3AccessPoints(ctx context.Context, client S3ControlAccessPointAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	accountID, ok := ctx.Value(util.AccountIdKey).(string)
 	if !ok {
 		logging.Errorf("unable to read the account-id from context")
 		return nil, errors.WithStackTrace(fmt.Errorf("unable to lookup the account id"))
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	paginator := s3control.NewListAccessPointsPaginator(client, &s3control.ListAccessPointsInput{
 		AccountId: aws.String(accountID),
 	})
@@ -57,10 +58,11 @@ func listS3AccessPoints(ctx context.Context, client S3ControlAccessPointAPI, sco
 		}
 
 		for _, accessPoint := range page.AccessPointList {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: accessPoint.Name,
-			}) {
-				identifiers = append(identifiers, accessPoint.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(accessPoint.Name, value).WithARN(accessPoint.AccessPointArn))
 			}
 		}
 	}
@@ -69,7 +69,7 @@ func TestS3AccessPoint_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listS3AccessPoints(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
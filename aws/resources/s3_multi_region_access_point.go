@@ -24,6 +24,8 @@ type S3ControlMultiRegionAPI interface {
 func NewS3MultiRegionAccessPoints() AwsResource {
 	return NewAwsResource(&resource.Resource[S3ControlMultiRegionAPI]{
 		ResourceTypeName: "s3-multi-region-access-point",
+		Categories:       []string{resource.CategoryStorage},
+		NoTags:           true,
 		// S3 Control API has tight rate limits; keep batch size low to avoid throttling.
 		BatchSize: 5,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3ControlMultiRegionAPI], cfg aws.Config) {
@@ -31,11 +33,9 @@ func NewS3MultiRegionAccessPoints() AwsResource {
 			cfg.Region = "us-west-2" // MRAP control-plane requests must be routed to us-west-2
 			r.Client = s3control.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.S3MultiRegionAccessPoint
-		},
-		Lister: listS3MultiRegionAccessPoints,
-		Nuker:  nukeS3MultiRegionAccessPoints,
+		ConfigKey:      "S3MultiRegionAccessPoint",
+		DetailedLister: listS3MultiRegionAccessPoints,
+		Nuker:          nukeS3MultiRegionAccessPoints,
 	})
 }
 
@@ -43,14 +43,14 @@ func NewS3MultiRegionAccessPoints() AwsResource {
 //
 // NOTE: All control plane requests to create or maintain Multi-Region Access Points must be routed to US West (Oregon) Region.
 // Reference: https://docs.aws.amazon.com/AmazonS3/latest/userguide/MultiRegionAccessPointRestrictions.html
-func listS3MultiRegionAccessPoints(ctx context.Context, client S3ControlMultiRegionAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listS3MultiRegionAccessPoints(ctx context.Context, client S3ControlMultiRegionAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	accountID, ok := ctx.Value(util.AccountIdKey).(string)
 	if !ok {
 		logging.Errorf("unable to read the account-id from context")
 		return nil, errors.WithStackTrace(fmt.Errorf("unable to lookup the account id"))
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	paginator := s3control.NewListMultiRegionAccessPointsPaginator(client, &s3control.ListMultiRegionAccessPointsInput{
 		AccountId: aws.String(accountID),
 	})
@@ -62,11 +62,12 @@ func listS3MultiRegionAccessPoints(ctx context.Context, client S3ControlMultiReg
 		}
 
 		for _, accessPoint := range page.AccessPoints {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: accessPoint.Name,
 				Time: accessPoint.CreatedAt,
-			}) {
-				identifiers = append(identifiers, accessPoint.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(accessPoint.Name, value))
 			}
 		}
 	}
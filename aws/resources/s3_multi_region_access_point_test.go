@@ -80,7 +80,7 @@ func TestS3MultiRegionAccessPoint_List(t *testing.T) {
 
 			names, err := listS3MultiRegionAccessPoints(ctx, client, resource.Scope{Region: "global"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -23,29 +23,29 @@ type S3ObjectLambdaAccessPointAPI interface {
 func NewS3ObjectLambdaAccessPoints() AwsResource {
 	return NewAwsResource(&resource.Resource[S3ObjectLambdaAccessPointAPI]{
 		ResourceTypeName: "s3-object-lambda-access-point",
+		Categories:       []string{resource.CategoryStorage},
+		NoTags:           true,
 		// S3 Control API has tight rate limits; keep batch size low to avoid throttling.
 		BatchSize: 5,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[S3ObjectLambdaAccessPointAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = s3control.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.S3ObjectLambdaAccessPoint
-		},
-		Lister: listS3ObjectLambdaAccessPoints,
-		Nuker:  nukeS3ObjectLambdaAccessPoints,
+		ConfigKey:      "S3ObjectLambdaAccessPoint",
+		DetailedLister: listS3ObjectLambdaAccessPoints,
+		Nuker:          nukeS3ObjectLambdaAccessPoints,
 	})
 }
 
 // listS3ObjectLambdaAccessPoints retrieves all Object Lambda Access Points that match the config filters.
-func listS3ObjectLambdaAccessPoints(ctx context.Context, client S3ObjectLambdaAccessPointAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listS3ObjectLambdaAccessPoints(ctx context.Context, client S3ObjectLambdaAccessPointAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	accountID, ok := ctx.Value(util.AccountIdKey).(string)
 	if !ok {
 		logging.Errorf("unable to read the account-id from context")
 		return nil, errors.WithStackTrace(fmt.Errorf("unable to lookup the account id"))
 	}
 
-	var identifiers []*string
+	var identifiers []resource.ResourceDetails
 	paginator := s3control.NewListAccessPointsForObjectLambdaPaginator(client, &s3control.ListAccessPointsForObjectLambdaInput{
 		AccountId: aws.String(accountID),
 	})
@@ -57,10 +57,11 @@ func listS3ObjectLambdaAccessPoints(ctx context.Context, client S3ObjectLambdaAc
 		}
 
 		for _, accessPoint := range page.ObjectLambdaAccessPointList {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: accessPoint.Name,
-			}) {
-				identifiers = append(identifiers, accessPoint.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				identifiers = append(identifiers, resource.NewResourceDetails(accessPoint.Name, value).WithARN(accessPoint.ObjectLambdaAccessPointArn))
 			}
 		}
 	}
@@ -66,7 +66,7 @@ func TestS3ObjectLambdaAccessPoint_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listS3ObjectLambdaAccessPoints(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -126,7 +126,46 @@ func TestS3Buckets_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listS3Buckets(context.Background(), mockClient, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.ElementsMatch(t, tc.expected, aws.ToStringSlice(names))
+			require.ElementsMatch(t, tc.expected, resource.IDs(names))
+		})
+	}
+}
+
+func TestS3Buckets_ListProtectionTags(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	mockClient := func(tags ...types.Tag) mockedS3Buckets {
+		return mockedS3Buckets{
+			ListBucketsOutput: s3.ListBucketsOutput{
+				Buckets: []types.Bucket{{Name: aws.String("test-bucket"), CreationDate: aws.Time(now)}},
+			},
+			GetBucketLocationOutput: s3.GetBucketLocationOutput{LocationConstraint: "us-east-1"},
+			GetBucketTaggingOutput:  s3.GetBucketTaggingOutput{TagSet: tags},
+		}
+	}
+
+	var configObj config.Config
+	configObj.AddProtectionTags([]config.ProtectionTag{
+		{Key: "do-not-delete"},
+		{Key: "lifecycle", Value: &config.Expression{RE: *regexp.MustCompile("^permanent$")}},
+	})
+
+	tests := map[string]struct {
+		client   mockedS3Buckets
+		expected []string
+	}{
+		"defaultTag":    {mockClient(types.Tag{Key: aws.String("cloud-nuke-excluded"), Value: aws.String("true")}), nil},
+		"keyOnly":       {mockClient(types.Tag{Key: aws.String("do-not-delete"), Value: aws.String("")}), nil},
+		"keyAndValue":   {mockClient(types.Tag{Key: aws.String("lifecycle"), Value: aws.String("Permanent")}), nil},
+		"valueMismatch": {mockClient(types.Tag{Key: aws.String("lifecycle"), Value: aws.String("temporary")}), []string{"test-bucket"}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			names, err := listS3Buckets(context.Background(), tc.client, resource.Scope{Region: "us-east-1"}, configObj.S3)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -24,22 +24,21 @@ type SageMakerEndpointAPI interface {
 func NewSageMakerEndpoint() AwsResource {
 	return NewAwsResource(&resource.Resource[SageMakerEndpointAPI]{
 		ResourceTypeName: "sagemaker-endpoint",
+		Categories:       []string{resource.CategoryML},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SageMakerEndpointAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = sagemaker.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SageMakerEndpoint
-		},
-		Lister: listSageMakerEndpoints,
-		Nuker:  resource.SimpleBatchDeleter(deleteSageMakerEndpoint),
+		ConfigKey:      "SageMakerEndpoint",
+		DetailedLister: listSageMakerEndpoints,
+		Nuker:          resource.SimpleBatchDeleter(deleteSageMakerEndpoint),
 	})
 }
 
 // listSageMakerEndpoints retrieves all SageMaker Endpoints that match the config filters.
-func listSageMakerEndpoints(ctx context.Context, client SageMakerEndpointAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var endpointNames []*string
+func listSageMakerEndpoints(ctx context.Context, client SageMakerEndpointAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var endpointNames []resource.ResourceDetails
 	paginator := sagemaker.NewListEndpointsPaginator(client, &sagemaker.ListEndpointsInput{})
 
 	// Get account ID from context
@@ -81,12 +80,13 @@ func listSageMakerEndpoints(ctx context.Context, client SageMakerEndpointAPI, sc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: endpoint.EndpointName,
 				Time: endpoint.CreationTime,
 				Tags: tagMap,
-			}) {
-				endpointNames = append(endpointNames, endpoint.EndpointName)
+			}
+			if cfg.ShouldInclude(value) {
+				endpointNames = append(endpointNames, resource.NewResourceDetails(endpoint.EndpointName, value).WithARN(endpoint.EndpointArn))
 			}
 		}
 	}
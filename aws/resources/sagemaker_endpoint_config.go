@@ -23,28 +23,28 @@ type SageMakerEndpointConfigAPI interface {
 func NewSageMakerEndpointConfig() AwsResource {
 	return NewAwsResource(&resource.Resource[SageMakerEndpointConfigAPI]{
 		ResourceTypeName: "sagemaker-endpoint-config",
+		Categories:       []string{resource.CategoryML},
 		BatchSize:        10,
+		DependsOn:        []string{"sagemaker-endpoint"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SageMakerEndpointConfigAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = sagemaker.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SageMakerEndpointConfig
-		},
-		Lister: listSageMakerEndpointConfigs,
-		Nuker:  resource.SimpleBatchDeleter(deleteSageMakerEndpointConfig),
+		ConfigKey:      "SageMakerEndpointConfig",
+		DetailedLister: listSageMakerEndpointConfigs,
+		Nuker:          resource.SimpleBatchDeleter(deleteSageMakerEndpointConfig),
 	})
 }
 
 // listSageMakerEndpointConfigs retrieves all SageMaker Endpoint Configurations that match the config filters.
-func listSageMakerEndpointConfigs(ctx context.Context, client SageMakerEndpointConfigAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listSageMakerEndpointConfigs(ctx context.Context, client SageMakerEndpointConfigAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Get account ID from context (needed for constructing ARN for tags)
 	accountID, ok := ctx.Value(util.AccountIdKey).(string)
 	if !ok {
 		return nil, fmt.Errorf("unable to get account ID from context")
 	}
 
-	var endpointConfigNames []*string
+	var endpointConfigNames []resource.ResourceDetails
 	paginator := sagemaker.NewListEndpointConfigsPaginator(client, &sagemaker.ListEndpointConfigsInput{})
 
 	for paginator.HasMorePages() {
@@ -73,12 +73,13 @@ func listSageMakerEndpointConfigs(ctx context.Context, client SageMakerEndpointC
 
 			tagMap := util.ConvertSageMakerTagsToMap(tagsOutput.Tags)
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: endpointConfig.EndpointConfigName,
 				Time: endpointConfig.CreationTime,
 				Tags: tagMap,
-			}) {
-				endpointConfigNames = append(endpointConfigNames, endpointConfig.EndpointConfigName)
+			}
+			if cfg.ShouldInclude(value) {
+				endpointConfigNames = append(endpointConfigNames, resource.NewResourceDetails(endpointConfig.EndpointConfigName, value).WithARN(endpointConfig.EndpointConfigArn))
 			}
 		}
 	}
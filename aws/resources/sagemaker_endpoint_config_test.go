@@ -95,7 +95,7 @@ func TestListSageMakerEndpointConfigs(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.AccountIdKey, "123456789012")
 			names, err := listSageMakerEndpointConfigs(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
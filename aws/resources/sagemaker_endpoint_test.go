@@ -103,7 +103,7 @@ func TestListSageMakerEndpoints(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.AccountIdKey, "test-account-id")
 			names, err := listSageMakerEndpoints(ctx, mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -33,15 +33,14 @@ type SageMakerNotebookInstancesAPI interface {
 func NewSageMakerNotebookInstances() AwsResource {
 	return NewAwsResource(&resource.Resource[SageMakerNotebookInstancesAPI]{
 		ResourceTypeName: "sagemaker-notebook-instance",
+		Categories:       []string{resource.CategoryML},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SageMakerNotebookInstancesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = sagemaker.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SageMakerNotebook
-		},
-		Lister: listSageMakerNotebookInstances,
+		ConfigKey:      "SageMakerNotebook",
+		DetailedLister: listSageMakerNotebookInstances,
 		Nuker: resource.MultiStepDeleter(
 			stopNotebookInstance,
 			waitNotebookInstanceStopped,
@@ -52,8 +51,8 @@ func NewSageMakerNotebookInstances() AwsResource {
 }
 
 // listSageMakerNotebookInstances retrieves all SageMaker notebook instances that match the config filters.
-func listSageMakerNotebookInstances(ctx context.Context, client SageMakerNotebookInstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listSageMakerNotebookInstances(ctx context.Context, client SageMakerNotebookInstancesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := sagemaker.NewListNotebookInstancesPaginator(client, &sagemaker.ListNotebookInstancesInput{})
 	for paginator.HasMorePages() {
@@ -75,12 +74,13 @@ func listSageMakerNotebookInstances(ctx context.Context, client SageMakerNoteboo
 				tags = util.ConvertSageMakerTagsToMap(tagsOutput.Tags)
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: notebook.NotebookInstanceName,
 				Time: notebook.CreationTime,
 				Tags: tags,
-			}) {
-				names = append(names, notebook.NotebookInstanceName)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(notebook.NotebookInstanceName, value).WithARN(notebook.NotebookInstanceArn))
 			}
 		}
 	}
@@ -100,7 +100,7 @@ func TestListSageMakerNotebookInstances(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listSageMakerNotebookInstances(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
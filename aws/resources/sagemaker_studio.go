@@ -54,22 +54,21 @@ type SageMakerStudioAPI interface {
 func NewSageMakerStudio() AwsResource {
 	return NewAwsResource(&resource.Resource[SageMakerStudioAPI]{
 		ResourceTypeName: "sagemaker-studio",
+		Categories:       []string{resource.CategoryML},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SageMakerStudioAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = sagemaker.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SageMakerStudioDomain
-		},
-		Lister: listSageMakerDomains,
-		Nuker:  nukeSageMakerDomains,
+		ConfigKey:      "SageMakerStudioDomain",
+		DetailedLister: listSageMakerDomains,
+		Nuker:          nukeSageMakerDomains,
 	})
 }
 
 // listSageMakerDomains retrieves all SageMaker Studio domains that match the config filters.
-func listSageMakerDomains(ctx context.Context, client SageMakerStudioAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var domainIDs []*string
+func listSageMakerDomains(ctx context.Context, client SageMakerStudioAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var domainIDs []resource.ResourceDetails
 
 	paginator := sagemaker.NewListDomainsPaginator(client, &sagemaker.ListDomainsInput{})
 	for paginator.HasMorePages() {
@@ -95,17 +94,18 @@ func listSageMakerDomains(ctx context.Context, client SageMakerStudioAPI, scope
 				tagMap = util.ConvertSageMakerTagsToMap(tagsOutput.Tags)
 			}
 
-			if !cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: domain.DomainName,
 				Time: domain.CreationTime,
 				Tags: tagMap,
-			}) {
+			}
+			if !cfg.ShouldInclude(value) {
 				logging.Debugf("Skipping SageMaker Studio domain %s (filtered by config)", *domain.DomainId)
 				continue
 			}
 
 			logging.Debugf("Found SageMaker Studio domain: %s (Status: %s)", *domain.DomainId, domain.Status)
-			domainIDs = append(domainIDs, domain.DomainId)
+			domainIDs = append(domainIDs, resource.NewResourceDetails(domain.DomainId, value).WithARN(domain.DomainArn))
 		}
 	}
 
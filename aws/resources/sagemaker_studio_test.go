@@ -329,7 +329,7 @@ func TestListSageMakerDomains(t *testing.T) {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
-				require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+				require.Equal(t, tc.expected, resource.IDs(ids))
 			}
 		})
 	}
@@ -25,6 +25,7 @@ type SecretsManagerAPI interface {
 func NewSecretsManagerSecrets() AwsResource {
 	return NewAwsResource(&resource.Resource[SecretsManagerAPI]{
 		ResourceTypeName: "secrets-manager",
+		Categories:       []string{resource.CategoryIAM},
 		// Tentative batch size to ensure AWS doesn't throttle. Note that secrets manager does not support bulk delete,
 		// so we will be deleting this many in parallel using go routines. We conservatively pick 10 here, both to limit
 		// overloading the runtime and to avoid AWS throttling with many API calls.
@@ -33,17 +34,15 @@ func NewSecretsManagerSecrets() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = secretsmanager.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SecretsManager
-		},
-		Lister: listSecretsManagerSecrets,
-		Nuker:  resource.SimpleBatchDeleter(deleteSecretsManagerSecret),
+		ConfigKey:      "SecretsManager",
+		DetailedLister: listSecretsManagerSecrets,
+		Nuker:          resource.SimpleBatchDeleter(deleteSecretsManagerSecret),
 	})
 }
 
 // listSecretsManagerSecrets retrieves all Secrets Manager secrets that match the config filters.
-func listSecretsManagerSecrets(ctx context.Context, client SecretsManagerAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allSecrets []*string
+func listSecretsManagerSecrets(ctx context.Context, client SecretsManagerAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allSecrets []resource.ResourceDetails
 	paginator := secretsmanager.NewListSecretsPaginator(client, &secretsmanager.ListSecretsInput{})
 
 	for paginator.HasMorePages() {
@@ -53,8 +52,8 @@ func listSecretsManagerSecrets(ctx context.Context, client SecretsManagerAPI, sc
 		}
 
 		for _, secret := range page.SecretList {
-			if shouldIncludeSecret(&secret, cfg) {
-				allSecrets = append(allSecrets, secret.ARN)
+			if value, ok := shouldIncludeSecret(&secret, cfg); ok {
+				allSecrets = append(allSecrets, resource.NewResourceDetails(secret.ARN, value).WithARN(secret.ARN))
 			}
 		}
 	}
@@ -62,10 +61,10 @@ func listSecretsManagerSecrets(ctx context.Context, client SecretsManagerAPI, sc
 	return allSecrets, nil
 }
 
-// shouldIncludeSecret determines if a secret should be included based on config filters.
-func shouldIncludeSecret(secret *types.SecretListEntry, cfg config.ResourceType) bool {
+// shouldIncludeSecret determines if a secret should be included based on config filters, returning the value it was filtered on.
+func shouldIncludeSecret(secret *types.SecretListEntry, cfg config.ResourceType) (config.ResourceValue, bool) {
 	if secret == nil {
-		return false
+		return config.ResourceValue{}, false
 	}
 
 	// reference time for excludeAfter is last accessed time, unless it was never accessed in which created time is
@@ -77,11 +76,12 @@ func shouldIncludeSecret(secret *types.SecretListEntry, cfg config.ResourceType)
 		referenceTime = *secret.LastAccessedDate
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Time: &referenceTime,
 		Name: secret.Name,
 		Tags: util.ConvertSecretsManagerTagsToMap(secret.Tags),
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // deleteSecretsManagerSecret deletes a single Secrets Manager secret.
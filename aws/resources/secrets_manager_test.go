@@ -88,7 +88,7 @@ func TestListSecretsManagerSecrets(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listSecretsManagerSecrets(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -38,7 +38,10 @@ func NewSecurityGroup() AwsResource {
 	r := &securityGroupResource{
 		Resource: &resource.Resource[SecurityGroupAPI]{
 			ResourceTypeName: "security-group",
+			Categories:       []string{resource.CategoryNetwork},
 			BatchSize:        DefaultBatchSize,
+			DependsOn:        []string{"ec2", "network-interface"},
+			ConfigKey:        "SecurityGroup",
 		},
 	}
 
@@ -47,13 +50,12 @@ func NewSecurityGroup() AwsResource {
 		res.Client = ec2.NewFromConfig(cfg)
 	})
 
-	r.ConfigGetter = func(c config.Config) config.ResourceType {
+	r.OnConfig = func(c config.Config) {
 		// Store the DefaultOnly flag for use in lister and nuker
 		r.nukeOnlyDefault = c.SecurityGroup.DefaultOnly
-		return c.SecurityGroup.ResourceType
 	}
 
-	r.Lister = func(ctx context.Context, client SecurityGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+	r.DetailedLister = func(ctx context.Context, client SecurityGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 		return listSecurityGroups(ctx, client, cfg, r.nukeOnlyDefault)
 	}
 
@@ -68,8 +70,8 @@ func NewSecurityGroup() AwsResource {
 
 // listSecurityGroups returns security group IDs that match the filter criteria.
 // Uses pagination to handle large numbers of security groups.
-func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config.ResourceType, defaultOnly bool) ([]*string, error) {
-	var identifiers []*string
+func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config.ResourceType, defaultOnly bool) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	// Build filters - for default-only mode, filter to just default security groups
 	var filters []types.Filter
@@ -101,8 +103,8 @@ func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config
 				return nil, cerrors.WithStackTrace(err)
 			}
 
-			if shouldIncludeSecurityGroup(group, firstSeenTime, cfg, defaultOnly) {
-				identifiers = append(identifiers, group.GroupId)
+			if value, ok := shouldIncludeSecurityGroup(group, firstSeenTime, cfg, defaultOnly); ok {
+				identifiers = append(identifiers, resource.NewResourceDetails(group.GroupId, value).WithARN(group.SecurityGroupArn))
 			}
 		}
 	}
@@ -110,21 +112,22 @@ func listSecurityGroups(ctx context.Context, client SecurityGroupAPI, cfg config
 	return identifiers, nil
 }
 
-// shouldIncludeSecurityGroup determines whether a security group should be included for deletion.
-func shouldIncludeSecurityGroup(sg types.SecurityGroup, firstSeenTime *time.Time, cfg config.ResourceType, defaultOnly bool) bool {
+// shouldIncludeSecurityGroup determines whether a security group should be included for deletion, returning the value it was filtered on.
+func shouldIncludeSecurityGroup(sg types.SecurityGroup, firstSeenTime *time.Time, cfg config.ResourceType, defaultOnly bool) (config.ResourceValue, bool) {
 	groupName := sg.GroupName
 
 	// Skip default security groups unless we're in default-only mode
 	if !defaultOnly && aws.ToString(groupName) == "default" {
 		logging.Debugf("[default security group] skipping default security group including")
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{
+	value := config.ResourceValue{
 		Name: groupName,
 		Tags: util.ConvertTypesTagsToMap(sg.Tags),
 		Time: firstSeenTime,
-	})
+	}
+	return value, cfg.ShouldInclude(value)
 }
 
 // verifySecurityGroupNukePermission performs a dry-run delete to check permissions.
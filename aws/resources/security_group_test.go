@@ -126,7 +126,7 @@ func TestSecurityGroup_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listSecurityGroups(ctx, mockClient, tc.configObj, tc.defaultOnly)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -161,7 +161,7 @@ func TestSecurityGroup_List_SkipsDefault(t *testing.T) {
 	// Without defaultOnly, should skip "default" security group
 	ids, err := listSecurityGroups(ctx, mockClient, config.ResourceType{}, false)
 	require.NoError(t, err)
-	require.Equal(t, []string{"sg-custom"}, aws.ToStringSlice(ids))
+	require.Equal(t, []string{"sg-custom"}, resource.IDs(ids))
 }
 
 func TestSecurityGroup_Nuke(t *testing.T) {
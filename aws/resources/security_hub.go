@@ -29,24 +29,23 @@ type SecurityHubAPI interface {
 func NewSecurityHub() AwsResource {
 	return NewAwsResource(&resource.Resource[SecurityHubAPI]{
 		ResourceTypeName: "security-hub",
+		Categories:       []string{resource.CategoryObservability},
 		// SecurityHub API has tight rate limits; keep batch size low to avoid throttling.
 		BatchSize: 5,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SecurityHubAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = securityhub.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SecurityHub
-		},
-		Lister: listSecurityHubs,
-		Nuker:  resource.MultiStepDeleter(removeSecurityHubMembers, disassociateSecurityHubAdmin, disableSecurityHub),
+		ConfigKey:      "SecurityHub",
+		DetailedLister: listSecurityHubs,
+		Nuker:          resource.MultiStepDeleter(removeSecurityHubMembers, disassociateSecurityHubAdmin, disableSecurityHub),
 	})
 }
 
 // listSecurityHubs retrieves all Security Hubs that match the config filters.
 // Note: There is only one Security Hub per region, so no pagination is needed.
-func listSecurityHubs(ctx context.Context, client SecurityHubAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var securityHubArns []*string
+func listSecurityHubs(ctx context.Context, client SecurityHubAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var securityHubArns []resource.ResourceDetails
 
 	output, err := client.DescribeHub(ctx, &securityhub.DescribeHubInput{})
 
@@ -72,22 +71,23 @@ func listSecurityHubs(ctx context.Context, client SecurityHubAPI, _ resource.Sco
 		}
 	}
 
-	if shouldIncludeSecurityHub(output, tags, cfg) {
-		securityHubArns = append(securityHubArns, output.HubArn)
+	if value, ok := shouldIncludeSecurityHub(output, tags, cfg); ok {
+		securityHubArns = append(securityHubArns, resource.NewResourceDetails(output.HubArn, value).WithARN(output.HubArn))
 	}
 
 	return securityHubArns, nil
 }
 
-func shouldIncludeSecurityHub(hub *securityhub.DescribeHubOutput, tags map[string]string, cfg config.ResourceType) bool {
+func shouldIncludeSecurityHub(hub *securityhub.DescribeHubOutput, tags map[string]string, cfg config.ResourceType) (config.ResourceValue, bool) {
 	subscribedAt, err := util.ParseTimestamp(hub.SubscribedAt)
 	if err != nil {
 		logging.Debugf(
 			"Could not parse subscribedAt timestamp (%s) of security hub. Excluding from delete.", *hub.SubscribedAt)
-		return false
+		return config.ResourceValue{}, false
 	}
 
-	return cfg.ShouldInclude(config.ResourceValue{Time: subscribedAt, Tags: tags})
+	value := config.ResourceValue{Time: subscribedAt, Tags: tags}
+	return value, cfg.ShouldInclude(value)
 }
 
 // removeSecurityHubMembers removes all member accounts from Security Hub.
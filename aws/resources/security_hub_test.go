@@ -113,7 +113,7 @@ func TestSecurityHub_List(t *testing.T) {
 
 			result, err := listSecurityHubs(context.Background(), client, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(result))
+			require.Equal(t, tc.expected, resource.IDs(result))
 		})
 	}
 }
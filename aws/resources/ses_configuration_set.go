@@ -19,22 +19,21 @@ type SesConfigurationSetAPI interface {
 func NewSesConfigurationSet() AwsResource {
 	return NewAwsResource(&resource.Resource[SesConfigurationSetAPI]{
 		ResourceTypeName: "ses-configuration-set",
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SesConfigurationSetAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ses.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SESConfigurationSet
-		},
-		Lister: listSesConfigurationSets,
-		Nuker:  resource.SimpleBatchDeleter(deleteSesConfigurationSet),
+		ConfigKey:      "SESConfigurationSet",
+		DetailedLister: listSesConfigurationSets,
+		Nuker:          resource.SimpleBatchDeleter(deleteSesConfigurationSet),
 	})
 }
 
 // listSesConfigurationSets retrieves all SES configuration sets that match the config filters.
-func listSesConfigurationSets(ctx context.Context, client SesConfigurationSetAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var configSets []*string
+func listSesConfigurationSets(ctx context.Context, client SesConfigurationSetAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var configSets []resource.ResourceDetails
 	var nextToken *string
 
 	for {
@@ -46,10 +45,11 @@ func listSesConfigurationSets(ctx context.Context, client SesConfigurationSetAPI
 		}
 
 		for _, set := range result.ConfigurationSets {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: set.Name,
-			}) {
-				configSets = append(configSets, set.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				configSets = append(configSets, resource.NewResourceDetails(set.Name, value))
 			}
 		}
 
@@ -30,22 +30,21 @@ type SESReceiptRuleSetAPI interface {
 func NewSesReceiptRule() AwsResource {
 	return NewAwsResource(&resource.Resource[SESReceiptRuleSetAPI]{
 		ResourceTypeName: "ses-receipt-rule-set",
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SESReceiptRuleSetAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ses.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SESReceiptRuleSet
-		},
-		Lister: listSesReceiptRuleSets,
-		Nuker:  resource.SimpleBatchDeleter(deleteSesReceiptRuleSet),
+		ConfigKey:      "SESReceiptRuleSet",
+		DetailedLister: listSesReceiptRuleSets,
+		Nuker:          resource.SimpleBatchDeleter(deleteSesReceiptRuleSet),
 	})
 }
 
 // listSesReceiptRuleSets retrieves all SES receipt rule sets that match the config filters.
 // Note: Active rule sets cannot be deleted, so they are excluded from the results.
-func listSesReceiptRuleSets(ctx context.Context, client SESReceiptRuleSetAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listSesReceiptRuleSets(ctx context.Context, client SESReceiptRuleSetAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Check if region supports email receiving
 	if !slices.Contains(ReceiptRuleAllowedRegions, scope.Region) {
 		logging.Debugf("Region %s is not allowed for Email receiving", scope.Region)
@@ -58,7 +57,7 @@ func listSesReceiptRuleSets(ctx context.Context, client SESReceiptRuleSetAPI, sc
 		return nil, err
 	}
 
-	var ruleSets []*string
+	var ruleSets []resource.ResourceDetails
 	var nextToken *string
 
 	for {
@@ -77,11 +76,12 @@ func listSesReceiptRuleSets(ctx context.Context, client SESReceiptRuleSetAPI, sc
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: ruleSet.Name,
 				Time: ruleSet.CreatedTimestamp,
-			}) {
-				ruleSets = append(ruleSets, ruleSet.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				ruleSets = append(ruleSets, resource.NewResourceDetails(ruleSet.Name, value))
 			}
 		}
 
@@ -112,33 +112,33 @@ type SESReceiptFilterAPI interface {
 func NewSesReceiptFilter() AwsResource {
 	return NewAwsResource(&resource.Resource[SESReceiptFilterAPI]{
 		ResourceTypeName: "ses-receipt-filter",
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SESReceiptFilterAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ses.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SESReceiptFilter
-		},
-		Lister: listSesReceiptFilters,
-		Nuker:  resource.SimpleBatchDeleter(deleteSesReceiptFilter),
+		ConfigKey:      "SESReceiptFilter",
+		DetailedLister: listSesReceiptFilters,
+		Nuker:          resource.SimpleBatchDeleter(deleteSesReceiptFilter),
 	})
 }
 
 // listSesReceiptFilters retrieves all SES receipt filters that match the config filters.
 // Note: ListReceiptFilters does not support pagination.
-func listSesReceiptFilters(ctx context.Context, client SESReceiptFilterAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listSesReceiptFilters(ctx context.Context, client SESReceiptFilterAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	output, err := client.ListReceiptFilters(ctx, &ses.ListReceiptFiltersInput{})
 	if err != nil {
 		return nil, err
 	}
 
-	var filters []*string
+	var filters []resource.ResourceDetails
 	for _, filter := range output.Filters {
-		if cfg.ShouldInclude(config.ResourceValue{
+		value := config.ResourceValue{
 			Name: filter.Name,
-		}) {
-			filters = append(filters, filter.Name)
+		}
+		if cfg.ShouldInclude(value) {
+			filters = append(filters, resource.NewResourceDetails(filter.Name, value))
 		}
 	}
 
@@ -98,7 +98,7 @@ func TestListSesReceiptRuleSets(t *testing.T) {
 
 			names, err := listSesReceiptRuleSets(context.Background(), client, scope, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -167,7 +167,7 @@ func TestListSesReceiptFilters(t *testing.T) {
 
 			names, err := listSesReceiptFilters(context.Background(), client, scope, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -19,22 +19,21 @@ type SesEmailTemplatesAPI interface {
 func NewSesEmailTemplates() AwsResource {
 	return NewAwsResource(&resource.Resource[SesEmailTemplatesAPI]{
 		ResourceTypeName: "ses-email-template",
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SesEmailTemplatesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ses.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SESEmailTemplates
-		},
-		Lister: listSesEmailTemplates,
-		Nuker:  resource.SimpleBatchDeleter(deleteSesEmailTemplate),
+		ConfigKey:      "SESEmailTemplates",
+		DetailedLister: listSesEmailTemplates,
+		Nuker:          resource.SimpleBatchDeleter(deleteSesEmailTemplate),
 	})
 }
 
 // listSesEmailTemplates retrieves all SES email templates that match the config filters.
-func listSesEmailTemplates(ctx context.Context, client SesEmailTemplatesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var templates []*string
+func listSesEmailTemplates(ctx context.Context, client SesEmailTemplatesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var templates []resource.ResourceDetails
 	var nextToken *string
 
 	for {
@@ -46,11 +45,12 @@ func listSesEmailTemplates(ctx context.Context, client SesEmailTemplatesAPI, sco
 		}
 
 		for _, template := range result.TemplatesMetadata {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: template.Name,
 				Time: template.CreatedTimestamp,
-			}) {
-				templates = append(templates, template.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				templates = append(templates, resource.NewResourceDetails(template.Name, value))
 			}
 		}
 
@@ -73,7 +73,7 @@ func TestListSesEmailTemplates(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			names, err := listSesEmailTemplates(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -20,22 +20,21 @@ type SESIdentityAPI interface {
 func NewSesIdentities() AwsResource {
 	return NewAwsResource(&resource.Resource[SESIdentityAPI]{
 		ResourceTypeName: "ses-identity",
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SESIdentityAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ses.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SESIdentity
-		},
-		Lister: listSesIdentities,
-		Nuker:  resource.SimpleBatchDeleter(deleteSesIdentity),
+		ConfigKey:      "SESIdentity",
+		DetailedLister: listSesIdentities,
+		Nuker:          resource.SimpleBatchDeleter(deleteSesIdentity),
 	})
 }
 
 // listSesIdentities retrieves all SES Identities that match the config filters.
-func listSesIdentities(ctx context.Context, client SESIdentityAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listSesIdentities(ctx context.Context, client SESIdentityAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	paginator := ses.NewListIdentitiesPaginator(client, &ses.ListIdentitiesInput{})
 
 	for paginator.HasMorePages() {
@@ -45,8 +44,9 @@ func listSesIdentities(ctx context.Context, client SESIdentityAPI, _ resource.Sc
 		}
 
 		for _, id := range page.Identities {
-			if cfg.ShouldInclude(config.ResourceValue{Name: aws.String(id)}) {
-				ids = append(ids, aws.String(id))
+			value := config.ResourceValue{Name: aws.String(id)}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(aws.String(id), value))
 			}
 		}
 	}
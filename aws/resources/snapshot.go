@@ -26,16 +26,16 @@ type SnapshotsAPI interface {
 func NewSnapshots() AwsResource {
 	return NewAwsResource(&resource.Resource[SnapshotsAPI]{
 		ResourceTypeName: "ebs-snapshot",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
+		DependsOn:        []string{"ami"},
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[SnapshotsAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.Snapshots
-		},
-		Lister: listSnapshots,
-		Nuker:  resource.MultiStepDeleter(deregisterSnapshotAMIs, deleteSnapshot),
+		ConfigKey:      "Snapshots",
+		DetailedLister: listSnapshots,
+		Nuker:          resource.MultiStepDeleter(deregisterSnapshotAMIs, deleteSnapshot),
 		PermissionVerifier: func(ctx context.Context, client SnapshotsAPI, id *string) error {
 			_, err := client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{
 				SnapshotId: id,
@@ -47,13 +47,13 @@ func NewSnapshots() AwsResource {
 }
 
 // listSnapshots retrieves all EBS Snapshots that match the config filters.
-func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// status - The status of the snapshot (pending | completed | error).
 	// We only want to list EBS Snapshots with a status of "completed" or "error"
 	// since those are the only statuses eligible for deletion.
 	statusFilter := types.Filter{Name: aws.String("status"), Values: []string{"completed", "error"}}
 
-	var snapshotIds []*string
+	var snapshotIds []resource.ResourceDetails
 	paginator := ec2.NewDescribeSnapshotsPaginator(client, &ec2.DescribeSnapshotsInput{
 		OwnerIds: []string{"self"},
 		Filters:  []types.Filter{statusFilter},
@@ -66,11 +66,12 @@ func listSnapshots(ctx context.Context, client SnapshotsAPI, scope resource.Scop
 		}
 
 		for _, snapshot := range page.Snapshots {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: snapshot.StartTime,
 				Tags: util.ConvertTypesTagsToMap(snapshot.Tags),
-			}) && !snapshotHasAWSBackupTag(snapshot.Tags) {
-				snapshotIds = append(snapshotIds, snapshot.SnapshotId)
+			}
+			if cfg.ShouldInclude(value) && !snapshotHasAWSBackupTag(snapshot.Tags) {
+				snapshotIds = append(snapshotIds, resource.NewResourceDetails(snapshot.SnapshotId, value))
 			}
 		}
 	}
@@ -100,7 +100,7 @@ func TestListSnapshots(t *testing.T) {
 			mock := &mockSnapshotsClient{Pages: tc.pages}
 			ids, err := listSnapshots(context.Background(), mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
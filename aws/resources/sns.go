@@ -32,24 +32,22 @@ func NewSNSTopic() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = sns.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SNS
-		},
-		Lister: listSNSTopics,
-		Nuker:  resource.SimpleBatchDeleter(deleteSNSTopic),
+		ConfigKey:      "SNS",
+		DetailedLister: listSNSTopics,
+		Nuker:          resource.SimpleBatchDeleter(deleteSNSTopic),
 	})
 }
 
 // listSNSTopics retrieves all SNS topics that match the config filters.
 // SNS APIs do not return a creation date, so we tag resources with a first-seen time
 // when the topic first appears, then use that tag for time-based filtering.
-func listSNSTopics(ctx context.Context, client SNSTopicAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listSNSTopics(ctx context.Context, client SNSTopicAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	excludeFirstSeenTag, err := util.GetBoolFromContext(ctx, util.ExcludeFirstSeenTagKey)
 	if err != nil {
 		return nil, errors.WithStackTrace(err)
 	}
 
-	var topics []*string
+	var topics []resource.ResourceDetails
 	paginator := sns.NewListTopicsPaginator(client, &sns.ListTopicsInput{})
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -76,12 +74,13 @@ func listSNSTopics(ctx context.Context, client SNSTopicAPI, scope resource.Scope
 			// Extract topic name from ARN (format: arn:aws:sns:us-east-1:123456789012:MyTopic)
 			topicName := (*topic.TopicArn)[strings.LastIndex(*topic.TopicArn, ":")+1:]
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: firstSeenTime,
 				Name: &topicName,
 				Tags: util.ConvertSNSTagsToMap(tagsOutput.Tags),
-			}) {
-				topics = append(topics, topic.TopicArn)
+			}
+			if cfg.ShouldInclude(value) {
+				topics = append(topics, resource.NewResourceDetails(topic.TopicArn, value).WithARN(topic.TopicArn))
 			}
 		}
 	}
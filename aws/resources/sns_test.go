@@ -114,7 +114,7 @@ func TestSNSTopic_GetAll(t *testing.T) {
 			ctx := context.WithValue(context.Background(), util.ExcludeFirstSeenTagKey, false)
 			topics, err := listSNSTopics(ctx, mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(topics))
+			require.Equal(t, tc.expected, resource.IDs(topics))
 		})
 	}
 }
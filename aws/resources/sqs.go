@@ -32,17 +32,15 @@ func NewSqsQueue() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = sqs.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SQS
-		},
-		Lister: listSqsQueues,
-		Nuker:  resource.SimpleBatchDeleter(deleteSqsQueue),
+		ConfigKey:      "SQS",
+		DetailedLister: listSqsQueues,
+		Nuker:          resource.SimpleBatchDeleter(deleteSqsQueue),
 	})
 }
 
 // listSqsQueues retrieves all SQS Queues that match the config filters.
-func listSqsQueues(ctx context.Context, client SqsQueueAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var queueUrls []*string
+func listSqsQueues(ctx context.Context, client SqsQueueAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var queueUrls []resource.ResourceDetails
 
 	paginator := sqs.NewListQueuesPaginator(client, &sqs.ListQueuesInput{})
 	for paginator.HasMorePages() {
@@ -65,12 +63,13 @@ func listSqsQueues(ctx context.Context, client SqsQueueAPI, scope resource.Scope
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: aws.String(queueUrl),
 				Time: createdAt,
 				Tags: tagsOutput.Tags,
-			}) {
-				queueUrls = append(queueUrls, aws.String(queueUrl))
+			}
+			if cfg.ShouldInclude(value) {
+				queueUrls = append(queueUrls, resource.NewResourceDetails(aws.String(queueUrl), value))
 			}
 		}
 	}
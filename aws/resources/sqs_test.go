@@ -99,7 +99,7 @@ func TestSqsQueue_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			urls, err := listSqsQueues(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(urls))
+			require.Equal(t, tc.expected, resource.IDs(urls))
 		})
 	}
 }
@@ -30,17 +30,15 @@ func NewSSMParameter() AwsResource {
 			r.Scope.Region = cfg.Region
 			r.Client = ssm.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.SSMParameter
-		},
-		Lister: listSSMParameters,
-		Nuker:  resource.SimpleBatchDeleter(deleteSSMParameter),
+		ConfigKey:      "SSMParameter",
+		DetailedLister: listSSMParameters,
+		Nuker:          resource.SimpleBatchDeleter(deleteSSMParameter),
 	})
 }
 
 // listSSMParameters retrieves all SSM parameters that match the config filters.
-func listSSMParameters(ctx context.Context, client SSMParameterAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var names []*string
+func listSSMParameters(ctx context.Context, client SSMParameterAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var names []resource.ResourceDetails
 
 	paginator := ssm.NewDescribeParametersPaginator(client, &ssm.DescribeParametersInput{})
 	for paginator.HasMorePages() {
@@ -72,12 +70,13 @@ func listSSMParameters(ctx context.Context, client SSMParameterAPI, scope resour
 
 			// SSM DescribeParameters does not expose a creation time; LastModifiedDate is the
 			// closest available timestamp and is used as the time filter for this resource type.
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: param.Name,
 				Time: param.LastModifiedDate,
 				Tags: tags,
-			}) {
-				names = append(names, param.Name)
+			}
+			if cfg.ShouldInclude(value) {
+				names = append(names, resource.NewResourceDetails(param.Name, value).WithARN(param.ARN))
 			}
 		}
 	}
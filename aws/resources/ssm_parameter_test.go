@@ -123,7 +123,7 @@ func TestSSMParameter_GetAll(t *testing.T) {
 			t.Parallel()
 			names, err := listSSMParameters(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(names))
+			require.Equal(t, tc.expected, resource.IDs(names))
 		})
 	}
 }
@@ -148,7 +148,7 @@ func TestSSMParameter_GetAll_TagFetchFailure(t *testing.T) {
 
 	names, err := listSSMParameters(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"/param/good"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"/param/good"}, resource.IDs(names))
 }
 
 // TestSSMParameter_GetAll_SkipsAwsManagedParameters verifies that AWS-managed public
@@ -172,7 +172,7 @@ func TestSSMParameter_GetAll_SkipsAwsManagedParameters(t *testing.T) {
 
 	names, err := listSSMParameters(context.Background(), mock, resource.Scope{}, config.ResourceType{})
 	require.NoError(t, err)
-	require.Equal(t, []string{"/app/my-param"}, aws.ToStringSlice(names))
+	require.Equal(t, []string{"/app/my-param"}, resource.IDs(names))
 }
 
 func TestSSMParameter_GetAll_DescribeError(t *testing.T) {
@@ -20,22 +20,21 @@ type TransitGatewayPeeringAttachmentAPI interface {
 func NewTransitGatewayPeeringAttachment() AwsResource {
 	return NewAwsResource(&resource.Resource[TransitGatewayPeeringAttachmentAPI]{
 		ResourceTypeName: "transit-gateway-peering-attachment",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[TransitGatewayPeeringAttachmentAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.TransitGatewayPeeringAttachment
-		},
-		Lister: listTransitGatewayPeeringAttachments,
-		Nuker:  resource.SimpleBatchDeleter(deleteTransitGatewayPeeringAttachment),
+		ConfigKey:      "TransitGatewayPeeringAttachment",
+		DetailedLister: listTransitGatewayPeeringAttachments,
+		Nuker:          resource.SimpleBatchDeleter(deleteTransitGatewayPeeringAttachment),
 	})
 }
 
 // listTransitGatewayPeeringAttachments retrieves all Transit Gateway Peering Attachments that match the config filters.
-func listTransitGatewayPeeringAttachments(ctx context.Context, client TransitGatewayPeeringAttachmentAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listTransitGatewayPeeringAttachments(ctx context.Context, client TransitGatewayPeeringAttachmentAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeTransitGatewayPeeringAttachmentsPaginator(client, &ec2.DescribeTransitGatewayPeeringAttachmentsInput{})
 	for paginator.HasMorePages() {
@@ -45,12 +44,13 @@ func listTransitGatewayPeeringAttachments(ctx context.Context, client TransitGat
 		}
 
 		for _, attachment := range page.TransitGatewayPeeringAttachments {
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: attachment.TransitGatewayAttachmentId,
 				Time: attachment.CreationTime,
 				Tags: util.ConvertTypesTagsToMap(attachment.Tags),
-			}) {
-				ids = append(ids, attachment.TransitGatewayAttachmentId)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(attachment.TransitGatewayAttachmentId, value))
 			}
 		}
 	}
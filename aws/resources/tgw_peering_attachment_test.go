@@ -68,7 +68,7 @@ func TestListTransitGatewayPeeringAttachments(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listTransitGatewayPeeringAttachments(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
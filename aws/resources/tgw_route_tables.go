@@ -22,22 +22,22 @@ type TransitGatewaysRouteTablesAPI interface {
 func NewTransitGatewaysRouteTables() AwsResource {
 	return NewAwsResource(&resource.Resource[TransitGatewaysRouteTablesAPI]{
 		ResourceTypeName: "transit-gateway-route-table",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"transit-gateway-attachment", "transit-gateway-peering-attachment"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[TransitGatewaysRouteTablesAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.TransitGatewayRouteTable
-		},
-		Lister: listTransitGatewayRouteTables,
-		Nuker:  resource.SimpleBatchDeleter(deleteTransitGatewayRouteTable),
+		ConfigKey:      "TransitGatewayRouteTable",
+		DetailedLister: listTransitGatewayRouteTables,
+		Nuker:          resource.SimpleBatchDeleter(deleteTransitGatewayRouteTable),
 	})
 }
 
 // listTransitGatewayRouteTables retrieves all Transit Gateway Route Tables that match the config filters.
 // Uses pagination to handle large numbers of route tables.
-func listTransitGatewayRouteTables(ctx context.Context, client TransitGatewaysRouteTablesAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listTransitGatewayRouteTables(ctx context.Context, client TransitGatewaysRouteTablesAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	// Filter out default route tables - they are deleted along with their TransitGateway
 	input := &ec2.DescribeTransitGatewayRouteTablesInput{
 		Filters: []types.Filter{
@@ -48,7 +48,7 @@ func listTransitGatewayRouteTables(ctx context.Context, client TransitGatewaysRo
 		},
 	}
 
-	var ids []*string
+	var ids []resource.ResourceDetails
 	paginator := ec2.NewDescribeTransitGatewayRouteTablesPaginator(client, input)
 	for paginator.HasMorePages() {
 		page, err := paginator.NextPage(ctx)
@@ -63,11 +63,12 @@ func listTransitGatewayRouteTables(ctx context.Context, client TransitGatewaysRo
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: rt.CreationTime,
 				Tags: util.ConvertTypesTagsToMap(rt.Tags),
-			}) {
-				ids = append(ids, rt.TransitGatewayRouteTableId)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(rt.TransitGatewayRouteTableId, value))
 			}
 		}
 	}
@@ -87,7 +87,7 @@ func TestTransitGatewayRouteTables_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listTransitGatewayRouteTables(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
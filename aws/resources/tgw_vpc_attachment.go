@@ -25,22 +25,21 @@ type TransitGatewaysVpcAttachmentAPI interface {
 func NewTransitGatewaysVpcAttachment() AwsResource {
 	return NewAwsResource(&resource.Resource[TransitGatewaysVpcAttachmentAPI]{
 		ResourceTypeName: "transit-gateway-attachment",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[TransitGatewaysVpcAttachmentAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.TransitGatewayVPCAttachment
-		},
-		Lister: listTransitGatewaysVpcAttachments,
-		Nuker:  resource.SequentialDeleteThenWaitAll(deleteTransitGatewayVpcAttachment, waitForTransitGatewayAttachmentsToBeDeleted),
+		ConfigKey:      "TransitGatewayVPCAttachment",
+		DetailedLister: listTransitGatewaysVpcAttachments,
+		Nuker:          resource.SequentialDeleteThenWaitAll(deleteTransitGatewayVpcAttachment, waitForTransitGatewayAttachmentsToBeDeleted),
 	})
 }
 
 // listTransitGatewaysVpcAttachments retrieves all Transit Gateway VPC Attachments that match the config filters.
-func listTransitGatewaysVpcAttachments(ctx context.Context, client TransitGatewaysVpcAttachmentAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var identifiers []*string
+func listTransitGatewaysVpcAttachments(ctx context.Context, client TransitGatewaysVpcAttachmentAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var identifiers []resource.ResourceDetails
 
 	paginator := ec2.NewDescribeTransitGatewayVpcAttachmentsPaginator(client, &ec2.DescribeTransitGatewayVpcAttachmentsInput{})
 	for paginator.HasMorePages() {
@@ -57,14 +56,15 @@ func listTransitGatewaysVpcAttachments(ctx context.Context, client TransitGatewa
 				continue
 			}
 
-			if !cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: attachment.CreationTime,
 				Tags: util.ConvertTypesTagsToMap(attachment.Tags),
-			}) {
+			}
+			if !cfg.ShouldInclude(value) {
 				continue
 			}
 
-			identifiers = append(identifiers, attachment.TransitGatewayAttachmentId)
+			identifiers = append(identifiers, resource.NewResourceDetails(attachment.TransitGatewayAttachmentId, value))
 		}
 	}
 
@@ -73,7 +73,7 @@ func TestListTransitGatewayVpcAttachments(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listTransitGatewaysVpcAttachments(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
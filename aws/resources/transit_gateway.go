@@ -29,15 +29,15 @@ type TransitGatewaysAPI interface {
 func NewTransitGateways() AwsResource {
 	return NewAwsResource(&resource.Resource[TransitGatewaysAPI]{
 		ResourceTypeName: "transit-gateway",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"transit-gateway-attachment", "transit-gateway-peering-attachment", "transit-gateway-route-table"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[TransitGatewaysAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = ec2.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.TransitGateway
-		},
-		Lister:             listTransitGateways,
+		ConfigKey:          "TransitGateway",
+		DetailedLister:     listTransitGateways,
 		Nuker:              resource.SequentialDeleter(nukeTransitGateway),
 		PermissionVerifier: verifyTransitGatewayNukePermission,
 	})
@@ -45,8 +45,8 @@ func NewTransitGateways() AwsResource {
 
 // listTransitGateways returns a formatted string of TransitGateway IDs.
 // Uses pagination to handle large numbers of transit gateways.
-func listTransitGateways(ctx context.Context, client TransitGatewaysAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listTransitGateways(ctx context.Context, client TransitGatewaysAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	currentOwner := ctx.Value(util.AccountIdKey)
 
 	paginator := ec2.NewDescribeTransitGatewaysPaginator(client, &ec2.DescribeTransitGatewaysInput{})
@@ -70,15 +70,16 @@ func listTransitGateways(ctx context.Context, client TransitGatewaysAPI, scope r
 			}
 
 			hostNameTagValue := util.GetEC2ResourceNameTagValue(transitGateway.Tags)
-			if !cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Time: transitGateway.CreationTime,
 				Name: hostNameTagValue,
 				Tags: util.ConvertTypesTagsToMap(transitGateway.Tags),
-			}) {
+			}
+			if !cfg.ShouldInclude(value) {
 				continue
 			}
 
-			ids = append(ids, transitGateway.TransitGatewayId)
+			ids = append(ids, resource.NewResourceDetails(transitGateway.TransitGatewayId, value).WithARN(transitGateway.TransitGatewayArn))
 		}
 	}
 
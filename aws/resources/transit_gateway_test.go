@@ -106,7 +106,7 @@ func TestTransitGateways_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listTransitGateways(context.Background(), mock, resource.Scope{Region: "us-east-1"}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -26,15 +26,14 @@ type VPCLatticeServiceAPI interface {
 func NewVPCLatticeService() AwsResource {
 	return NewAwsResource(&resource.Resource[VPCLatticeServiceAPI]{
 		ResourceTypeName: "vpc-lattice-service",
+		Categories:       []string{resource.CategoryNetwork},
 		BatchSize:        10,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[VPCLatticeServiceAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = vpclattice.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.VPCLatticeService
-		},
-		Lister: listVPCLatticeServices,
+		ConfigKey:      "VPCLatticeService",
+		DetailedLister: listVPCLatticeServices,
 		// VPC Lattice Service deletion requires: delete associations → wait → delete service
 		Nuker: resource.MultiStepDeleter(
 			deleteVPCLatticeServiceAssociations,
@@ -45,8 +44,8 @@ func NewVPCLatticeService() AwsResource {
 }
 
 // listVPCLatticeServices retrieves all VPC Lattice Services that match the config filters.
-func listVPCLatticeServices(ctx context.Context, client VPCLatticeServiceAPI, _ resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var allServices []*string
+func listVPCLatticeServices(ctx context.Context, client VPCLatticeServiceAPI, _ resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var allServices []resource.ResourceDetails
 
 	paginator := vpclattice.NewListServicesPaginator(client, &vpclattice.ListServicesInput{})
 	for paginator.HasMorePages() {
@@ -64,12 +63,13 @@ func listVPCLatticeServices(ctx context.Context, client VPCLatticeServiceAPI, _
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: service.Name,
 				Time: service.CreatedAt,
 				Tags: tagsOutput.Tags,
-			}) {
-				allServices = append(allServices, service.Arn)
+			}
+			if cfg.ShouldInclude(value) {
+				allServices = append(allServices, resource.NewResourceDetails(service.Arn, value).WithARN(service.Arn))
 			}
 		}
 	}
@@ -26,15 +26,15 @@ type VPCLatticeServiceNetworkAPI interface {
 func NewVPCLatticeServiceNetwork() AwsResource {
 	return NewAwsResource(&resource.Resource[VPCLatticeServiceNetworkAPI]{
 		ResourceTypeName: "vpc-lattice-service-network",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"vpc-lattice-service"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[VPCLatticeServiceNetworkAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = vpclattice.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.VPCLatticeServiceNetwork
-		},
-		Lister: listVPCLatticeServiceNetworks,
+		ConfigKey:      "VPCLatticeServiceNetwork",
+		DetailedLister: listVPCLatticeServiceNetworks,
 		// Service network deletion requires: delete associations → wait → delete network
 		Nuker: resource.MultiStepDeleter(
 			deleteServiceAssociations,
@@ -45,8 +45,8 @@ func NewVPCLatticeServiceNetwork() AwsResource {
 }
 
 // listVPCLatticeServiceNetworks retrieves all VPC Lattice Service Networks that match the config filters.
-func listVPCLatticeServiceNetworks(ctx context.Context, client VPCLatticeServiceNetworkAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listVPCLatticeServiceNetworks(ctx context.Context, client VPCLatticeServiceNetworkAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 
 	paginator := vpclattice.NewListServiceNetworksPaginator(client, &vpclattice.ListServiceNetworksInput{})
 	for paginator.HasMorePages() {
@@ -64,12 +64,13 @@ func listVPCLatticeServiceNetworks(ctx context.Context, client VPCLatticeService
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: item.Name,
 				Time: item.CreatedAt,
 				Tags: tagsOutput.Tags,
-			}) {
-				ids = append(ids, item.Arn)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(item.Arn, value).WithARN(item.Arn))
 			}
 		}
 	}
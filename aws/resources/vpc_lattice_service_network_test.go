@@ -126,7 +126,7 @@ func TestVPCLatticeServiceNetwork_GetAll(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ids, err := listVPCLatticeServiceNetworks(context.Background(), mock, resource.Scope{}, tc.configObj)
 			require.NoError(t, err)
-			require.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			require.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -25,22 +25,22 @@ type VPCLatticeTargetGroupAPI interface {
 func NewVPCLatticeTargetGroup() AwsResource {
 	return NewAwsResource(&resource.Resource[VPCLatticeTargetGroupAPI]{
 		ResourceTypeName: "vpc-lattice-target-group",
+		Categories:       []string{resource.CategoryNetwork},
+		DependsOn:        []string{"vpc-lattice-service"},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapAwsInitClient(func(r *resource.Resource[VPCLatticeTargetGroupAPI], cfg aws.Config) {
 			r.Scope.Region = cfg.Region
 			r.Client = vpclattice.NewFromConfig(cfg)
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.VPCLatticeTargetGroup
-		},
-		Lister: listVPCLatticeTargetGroups,
-		Nuker:  resource.MultiStepDeleter(deregisterVPCLatticeTargets, deleteVPCLatticeTargetGroup),
+		ConfigKey:      "VPCLatticeTargetGroup",
+		DetailedLister: listVPCLatticeTargetGroups,
+		Nuker:          resource.MultiStepDeleter(deregisterVPCLatticeTargets, deleteVPCLatticeTargetGroup),
 	})
 }
 
 // listVPCLatticeTargetGroups retrieves all VPC Lattice Target Groups that match the config filters.
-func listVPCLatticeTargetGroups(ctx context.Context, client VPCLatticeTargetGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var ids []*string
+func listVPCLatticeTargetGroups(ctx context.Context, client VPCLatticeTargetGroupAPI, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var ids []resource.ResourceDetails
 	paginator := vpclattice.NewListTargetGroupsPaginator(client, &vpclattice.ListTargetGroupsInput{})
 
 	for paginator.HasMorePages() {
@@ -58,12 +58,13 @@ func listVPCLatticeTargetGroups(ctx context.Context, client VPCLatticeTargetGrou
 				continue
 			}
 
-			if cfg.ShouldInclude(config.ResourceValue{
+			value := config.ResourceValue{
 				Name: item.Name,
 				Time: item.CreatedAt,
 				Tags: tagsOutput.Tags,
-			}) {
-				ids = append(ids, item.Arn)
+			}
+			if cfg.ShouldInclude(value) {
+				ids = append(ids, resource.NewResourceDetails(item.Arn, value).WithARN(item.Arn))
 			}
 		}
 	}
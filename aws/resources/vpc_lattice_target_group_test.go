@@ -118,7 +118,7 @@ func TestListVPCLatticeTargetGroups(t *testing.T) {
 
 			ids, err := listVPCLatticeTargetGroups(context.Background(), mock, resource.Scope{}, tc.cfg)
 			require.NoError(t, err)
-			assert.Equal(t, tc.expected, aws.ToStringSlice(ids))
+			assert.Equal(t, tc.expected, resource.IDs(ids))
 		})
 	}
 }
@@ -0,0 +1,240 @@
+package commands
+
+import (
+	"slices"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/urfave/cli/v2"
+)
+
+// Multi-account runs
+// These functions run the aws and inspect-aws commands against several accounts, assuming a role in each of them
+
+// accountScan holds what was found in one account of a multi-account run.
+type accountScan struct {
+	accountId string
+	query     *aws.Query
+	account   *aws.AwsAccountResources
+}
+
+// parseAccountSelection builds the accounts to run against from the CLI flags.
+// Flags that only make sense for a single account are rejected in multi-account runs.
+func parseAccountSelection(c *cli.Context) (aws.AccountSelection, error) {
+	selection := aws.AccountSelection{
+		OrgAccounts:       c.Bool(FlagOrgAccounts),
+		AccountIds:        c.StringSlice(FlagAccountIds),
+		ExcludeAccountIds: c.StringSlice(FlagExcludeAccountIds),
+		RoleName:          c.String(FlagAssumeRoleName),
+	}
+	if !selection.IsMultiAccount() {
+		return selection, nil
+	}
+	for _, flag := range []string{FlagPlan, FlagOutPlan, FlagJournal, FlagResume} {
+		if c.IsSet(flag) {
+			return selection, MultiAccountFlagConflictError{Flag: flag}
+		}
+	}
+	if err := selection.Validate(); err != nil {
+		return selection, err
+	}
+	return selection, nil
+}
+
+// resolveAccounts returns the IDs of the accounts selected by the flags. The run is refused if the accounts section of
+// the config doesn't allow one of them.
+func resolveAccounts(c *cli.Context, selection aws.AccountSelection, accounts config.Accounts) ([]string, error) {
+	var client aws.OrganizationsAPI
+	if selection.OrgAccounts {
+		orgClient, err := aws.NewOrganizationsClient()
+		if err != nil {
+			return nil, err
+		}
+		client = orgClient
+	}
+
+	accountIds, err := selection.Accounts(c.Context, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(accountIds) == 0 {
+		return nil, errors.WithStackTrace(NoAccountsSelectedError{})
+	}
+	for _, accountId := range accountIds {
+		if err := aws.CheckAccount(accountId, accounts); err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+	logging.Infof("Running against %d accounts", len(accountIds))
+	return accountIds, nil
+}
+
+// awsNukeAccounts scans every selected account, confirms deletion with the user once for all of them, and then
+// nukes the accounts one after the other. An account that can't be scanned or nuked is reported and skipped, and
+// the run carries on with the other accounts.
+func awsNukeAccounts(c *cli.Context, configObj config.Config, selection aws.AccountSelection, outputFormat string, outputFile string) error {
+	accountIds, err := resolveAccounts(c, selection, configObj.Accounts)
+	if err != nil {
+		return err
+	}
+
+	// The flags are validated, and shown, before assuming any role
+	query, err := generateQuery(c, c.Bool(FlagDeleteUnaliasedKMSKeys), nil, false)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	collector, cleanup, err := setupAwsReporting(c.Context, outputFormat, outputFile, query, accountIds)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	scanStarted := aws.NewScanStarted(query)
+	scanStarted.Accounts = accountIds
+	collector.Emit(scanStarted)
+
+	scans, allErrs := scanAccounts(c, configObj, selection, accountIds, c.Bool(FlagDeleteUnaliasedKMSKeys), collector)
+	collector.Emit(reporting.ScanComplete{})
+
+	// Refuse to nuke more than the delete limits of any account allow, or more than --max-delete across all of them,
+	// before asking for confirmation
+	accounts := make([]*aws.AwsAccountResources, 0, len(scans))
+	for _, scan := range scans {
+		if err := aws.EnforceDeleteLimits(scan.account, scan.query); err != nil {
+			return aws.AccountNukeError{AccountId: scan.accountId, Underlying: err}
+		}
+		accounts = append(accounts, scan.account)
+	}
+	if err := aws.EnforceTotalDeleteLimit(accounts, query); err != nil {
+		return err
+	}
+	total := 0
+	for _, account := range accounts {
+		total += account.TotalResourceCount()
+	}
+
+	shouldProceed, err := confirmNukeAccounts(c, total > 0, accountsConfirmationWords(scans, configObj.Accounts))
+	if err != nil {
+		return err
+	}
+	if !shouldProceed {
+		return allErrs.ErrorOrNil()
+	}
+
+	collector.Emit(reporting.NukeStarted{Total: total})
+	for _, scan := range scans {
+		if c.Context.Err() != nil {
+			break
+		}
+		emitter := reporting.AccountEmitter{Account: scan.accountId, Emitter: collector}
+		err := selection.InAccount(scan.accountId, func() error {
+			return aws.NukeAllResources(c.Context, scan.account, scan.query, emitter)
+		})
+		if err != nil {
+			allErrs = multierror.Append(allErrs, aws.AccountNukeError{AccountId: scan.accountId, Underlying: err})
+		}
+	}
+	collector.Emit(reporting.NukeComplete{})
+
+	return allErrs.ErrorOrNil()
+}
+
+// accountsConfirmationWords returns the words to type to confirm the nuke of the scanned accounts: the alias of every
+// account with resources to nuke that has one in the accounts section of the config, or NukeConfirmationWord if none
+// of them has an alias.
+func accountsConfirmationWords(scans []accountScan, accounts config.Accounts) []string {
+	var words []string
+	for _, scan := range scans {
+		alias := accounts.Alias(scan.accountId)
+		if alias != "" && scan.account.TotalResourceCount() > 0 && !slices.Contains(words, alias) {
+			words = append(words, alias)
+		}
+	}
+	if len(words) == 0 {
+		return []string{NukeConfirmationWord}
+	}
+	return words
+}
+
+// confirmNukeAccounts confirms the nuke of several accounts, with a prompt for each of the confirmation words.
+func confirmNukeAccounts(c *cli.Context, hasResources bool, confirmationWords []string) (bool, error) {
+	for _, confirmationWord := range confirmationWords {
+		shouldProceed, err := confirmNuke(c, hasResources, confirmationWord)
+		if err != nil || !shouldProceed {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// awsInspectAccounts lists the resources of every selected account without deleting them.
+func awsInspectAccounts(c *cli.Context, configObj config.Config, selection aws.AccountSelection, outputFormat string, outputFile string) error {
+	accountIds, err := resolveAccounts(c, selection, configObj.Accounts)
+	if err != nil {
+		return err
+	}
+
+	query, err := generateQuery(c, c.Bool(FlagListUnaliasedKMSKeys), nil, false)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
+	collector, cleanup, err := setupAwsReporting(c.Context, outputFormat, outputFile, query, accountIds)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	scanStarted := aws.NewScanStarted(query)
+	scanStarted.Accounts = accountIds
+	collector.Emit(scanStarted)
+
+	_, allErrs := scanAccounts(c, configObj, selection, accountIds, c.Bool(FlagListUnaliasedKMSKeys), collector)
+	collector.Emit(reporting.ScanComplete{})
+
+	return allErrs.ErrorOrNil()
+}
+
+// scanAccounts scans the accounts one after the other, with the role assumed in each of them. The query is built
+// again in every account, since the regions enabled in the accounts can differ. Accounts that can't be scanned are
+// reported as general errors and left out of the returned scans.
+func scanAccounts(c *cli.Context, configObj config.Config, selection aws.AccountSelection, accountIds []string,
+	includeUnaliasedKmsKeys bool, collector *reporting.Collector) ([]accountScan, *multierror.Error) {
+	var scans []accountScan
+	var allErrs *multierror.Error
+
+	for _, accountId := range accountIds {
+		if c.Context.Err() != nil {
+			break
+		}
+		emitter := reporting.AccountEmitter{Account: accountId, Emitter: collector}
+
+		scan := accountScan{accountId: accountId}
+		err := selection.InAccount(accountId, func() error {
+			var err error
+			scan.query, err = generateQuery(c, includeUnaliasedKmsKeys, nil, false)
+			if err != nil {
+				return err
+			}
+			scan.account, err = aws.GetAllResources(c.Context, scan.query, configObj, emitter)
+			return err
+		})
+		if err != nil {
+			logging.Errorf("Unable to scan account %s: %v", accountId, err)
+			emitter.Emit(reporting.GeneralError{
+				Description: "Unable to scan account",
+				Error:       err.Error(),
+			})
+			allErrs = multierror.Append(allErrs, aws.AccountNukeError{AccountId: accountId, Underlying: err})
+			continue
+		}
+		scans = append(scans, scan)
+	}
+
+	return scans, allErrs
+}
@@ -1,8 +1,13 @@
 package commands
 
 import (
+	"cmp"
+	"context"
+
 	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/nuke"
 	"github.com/gruntwork-io/cloud-nuke/renderers"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
@@ -29,6 +34,11 @@ func awsNuke(c *cli.Context) error {
 		return err
 	}
 
+	// Plans are applied without filters, so filtering flags would be ignored
+	if err := rejectPlanFilterFlags(c); err != nil {
+		return err
+	}
+
 	// Load config file if provided
 	configObj, err := loadConfigFile(c.String(FlagConfig))
 	if err != nil {
@@ -40,19 +50,94 @@ func awsNuke(c *cli.Context) error {
 		return err
 	}
 
+	// Apply protection tags to config
+	if err = parseAndApplyProtectionTags(c, &configObj); err != nil {
+		return err
+	}
+
+	// Get output preferences
+	outputFormat := c.String(FlagOutputFormat)
+	outputFile := c.String(FlagOutputFile)
+
+	// Run against several accounts, assuming a role in each of them, if requested
+	selection, err := parseAccountSelection(c)
+	if err != nil {
+		return err
+	}
+	if selection.IsMultiAccount() {
+		return awsNukeAccounts(c, configObj, selection, outputFormat, outputFile)
+	}
+
 	// Build AWS query from CLI flags
 	query, err := generateQuery(c, c.Bool(FlagDeleteUnaliasedKMSKeys), nil, false)
 	if err != nil {
 		return errors.WithStackTrace(err)
 	}
 
-	// Get output preferences
-	outputFormat := c.String(FlagOutputFormat)
-	outputFile := c.String(FlagOutputFile)
+	// Record progress to a journal, or resume from one, if requested
+	if c.String(FlagJournal) != "" || c.String(FlagResume) != "" {
+		accountId, err := aws.GetCurrentAccountId(aws.GlobalRegion)
+		if err != nil {
+			return errors.WithStackTrace(err)
+		}
+		query.Journal, err = openJournal(c, "aws/"+accountId, query.ResourceTypes)
+		if err != nil {
+			return err
+		}
+		defer query.Journal.Close()
+	}
+
+	if planFile := c.String(FlagPlan); planFile != "" {
+		return awsApplyPlan(c, configObj, planFile, query, outputFormat, outputFile)
+	}
 
 	return awsNukeHelper(c, configObj, query, outputFormat, outputFile)
 }
 
+// planFilterFlags are the flags that select the resources to nuke, which can't be combined with --plan.
+var planFilterFlags = []string{
+	FlagRegion, FlagExcludeRegion, FlagResourceType, FlagExcludeResourceType, FlagOlderThan, FlagNewerThan,
+	FlagIncludeTag, FlagDeleteUnaliasedKMSKeys,
+}
+
+// rejectPlanFilterFlags refuses filtering flags along with --plan, since plans are applied without filters.
+func rejectPlanFilterFlags(c *cli.Context) error {
+	if c.String(FlagPlan) == "" {
+		return nil
+	}
+	for _, flag := range planFilterFlags {
+		if c.IsSet(flag) {
+			return PlanFlagConflictError{Flag: flag}
+		}
+	}
+	return nil
+}
+
+// awsApplyPlan nukes exactly the resources of a plan created with inspect-aws --out-plan.
+// The filters of the config are ignored, and filtering flags are refused by rejectPlanFilterFlags. Only the execution
+// settings of the query, and the hooks, protection tags and accounts section of the config apply.
+func awsApplyPlan(c *cli.Context, configObj config.Config, planFile string, query *aws.Query, outputFormat string, outputFile string) error {
+	plan, err := aws.ReadPlan(planFile)
+	if err != nil {
+		return err
+	}
+
+	if err := aws.CheckCurrentAccount(configObj.Accounts); err != nil {
+		return err
+	}
+	confirmationWord, err := awsConfirmationWord(configObj.Accounts)
+	if err != nil {
+		return err
+	}
+
+	query.Regions = plan.Regions()
+	query.ResourceTypes = plan.ResourceTypes()
+
+	return nukeAwsResources(c, query, confirmationWord, outputFormat, outputFile, func(collector *reporting.Collector) (*aws.AwsAccountResources, error) {
+		return aws.GetPlannedResources(c.Context, plan, query, configObj, !c.Bool(FlagSkipPlanAccountCheck), collector)
+	})
+}
+
 // awsDefaults is the command handler for nuking AWS default VPCs and security groups.
 // This is a specialized version of awsNuke that targets only default resources.
 func awsDefaults(c *cli.Context) error {
@@ -65,7 +150,7 @@ func awsDefaults(c *cli.Context) error {
 
 	// Determine which default resources to target based on flags.
 	// Default VPCs have dependencies that must be deleted first.
-	// The resource ordering in resource_registry.go ensures correct deletion order.
+	// The dependencies declared by each resource ensure correct deletion order.
 	resourceTypes := []string{
 		"ec2-endpoint",      // Delete VPC endpoints in default VPCs
 		"nat-gateway",       // Delete NAT gateways in default VPCs
@@ -115,39 +200,103 @@ func awsInspect(c *cli.Context) error {
 		return err
 	}
 
-	// Build AWS query from CLI flags
-	query, err := generateQuery(c, c.Bool(FlagListUnaliasedKMSKeys), nil, false)
-	if err != nil {
-		return errors.WithStackTrace(err)
+	// Apply protection tags to config
+	if err = parseAndApplyProtectionTags(c, &configObj); err != nil {
+		return err
 	}
 
 	// Get output preferences
 	outputFormat := c.String(FlagOutputFormat)
 	outputFile := c.String(FlagOutputFile)
 
+	// Inspect several accounts, assuming a role in each of them, if requested
+	selection, err := parseAccountSelection(c)
+	if err != nil {
+		return err
+	}
+	if selection.IsMultiAccount() {
+		return awsInspectAccounts(c, configObj, selection, outputFormat, outputFile)
+	}
+
+	// Build AWS query from CLI flags
+	query, err := generateQuery(c, c.Bool(FlagListUnaliasedKMSKeys), nil, false)
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
 	// Retrieve and display resources without deleting them
-	_, err = handleGetResourcesWithFormat(c, configObj, query, outputFormat, outputFile)
-	return err
+	account, err := handleGetResourcesWithFormat(c, configObj, query, outputFormat, outputFile)
+	if err != nil {
+		return err
+	}
+
+	// Save what was found so it can be reviewed, and then nuked with aws --plan
+	if planFile := c.String(FlagOutPlan); planFile != "" {
+		plan := aws.NewPlan(account)
+		if err := aws.WritePlan(planFile, plan); err != nil {
+			return err
+		}
+		logging.Infof("Saved a plan for %d resources to %s", len(plan.Resources), planFile)
+	}
+	return nil
 }
 
 // Helper Functions
 // These functions contain shared logic used by multiple command handlers
 
 // awsNukeHelper is the core logic for nuking AWS resources.
-// It retrieves resources, confirms deletion with the user, and executes the nuke operation.
+// It retrieves resources, confirms deletion with the user, and executes the nuke operation with nuke.Run.
 func awsNukeHelper(c *cli.Context, configObj config.Config, query *aws.Query, outputFormat string, outputFile string) error {
+	confirmationWord, err := awsConfirmationWord(configObj.Accounts)
+	if err != nil {
+		return err
+	}
+
+	// Setup reporting - cleanup calls Complete() and closes writer
+	collector, cleanup, err := setupAwsReporting(c.Context, outputFormat, outputFile, query, nil)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	_, err = nuke.Run(c.Context, nuke.Options{
+		AWS:     query,
+		Config:  configObj,
+		Emitter: collector,
+		Confirm: cliConfirmation(c, confirmationWord),
+	})
+	return errors.WithStackTrace(err)
+}
+
+// awsConfirmationWord returns the word to type to confirm the nuke of the account of the current credentials: its
+// alias in the accounts section of the config, or NukeConfirmationWord if it has none.
+func awsConfirmationWord(accounts config.Accounts) (string, error) {
+	if len(accounts.Aliases) == 0 {
+		return NukeConfirmationWord, nil
+	}
+	accountId, err := aws.GetCurrentAccountId(aws.GlobalRegion)
+	if err != nil {
+		return "", errors.WithStackTrace(err)
+	}
+	return cmp.Or(accounts.Alias(accountId), NukeConfirmationWord), nil
+}
+
+// nukeAwsResources retrieves the resources to nuke with getResources, confirms deletion with the user,
+// and executes the nuke operation. Used to apply plans, whose resources are not scanned like those of nuke.Run.
+func nukeAwsResources(c *cli.Context, query *aws.Query, confirmationWord string, outputFormat string, outputFile string,
+	getResources func(collector *reporting.Collector) (*aws.AwsAccountResources, error)) error {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, query)
+	collector, cleanup, err := setupAwsReporting(c.Context, outputFormat, outputFile, query, nil)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
 	// Emit scan started event with query parameters
-	collector.Emit(buildAwsScanStarted(query))
+	collector.Emit(aws.NewScanStarted(query))
 
 	// Retrieve all matching resources (emits ResourceFound events via collector)
-	account, err := aws.GetAllResources(c.Context, query, configObj, collector)
+	account, err := getResources(collector)
 	if err != nil {
 		telemetry.TrackEvent(commonTelemetry.EventContext{
 			EventName: "Error getting resources",
@@ -158,15 +307,20 @@ func awsNukeHelper(c *cli.Context, configObj config.Config, query *aws.Query, ou
 	// Signal scan complete - renderer will show found resources table
 	collector.Emit(reporting.ScanComplete{})
 
+	// Refuse to nuke more than the delete limits allow, before asking for confirmation
+	if err := aws.EnforceDeleteLimits(account, query); err != nil {
+		return err
+	}
+
 	// Confirm with user before proceeding (unless --force or --dry-run is set)
-	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0)
+	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0, confirmationWord)
 	if err != nil {
 		return err
 	}
 
 	// Execute the nuke operation if confirmed
 	if shouldProceed {
-		return aws.NukeAllResources(c.Context, account, query.Regions, collector)
+		return aws.NukeAllResources(c.Context, account, query, collector)
 	}
 
 	return nil
@@ -204,19 +358,31 @@ func generateQuery(c *cli.Context, includeUnaliasedKmsKeys bool, overridingResou
 		resourceTypes = overridingResourceTypes
 	}
 
+	trimToMaxDelete, err := parseMaxDeleteMode(c)
+	if err != nil {
+		return nil, err
+	}
+
 	// Build and return the query
 	q := &aws.Query{
-		Regions:              c.StringSlice(FlagRegion),
-		ExcludeRegions:       c.StringSlice(FlagExcludeRegion),
-		ResourceTypes:        resourceTypes,
-		ExcludeResourceTypes: c.StringSlice(FlagExcludeResourceType),
-		ExcludeAfter:         excludeAfter,
-		IncludeAfter:         includeAfter,
-		ListUnaliasedKMSKeys: includeUnaliasedKmsKeys,
-		Timeout:              timeout,
-		DefaultOnly:          onlyDefault,
-		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
-		IncludeTags:          includeTags,
+		Regions:                 c.StringSlice(FlagRegion),
+		ExcludeRegions:          c.StringSlice(FlagExcludeRegion),
+		ResourceTypes:           resourceTypes,
+		ExcludeResourceTypes:    c.StringSlice(FlagExcludeResourceType),
+		ExcludeAfter:            excludeAfter,
+		IncludeAfter:            includeAfter,
+		ListUnaliasedKMSKeys:    includeUnaliasedKmsKeys,
+		Timeout:                 timeout,
+		DefaultOnly:             onlyDefault,
+		ExcludeFirstSeen:        c.Bool(FlagExcludeFirstSeen),
+		IncludeTags:             includeTags,
+		ParallelRegions:         c.Int(FlagParallelRegions),
+		MaxPasses:               c.Int(FlagMaxPasses),
+		Verify:                  c.Bool(FlagVerify),
+		VerifyGracePeriod:       c.Duration(FlagVerifyGracePeriod),
+		MaxDelete:               c.Int(FlagMaxDelete),
+		TrimToMaxDelete:         trimToMaxDelete,
+		CircuitBreakerThreshold: c.Int(FlagCircuitBreakerThreshold),
 	}
 	if err := q.Validate(); err != nil {
 		return nil, err
@@ -229,14 +395,14 @@ func generateQuery(c *cli.Context, includeUnaliasedKmsKeys bool, overridingResou
 func handleGetResourcesWithFormat(c *cli.Context, configObj config.Config, query *aws.Query, outputFormat string, outputFile string) (
 	*aws.AwsAccountResources, error) {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupAwsReporting(outputFormat, outputFile, query)
+	collector, cleanup, err := setupAwsReporting(c.Context, outputFormat, outputFile, query, nil)
 	if err != nil {
 		return nil, err
 	}
 	defer cleanup()
 
 	// Emit scan started event with query parameters
-	collector.Emit(buildAwsScanStarted(query))
+	collector.Emit(aws.NewScanStarted(query))
 
 	// Retrieve all resources matching the query (emits ResourceFound events via collector)
 	accountResources, err := aws.GetAllResources(c.Context, query, configObj, collector)
@@ -255,18 +421,20 @@ func handleGetResourcesWithFormat(c *cli.Context, configObj config.Config, query
 
 // handleListResourceTypes displays all available AWS resource types that can be targeted.
 func handleListResourceTypes() error {
-	return printResourceTypes("AWS Resource Types", aws.ListResourceTypes())
+	return printResourceTypes("AWS Resource Types", aws.ListResourceTypes(), aws.ListResourceCategories())
 }
 
 // setupAwsReporting creates a collector and appropriate renderer for AWS operations.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
-func setupAwsReporting(outputFormat string, outputFile string, query *aws.Query) (
+// accounts lists the accounts of a multi-account run, nil otherwise.
+func setupAwsReporting(ctx context.Context, outputFormat string, outputFile string, query *aws.Query, accounts []string) (
 	*reporting.Collector, func(), error) {
 	// Build query params for JSON output
 	queryParams := &renderers.QueryParams{
 		Regions:              query.Regions,
 		ResourceTypes:        query.ResourceTypes,
 		ListUnaliasedKMSKeys: query.ListUnaliasedKMSKeys,
+		Accounts:             accounts,
 	}
 	if query.ExcludeAfter != nil && !query.ExcludeAfter.IsZero() {
 		queryParams.ExcludeAfter = query.ExcludeAfter
@@ -275,25 +443,10 @@ func setupAwsReporting(outputFormat string, outputFile string, query *aws.Query)
 		queryParams.IncludeAfter = query.IncludeAfter
 	}
 
-	return setupReporting(outputFormat, outputFile, renderers.JSONRendererConfig{
-		Command: "aws",
-		Query:   queryParams,
-		Regions: query.Regions,
+	return setupReporting(ctx, outputFormat, outputFile, renderers.JSONRendererConfig{
+		Command:  "aws",
+		Query:    queryParams,
+		Regions:  query.Regions,
+		Accounts: accounts,
 	})
 }
-
-// buildAwsScanStarted creates a ScanStarted event from an AWS query.
-func buildAwsScanStarted(query *aws.Query) reporting.ScanStarted {
-	event := reporting.ScanStarted{
-		Regions:              query.Regions,
-		ResourceTypes:        query.ResourceTypes,
-		ListUnaliasedKMSKeys: query.ListUnaliasedKMSKeys,
-	}
-	if query.ExcludeAfter != nil && !query.ExcludeAfter.IsZero() {
-		event.ExcludeAfter = query.ExcludeAfter.Format("2006-01-02 15:04:05")
-	}
-	if query.IncludeAfter != nil && !query.IncludeAfter.IsZero() {
-		event.IncludeAfter = query.IncludeAfter.Format("2006-01-02 15:04:05")
-	}
-	return event
-}
@@ -38,25 +38,45 @@ func CreateCli(version string) *cli.App {
 	app.Version = version
 	app.Usage = "A CLI tool to nuke (delete) cloud resources."
 
+	// Cancel the context of every command on SIGINT or SIGTERM, so that runs stop gracefully
+	app.Before = func(c *cli.Context) error {
+		c.Context = withInterruptHandling(c.Context)
+		return nil
+	}
+
 	// Register all available commands
 	app.Commands = []*cli.Command{
 		{
 			Name:   "aws",
 			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes AWS resources.",
-			Action: errors.WithPanicHandling(awsNuke),
+			Action: withInterruptExitCode(errors.WithPanicHandling(awsNuke)),
 			Flags: CombineFlags(
 				RegionFlags(),
 				CommonResourceTypeFlags(),
 				CommonTimeFlags(),
 				TagFlags(),
 				CommonExecutionFlags(),
+				JournalFlags(),
+				AccountFlags(),
+				DeleteLimitFlags(),
+				NukePassFlags(),
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					ProtectionTagFlag(),
+					ParallelRegionsFlag(),
 					&cli.BoolFlag{
 						Name:  FlagDeleteUnaliasedKMSKeys,
 						Usage: "Delete KMS keys that do not have aliases associated with them.",
 					},
+					&cli.StringFlag{
+						Name:  FlagPlan,
+						Usage: "Nuke exactly the resources of a plan created with inspect-aws --out-plan. Filters are not applied, and can't be combined with it. Planned resources that no longer exist are not nuked.",
+					},
+					&cli.BoolFlag{
+						Name:  FlagSkipPlanAccountCheck,
+						Usage: "Do not verify that --plan was created for the account of the current credentials.",
+					},
 					&cli.BoolFlag{
 						Name:  FlagExcludeFirstSeen,
 						Usage: "Set a flag for excluding first-seen-tag",
@@ -66,16 +86,21 @@ func CreateCli(version string) *cli.App {
 		}, {
 			Name:   "gcp",
 			Usage:  "BEWARE: DESTRUCTIVE OPERATION! Nukes GCP resources.",
-			Action: errors.WithPanicHandling(gcpNuke),
+			Action: withInterruptExitCode(errors.WithPanicHandling(gcpNuke)),
 			Flags: CombineFlags(
-				[]cli.Flag{GCPProjectFlag()},
+				GCPProjectFlags(),
 				RegionFlags(),
 				CommonResourceTypeFlags(),
 				CommonTimeFlags(),
 				CommonExecutionFlags(),
+				JournalFlags(),
+				DeleteLimitFlags(),
+				NukePassFlags(),
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					ProtectionTagFlag(),
+					ParallelRegionsFlag(),
 					&cli.BoolFlag{
 						Name:  FlagExcludeFirstSeen,
 						Usage: "Set a flag for excluding first-seen-tag",
@@ -85,15 +110,17 @@ func CreateCli(version string) *cli.App {
 		}, {
 			Name:   "inspect-gcp",
 			Usage:  "Non-destructive inspection of target GCP resources only",
-			Action: errors.WithPanicHandling(gcpInspect),
+			Action: withInterruptExitCode(errors.WithPanicHandling(gcpInspect)),
 			Flags: CombineFlags(
-				[]cli.Flag{GCPProjectFlag()},
+				GCPProjectFlags(),
 				RegionFlags(),
 				InspectResourceTypeFlags(),
 				CommonTimeFlags(),
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					ProtectionTagFlag(),
+					ParallelRegionsFlag(),
 					&cli.BoolFlag{
 						Name:  FlagExcludeFirstSeen,
 						Usage: "Set a flag for excluding first-seen-tag",
@@ -103,7 +130,7 @@ func CreateCli(version string) *cli.App {
 		}, {
 			Name:   "defaults-aws",
 			Usage:  "Nukes AWS default VPCs and permissive default security group rules.",
-			Action: errors.WithPanicHandling(awsDefaults),
+			Action: withInterruptExitCode(errors.WithPanicHandling(awsDefaults)),
 			Flags: CombineFlags(
 				RegionFlags(),
 				[]cli.Flag{
@@ -130,25 +157,47 @@ func CreateCli(version string) *cli.App {
 		}, {
 			Name:   "inspect-aws",
 			Usage:  "Non-destructive inspection of target resources only",
-			Action: errors.WithPanicHandling(awsInspect),
+			Action: withInterruptExitCode(errors.WithPanicHandling(awsInspect)),
 			Flags: CombineFlags(
 				RegionFlags(),
 				InspectResourceTypeFlags(),
 				CommonTimeFlags(),
 				TagFlags(),
+				AccountFlags(),
 				CommonOutputFlags(),
 				[]cli.Flag{
 					ConfigFlag(),
+					ProtectionTagFlag(),
+					ParallelRegionsFlag(),
 					&cli.BoolFlag{
 						Name:  FlagListUnaliasedKMSKeys,
 						Usage: "List KMS keys that do not have aliases associated with them.",
 					},
+					&cli.StringFlag{
+						Name:  FlagOutPlan,
+						Usage: "Save the nukable resources found to a plan file, which can be nuked with aws --plan.",
+					},
 					&cli.BoolFlag{
 						Name:  FlagExcludeFirstSeen,
 						Usage: "Set a flag for excluding first-seen-tag",
 					},
 				},
 			),
+		}, {
+			Name:  "config",
+			Usage: "Check config files, and export their JSON Schema",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "validate",
+					Usage:     "Report every problem of a config file, with its line",
+					ArgsUsage: "<file>",
+					Action:    errors.WithPanicHandling(configValidate),
+				}, {
+					Name:   "schema",
+					Usage:  "Print the JSON Schema of config files, for editor autocompletion",
+					Action: errors.WithPanicHandling(configJSONSchema),
+				},
+			},
 		},
 	}
 
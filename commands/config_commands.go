@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/urfave/cli/v2"
+)
+
+// configValidate prints every problem of the config file given as argument, and fails when there is any.
+func configValidate(c *cli.Context) error {
+	defer telemetry.TrackCommandLifecycle("config-validate")()
+
+	if c.NArg() != 1 {
+		return errors.WithStackTrace(fmt.Errorf("expected the path of a config file, e.g., cloud-nuke config validate config.yaml"))
+	}
+	filePath := c.Args().First()
+
+	problems, err := config.ValidateConfigFile(filePath, configSchema())
+	if err != nil {
+		return errors.WithStackTrace(ConfigFileReadError{FilePath: filePath, Underlying: err})
+	}
+	for _, problem := range problems {
+		fmt.Fprintf(c.App.Writer, "%s: %s\n", filePath, problem)
+	}
+	if len(problems) > 0 {
+		return errors.WithStackTrace(InvalidConfigError{FilePath: filePath, Problems: len(problems)})
+	}
+
+	fmt.Fprintf(c.App.Writer, "%s: no problems found\n", filePath)
+	return nil
+}
+
+// configJSONSchema prints the JSON Schema of config files.
+func configJSONSchema(c *cli.Context) error {
+	defer telemetry.TrackCommandLifecycle("config-schema")()
+
+	encoder := json.NewEncoder(c.App.Writer)
+	encoder.SetIndent("", "  ")
+	return errors.WithStackTrace(encoder.Encode(config.JSONSchema(configSchema())))
+}
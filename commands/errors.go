@@ -22,6 +22,15 @@ func (e ConfigFileReadError) Error() string {
 	return fmt.Sprintf("Error reading config file %s: %v", e.FilePath, e.Underlying)
 }
 
+type InvalidConfigError struct {
+	FilePath string
+	Problems int
+}
+
+func (e InvalidConfigError) Error() string {
+	return fmt.Sprintf("Config file %s has %d problem(s)", e.FilePath, e.Problems)
+}
+
 type InvalidDurationError struct {
 	FlagName   string
 	Value      string
@@ -65,3 +74,90 @@ type DuplicateTagKeyError struct {
 func (e DuplicateTagKeyError) Error() string {
 	return fmt.Sprintf("Duplicate tag key '%s': each tag key may only be specified once", e.Key)
 }
+
+type InterruptedError struct {
+	Signal string
+}
+
+func (e InterruptedError) Error() string {
+	return fmt.Sprintf("Interrupted by %s: results are partial", e.Signal)
+}
+
+type JournalAndResumeBothPassedError struct{}
+
+func (e JournalAndResumeBothPassedError) Error() string {
+	return "You can not specify both --journal and --resume: a resumed run keeps recording to the journal it resumes"
+}
+
+type InvalidMaxDeleteModeError struct {
+	Value string
+}
+
+func (e InvalidMaxDeleteModeError) Error() string {
+	return fmt.Sprintf("Invalid --max-delete-mode '%s': must be one of %s, %s", e.Value, MaxDeleteModeAbort, MaxDeleteModeTrim)
+}
+
+type MultiAccountFlagConflictError struct {
+	Flag string
+}
+
+func (e MultiAccountFlagConflictError) Error() string {
+	return fmt.Sprintf("You can not specify --%s with --%s or --%s: it applies to a single account", e.Flag, FlagOrgAccounts, FlagAccountIds)
+}
+
+type PlanFlagConflictError struct {
+	Flag string
+}
+
+func (e PlanFlagConflictError) Error() string {
+	return fmt.Sprintf("You can not specify --%s with --%s: plans are applied without filters", e.Flag, FlagPlan)
+}
+
+type NoAccountsSelectedError struct{}
+
+func (e NoAccountsSelectedError) Error() string {
+	return "No accounts left to run against: check --account-ids and --exclude-account-ids"
+}
+
+type NoProjectsSelectedError struct{}
+
+func (e NoProjectsSelectedError) Error() string {
+	return "No projects left to run against: check --project-id, --folder-id, --organization-id and --project-label"
+}
+
+type MultiProjectFlagConflictError struct {
+	Flag string
+}
+
+func (e MultiProjectFlagConflictError) Error() string {
+	return fmt.Sprintf("You can not specify --%s with several projects, --%s or --%s: it applies to a single project", e.Flag, FlagFolderID, FlagOrganizationID)
+}
+
+type InvalidLabelFormatError struct {
+	Value string
+}
+
+func (e InvalidLabelFormatError) Error() string {
+	return fmt.Sprintf("Invalid label format '%s': expected key=value (e.g., env=sandbox)", e.Value)
+}
+
+type DuplicateLabelKeyError struct {
+	Key string
+}
+
+func (e DuplicateLabelKeyError) Error() string {
+	return fmt.Sprintf("Duplicate label key '%s': each label key may only be specified once", e.Key)
+}
+
+type ProjectNukeError struct {
+	ProjectID  string
+	Underlying error
+}
+
+func (e ProjectNukeError) Error() string {
+	return fmt.Sprintf("Error encountered in project %s: %v", e.ProjectID, e.Underlying)
+}
+
+func (e ProjectNukeError) Unwrap() error {
+	return e.Underlying
+}
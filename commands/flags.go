@@ -1,40 +1,71 @@
 package commands
 
-import "github.com/urfave/cli/v2"
+import (
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/urfave/cli/v2"
+)
 
 // Default values
 const (
-	DefaultOutputFormat     = "table"
-	DefaultDuration         = "0s"
-	DefaultLogLevel         = "info"
-	NukeConfirmationWord    = "nuke"
-	ForceNukeCountdown      = 10
-	MaxConfirmationAttempts = 2
+	DefaultOutputFormat      = "table"
+	DefaultDuration          = "0s"
+	DefaultLogLevel          = "info"
+	DefaultParallelRegions   = 1
+	DefaultMaxPasses         = 1
+	DefaultVerifyGracePeriod = 5 * time.Minute
+	MaxDeleteModeAbort       = "abort"
+	MaxDeleteModeTrim        = "trim"
+	NukeConfirmationWord     = "nuke"
+	ForceNukeCountdown       = 10
+	MaxConfirmationAttempts  = 2
 )
 
 // Flag Names
 // These constants define all CLI flag names to avoid typos and enable refactoring
 const (
-	FlagListResourceTypes      = "list-resource-types"
-	FlagLogLevel               = "log-level"
-	FlagConfig                 = "config"
-	FlagOlderThan              = "older-than"
-	FlagNewerThan              = "newer-than"
-	FlagTimeout                = "timeout"
-	FlagDryRun                 = "dry-run"
-	FlagForce                  = "force"
-	FlagOutputFormat           = "output-format"
-	FlagOutputFile             = "output-file"
-	FlagDeleteUnaliasedKMSKeys = "delete-unaliased-kms-keys"
-	FlagListUnaliasedKMSKeys   = "list-unaliased-kms-keys"
-	FlagExcludeFirstSeen       = "exclude-first-seen"
-	FlagSGOnly                 = "sg-only"
-	FlagProjectID              = "project-id"
-	FlagResourceType           = "resource-type"
-	FlagExcludeResourceType    = "exclude-resource-type"
-	FlagRegion                 = "region"
-	FlagExcludeRegion          = "exclude-region"
-	FlagIncludeTag             = "include-tag"
+	FlagListResourceTypes       = "list-resource-types"
+	FlagLogLevel                = "log-level"
+	FlagConfig                  = "config"
+	FlagOlderThan               = "older-than"
+	FlagNewerThan               = "newer-than"
+	FlagTimeout                 = "timeout"
+	FlagDryRun                  = "dry-run"
+	FlagForce                   = "force"
+	FlagOutputFormat            = "output-format"
+	FlagOutputFile              = "output-file"
+	FlagDeleteUnaliasedKMSKeys  = "delete-unaliased-kms-keys"
+	FlagListUnaliasedKMSKeys    = "list-unaliased-kms-keys"
+	FlagExcludeFirstSeen        = "exclude-first-seen"
+	FlagSGOnly                  = "sg-only"
+	FlagProjectID               = "project-id"
+	FlagFolderID                = "folder-id"
+	FlagOrganizationID          = "organization-id"
+	FlagProjectLabel            = "project-label"
+	FlagResourceType            = "resource-type"
+	FlagExcludeResourceType     = "exclude-resource-type"
+	FlagRegion                  = "region"
+	FlagExcludeRegion           = "exclude-region"
+	FlagIncludeTag              = "include-tag"
+	FlagProtectionTag           = "protection-tag"
+	FlagParallelRegions         = "parallel-regions"
+	FlagMaxPasses               = "max-passes"
+	FlagVerify                  = "verify"
+	FlagVerifyGracePeriod       = "verify-grace-period"
+	FlagMaxDelete               = "max-delete"
+	FlagMaxDeleteMode           = "max-delete-mode"
+	FlagCircuitBreakerThreshold = "circuit-breaker-threshold"
+	FlagOutPlan                 = "out-plan"
+	FlagPlan                    = "plan"
+	FlagSkipPlanAccountCheck    = "skip-plan-account-check"
+	FlagJournal                 = "journal"
+	FlagResume                  = "resume"
+	FlagOrgAccounts             = "org-accounts"
+	FlagAccountIds              = "account-ids"
+	FlagExcludeAccountIds       = "exclude-account-ids"
+	FlagAssumeRoleName          = "assume-role-name"
 )
 
 // Common flag sets for reuse across commands
@@ -60,11 +91,11 @@ func CommonResourceTypeFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringSliceFlag{
 			Name:  FlagResourceType,
-			Usage: "Resource types to nuke. Include multiple times if more than one.",
+			Usage: "Resource types to nuke, as names, globs (e.g., ec2-*) or categories (e.g., network). Prefix with ! to leave them out. Include multiple times if more than one.",
 		},
 		&cli.StringSliceFlag{
 			Name:  FlagExcludeResourceType,
-			Usage: "Resource types to exclude from nuking. Include multiple times if more than one.",
+			Usage: "Resource types to exclude from nuking, as names, globs or categories. Include multiple times if more than one.",
 		},
 		&cli.BoolFlag{
 			Name:  FlagListResourceTypes,
@@ -78,11 +109,11 @@ func InspectResourceTypeFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringSliceFlag{
 			Name:  FlagResourceType,
-			Usage: "Resource types to inspect. Include multiple times if more than one.",
+			Usage: "Resource types to inspect, as names, globs (e.g., ec2-*) or categories (e.g., network). Prefix with ! to leave them out. Include multiple times if more than one.",
 		},
 		&cli.StringSliceFlag{
 			Name:  FlagExcludeResourceType,
-			Usage: "Resource types to exclude from inspection. Include multiple times if more than one.",
+			Usage: "Resource types to exclude from inspection, as names, globs or categories. Include multiple times if more than one.",
 		},
 		&cli.BoolFlag{
 			Name:  FlagListResourceTypes,
@@ -106,6 +137,83 @@ func CommonExecutionFlags() []cli.Flag {
 			Name:  FlagTimeout,
 			Usage: "Resource execution timeout.",
 		},
+		&cli.IntFlag{
+			Name:  FlagCircuitBreakerThreshold,
+			Usage: "Skip the remaining resources of a resource type in a region after this many consecutive failures of the same kind (e.g., missing permissions). 0 disables the circuit breaker.",
+			Value: util.DefaultCircuitBreakerThreshold,
+		},
+	}
+}
+
+// JournalFlags returns flags for recording the progress of a nuke run, and resuming it
+func JournalFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:  FlagJournal,
+			Usage: "Record the progress of the run to this journal file, so it can be continued with --resume if it is interrupted.",
+		},
+		&cli.StringFlag{
+			Name:  FlagResume,
+			Usage: "Resume the run recorded in this journal file, skipping completed regions and resources that were already nuked.",
+		},
+	}
+}
+
+// AccountFlags returns flags for running against several AWS accounts, assuming a role in each of them
+func AccountFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:  FlagOrgAccounts,
+			Usage: "Run against every active account of the AWS Organization of the current credentials, which must be those of the management account or of a delegated administrator.",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagAccountIds,
+			Usage: "AWS account IDs to run against. With --org-accounts, only these accounts of the organization. Include multiple times if more than one.",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagExcludeAccountIds,
+			Usage: "AWS account IDs to never run against. Include multiple times if more than one.",
+		},
+		&cli.StringFlag{
+			Name:  FlagAssumeRoleName,
+			Usage: "Name of the role to assume in each account, or its ARN. {account_id} is replaced by the account ID.",
+			Value: aws.DefaultAssumeRoleName,
+		},
+	}
+}
+
+// DeleteLimitFlags returns flags for capping the number of resources nuked in a run
+func DeleteLimitFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:  FlagMaxDelete,
+			Usage: "Refuse to nuke more than this number of resources. Resource types can also be capped with max_delete in the config file. 0 means no limit.",
+		},
+		&cli.StringFlag{
+			Name:  FlagMaxDeleteMode,
+			Usage: "What to do when the resources to nuke exceed a delete limit: abort the run before anything is nuked, or trim the resources to the limit and skip the rest (abort, trim)",
+			Value: MaxDeleteModeAbort,
+		},
+	}
+}
+
+// NukePassFlags returns flags for retrying failed deletions and verifying that nuked resources are gone
+func NukePassFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.IntFlag{
+			Name:  FlagMaxPasses,
+			Usage: "Maximum number of nuke passes. Resources that failed to delete are re-listed and retried in the next pass, until nothing changes.",
+			Value: DefaultMaxPasses,
+		},
+		&cli.BoolFlag{
+			Name:  FlagVerify,
+			Usage: "Once the nuke is complete, list the nuked resource types again and report the deleted resources that are still present.",
+		},
+		&cli.DurationFlag{
+			Name:  FlagVerifyGracePeriod,
+			Usage: "With --verify, how long to keep listing nuked resources that are still present before reporting them, for asynchronous deletions to complete (e.g., 10m).",
+			Value: DefaultVerifyGracePeriod,
+		},
 	}
 }
 
@@ -138,20 +246,37 @@ func ConfigFlag() cli.Flag {
 	}
 }
 
+// ProtectionTagFlag returns the flag adding tags that protect resources from being nuked
+func ProtectionTagFlag() cli.Flag {
+	return &cli.StringSliceFlag{
+		Name:  FlagProtectionTag,
+		Usage: `Protect resources with this tag from being nuked, on top of cloud-nuke-excluded=true (format: key or key=value). Value is a regex matched against the lowercased tag value; without it, any value protects. Include multiple times for several tags. Example: --protection-tag "lifecycle=^permanent$"`,
+	}
+}
+
 // RegionFlags returns region-related flags (applicable to both AWS and GCP)
 func RegionFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringSliceFlag{
 			Name:  FlagRegion,
-			Usage: "Regions to include. Include multiple times if more than one.",
+			Usage: "Regions to include, as names or globs (e.g., eu-*). Prefix with ! to leave them out. Include multiple times if more than one.",
 		},
 		&cli.StringSliceFlag{
 			Name:  FlagExcludeRegion,
-			Usage: "Regions to exclude. Include multiple times if more than one.",
+			Usage: "Regions to exclude, as names or globs. Include multiple times if more than one.",
 		},
 	}
 }
 
+// ParallelRegionsFlag returns the flag controlling how many regions are processed concurrently
+func ParallelRegionsFlag() cli.Flag {
+	return &cli.IntFlag{
+		Name:  FlagParallelRegions,
+		Usage: "Maximum number of regions to scan and nuke concurrently. Global resources are always processed on their own.",
+		Value: DefaultParallelRegions,
+	}
+}
+
 // TagFlags returns flags for tag-based filtering
 func TagFlags() []cli.Flag {
 	return []cli.Flag{
@@ -162,12 +287,25 @@ func TagFlags() []cli.Flag {
 	}
 }
 
-// GCPProjectFlag returns the GCP project ID flag
-func GCPProjectFlag() cli.Flag {
-	return &cli.StringFlag{
-		Name:     FlagProjectID,
-		Usage:    "GCP Project ID to nuke resources from.",
-		Required: true,
+// GCPProjectFlags returns flags for selecting the GCP projects to nuke resources from
+func GCPProjectFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  FlagProjectID,
+			Usage: "GCP Project ID to nuke resources from. Include multiple times if more than one.",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagFolderID,
+			Usage: "GCP folder whose projects, including those of its sub-folders, to nuke resources from. Include multiple times if more than one.",
+		},
+		&cli.StringFlag{
+			Name:  FlagOrganizationID,
+			Usage: "GCP organization whose projects to nuke resources from.",
+		},
+		&cli.StringSliceFlag{
+			Name:  FlagProjectLabel,
+			Usage: "Only include the projects of --folder-id and --organization-id with this label (format: key=value). Include multiple times for AND logic.",
+		},
 	}
 }
 
@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
+
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/nuke"
 	"github.com/gruntwork-io/cloud-nuke/renderers"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
@@ -36,13 +39,30 @@ func gcpNuke(c *cli.Context) error {
 		return errors.WithStackTrace(err)
 	}
 
+	trimToMaxDelete, err := parseMaxDeleteMode(c)
+	if err != nil {
+		return err
+	}
+
+	timeout, err := parseTimeoutDurationParam(FlagTimeout, c.String(FlagTimeout))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
 	query := &gcp.Query{
-		ProjectID:            c.String(FlagProjectID),
-		Regions:              c.StringSlice(FlagRegion),
-		ExcludeRegions:       c.StringSlice(FlagExcludeRegion),
-		ResourceTypes:        c.StringSlice(FlagResourceType),
-		ExcludeResourceTypes: c.StringSlice(FlagExcludeResourceType),
-		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
+		Regions:                 c.StringSlice(FlagRegion),
+		ExcludeRegions:          c.StringSlice(FlagExcludeRegion),
+		ResourceTypes:           c.StringSlice(FlagResourceType),
+		ExcludeResourceTypes:    c.StringSlice(FlagExcludeResourceType),
+		ExcludeFirstSeen:        c.Bool(FlagExcludeFirstSeen),
+		Timeout:                 timeout,
+		ParallelRegions:         c.Int(FlagParallelRegions),
+		MaxPasses:               c.Int(FlagMaxPasses),
+		Verify:                  c.Bool(FlagVerify),
+		VerifyGracePeriod:       c.Duration(FlagVerifyGracePeriod),
+		MaxDelete:               c.Int(FlagMaxDelete),
+		TrimToMaxDelete:         trimToMaxDelete,
+		CircuitBreakerThreshold: c.Int(FlagCircuitBreakerThreshold),
 	}
 
 	// Apply timeout to config
@@ -50,6 +70,11 @@ func gcpNuke(c *cli.Context) error {
 		return err
 	}
 
+	// Apply protection tags to config
+	if err := parseAndApplyProtectionTags(c, &configObj); err != nil {
+		return err
+	}
+
 	// Apply time filters to config
 	if err := parseAndApplyTimeFilters(c, &configObj); err != nil {
 		return err
@@ -63,6 +88,23 @@ func gcpNuke(c *cli.Context) error {
 		return err
 	}
 
+	// Run against several projects, or the projects of folders and organizations, if requested
+	selection, err := parseProjectSelection(c)
+	if err != nil {
+		return err
+	}
+	if selection.IsMultiProject() {
+		return gcpNukeProjects(c, configObj, query, selection, outputFormat, outputFile)
+	}
+	query.ProjectID = selection.ProjectIDs[0]
+
+	// Record progress to a journal, or resume from one, if requested
+	query.Journal, err = openJournal(c, "gcp/"+query.ProjectID, query.SelectedResourceTypes())
+	if err != nil {
+		return err
+	}
+	defer query.Journal.Close()
+
 	return gcpNukeHelper(c, configObj, query, outputFormat, outputFile)
 }
 
@@ -81,13 +123,19 @@ func gcpInspect(c *cli.Context) error {
 		return err
 	}
 
+	timeout, err := parseTimeoutDurationParam(FlagTimeout, c.String(FlagTimeout))
+	if err != nil {
+		return errors.WithStackTrace(err)
+	}
+
 	query := &gcp.Query{
-		ProjectID:            c.String(FlagProjectID),
 		Regions:              c.StringSlice(FlagRegion),
 		ExcludeRegions:       c.StringSlice(FlagExcludeRegion),
 		ResourceTypes:        c.StringSlice(FlagResourceType),
 		ExcludeResourceTypes: c.StringSlice(FlagExcludeResourceType),
 		ExcludeFirstSeen:     c.Bool(FlagExcludeFirstSeen),
+		Timeout:              timeout,
+		ParallelRegions:      c.Int(FlagParallelRegions),
 	}
 
 	// Load config file if provided
@@ -101,6 +149,11 @@ func gcpInspect(c *cli.Context) error {
 		return err
 	}
 
+	// Apply protection tags to config
+	if err := parseAndApplyProtectionTags(c, &configObj); err != nil {
+		return err
+	}
+
 	// Apply time filters to config
 	if err := parseAndApplyTimeFilters(c, &configObj); err != nil {
 		return err
@@ -114,6 +167,16 @@ func gcpInspect(c *cli.Context) error {
 		return err
 	}
 
+	// Inspect several projects, or the projects of folders and organizations, if requested
+	selection, err := parseProjectSelection(c)
+	if err != nil {
+		return err
+	}
+	if selection.IsMultiProject() {
+		return gcpInspectProjects(c, configObj, query, selection, outputFormat, outputFile)
+	}
+	query.ProjectID = selection.ProjectIDs[0]
+
 	// Retrieve and display resources without deleting them
 	_, err = handleGetGcpResourcesWithFormat(c, configObj, query, outputFormat, outputFile)
 	return err
@@ -123,41 +186,22 @@ func gcpInspect(c *cli.Context) error {
 // These functions contain shared logic used by multiple command handlers
 
 // gcpNukeHelper is the core logic for nuking GCP resources.
-// It retrieves resources, confirms deletion with the user, and executes the nuke operation.
+// It retrieves resources, confirms deletion with the user, and executes the nuke operation with nuke.Run.
 func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, outputFormat string, outputFile string) error {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, query.ProjectID)
+	collector, cleanup, err := setupGcpReporting(c.Context, outputFormat, outputFile, []string{query.ProjectID})
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
-	// Retrieve all matching resources (emits ResourceFound events via collector)
-	account, err := gcp.GetAllResources(c.Context, query, configObj, collector)
-	if err != nil {
-		telemetry.TrackEvent(commonTelemetry.EventContext{
-			EventName: "Error getting resources",
-		}, map[string]interface{}{})
-		return errors.WithStackTrace(err)
-	}
-
-	// Signal scan complete - renderer will show found resources table
-	collector.Emit(reporting.ScanComplete{})
-
-	// Confirm with user before proceeding (unless --force or --dry-run is set)
-	shouldProceed, err := confirmNuke(c, len(account.Resources) > 0)
-	if err != nil {
-		return err
-	}
-
-	// Execute the nuke operation if confirmed
-	if shouldProceed {
-		if err := gcp.NukeAllResources(c.Context, account, query.Regions, collector); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	_, err = nuke.Run(c.Context, nuke.Options{
+		GCP:     query,
+		Config:  configObj,
+		Emitter: collector,
+		Confirm: cliConfirmation(c, NukeConfirmationWord),
+	})
+	return errors.WithStackTrace(err)
 }
 
 // handleGetGcpResourcesWithFormat retrieves all GCP resources matching the filters and renders them
@@ -165,7 +209,7 @@ func gcpNukeHelper(c *cli.Context, configObj config.Config, query *gcp.Query, ou
 func handleGetGcpResourcesWithFormat(c *cli.Context, configObj config.Config, query *gcp.Query, outputFormat string, outputFile string) (
 	*gcp.GcpProjectResources, error) {
 	// Setup reporting - cleanup calls Complete() and closes writer
-	collector, cleanup, err := setupGcpReporting(outputFormat, outputFile, query.ProjectID)
+	collector, cleanup, err := setupGcpReporting(c.Context, outputFormat, outputFile, []string{query.ProjectID})
 	if err != nil {
 		return nil, err
 	}
@@ -188,15 +232,20 @@ func handleGetGcpResourcesWithFormat(c *cli.Context, configObj config.Config, qu
 
 // handleListGcpResourceTypes displays all available GCP resource types that can be targeted.
 func handleListGcpResourceTypes() error {
-	return printResourceTypes("GCP Resource Types", gcp.ListResourceTypes())
+	return printResourceTypes("GCP Resource Types", gcp.ListResourceTypes(), gcp.ListResourceCategories())
 }
 
 // setupGcpReporting creates a collector and appropriate renderer for GCP operations.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
-func setupGcpReporting(outputFormat string, outputFile string, projectID string) (
+// Multi-project runs are reported with the projects they ran against.
+func setupGcpReporting(ctx context.Context, outputFormat string, outputFile string, projectIDs []string) (
 	*reporting.Collector, func(), error) {
-	return setupReporting(outputFormat, outputFile, renderers.JSONRendererConfig{
+	jsonConfig := renderers.JSONRendererConfig{
 		Command: "gcp",
-		Regions: []string{projectID},
-	})
+		Regions: projectIDs,
+	}
+	if len(projectIDs) > 1 {
+		jsonConfig.Projects = projectIDs
+	}
+	return setupReporting(ctx, outputFormat, outputFile, jsonConfig)
 }
@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/go-commons/errors"
+	"github.com/hashicorp/go-multierror"
+	"github.com/urfave/cli/v2"
+)
+
+// Multi-project runs
+// These functions run the gcp and inspect-gcp commands against several projects
+
+// projectScan holds what was found in one project of a multi-project run.
+type projectScan struct {
+	query   *gcp.Query
+	project *gcp.GcpProjectResources
+}
+
+// parseProjectSelection builds the projects to run against from the CLI flags.
+// Flags that only make sense for a single project are rejected in multi-project runs.
+func parseProjectSelection(c *cli.Context) (gcp.ProjectSelection, error) {
+	labels, err := parseLabelFlags(c.StringSlice(FlagProjectLabel))
+	if err != nil {
+		return gcp.ProjectSelection{}, err
+	}
+
+	selection := gcp.ProjectSelection{
+		ProjectIDs:     c.StringSlice(FlagProjectID),
+		FolderIDs:      c.StringSlice(FlagFolderID),
+		OrganizationID: c.String(FlagOrganizationID),
+		Labels:         labels,
+	}
+	if err := selection.Validate(); err != nil {
+		return selection, errors.WithStackTrace(err)
+	}
+	if selection.IsMultiProject() {
+		for _, flag := range []string{FlagJournal, FlagResume} {
+			if c.IsSet(flag) {
+				return selection, MultiProjectFlagConflictError{Flag: flag}
+			}
+		}
+	}
+	return selection, nil
+}
+
+// resolveProjects returns the IDs of the projects selected by the flags.
+func resolveProjects(c *cli.Context, selection gcp.ProjectSelection) ([]string, error) {
+	var client gcp.ProjectsAPI
+	if len(selection.FolderIDs) > 0 || selection.OrganizationID != "" {
+		var err error
+		client, err = gcp.NewProjectsClient(c.Context)
+		if err != nil {
+			return nil, errors.WithStackTrace(err)
+		}
+	}
+
+	projectIDs, err := selection.Projects(c.Context, client)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+	if len(projectIDs) == 0 {
+		return nil, errors.WithStackTrace(NoProjectsSelectedError{})
+	}
+	logging.Infof("Running against %d projects", len(projectIDs))
+	return projectIDs, nil
+}
+
+// gcpNukeProjects scans every selected project, confirms deletion with the user once for all of them, and then
+// nukes the projects one after the other. A project that can't be scanned or nuked is reported and skipped, and
+// the run carries on with the other projects.
+func gcpNukeProjects(c *cli.Context, configObj config.Config, query *gcp.Query, selection gcp.ProjectSelection, outputFormat string, outputFile string) error {
+	projectIDs, err := resolveProjects(c, selection)
+	if err != nil {
+		return err
+	}
+
+	collector, cleanup, err := setupGcpReporting(c.Context, outputFormat, outputFile, projectIDs)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	collector.Emit(gcp.NewScanStarted(query, projectIDs))
+	scans, allErrs := scanProjects(c, configObj, query, projectIDs, collector)
+	collector.Emit(reporting.ScanComplete{})
+
+	// Refuse to nuke more than the delete limits of any project allow, or more than --max-delete across all of them,
+	// before asking for confirmation
+	projects := make([]*gcp.GcpProjectResources, 0, len(scans))
+	for _, scan := range scans {
+		if err := gcp.EnforceDeleteLimits(scan.project, scan.query, configObj); err != nil {
+			return ProjectNukeError{ProjectID: scan.query.ProjectID, Underlying: err}
+		}
+		projects = append(projects, scan.project)
+	}
+	if err := gcp.EnforceTotalDeleteLimit(projects, query); err != nil {
+		return err
+	}
+	total := 0
+	for _, project := range projects {
+		total += project.TotalResourceCount()
+	}
+
+	shouldProceed, err := confirmNuke(c, total > 0, NukeConfirmationWord)
+	if err != nil {
+		return err
+	}
+	if !shouldProceed {
+		return allErrs.ErrorOrNil()
+	}
+
+	collector.Emit(reporting.NukeStarted{Total: total})
+	for _, scan := range scans {
+		if c.Context.Err() != nil {
+			break
+		}
+		emitter := reporting.ProjectEmitter{Project: scan.query.ProjectID, Emitter: collector}
+		if err := gcp.NukeAllResources(c.Context, scan.project, scan.query, emitter); err != nil {
+			allErrs = multierror.Append(allErrs, ProjectNukeError{ProjectID: scan.query.ProjectID, Underlying: err})
+		}
+	}
+	collector.Emit(reporting.NukeComplete{})
+
+	return allErrs.ErrorOrNil()
+}
+
+// gcpInspectProjects lists the resources of every selected project without deleting them.
+func gcpInspectProjects(c *cli.Context, configObj config.Config, query *gcp.Query, selection gcp.ProjectSelection, outputFormat string, outputFile string) error {
+	projectIDs, err := resolveProjects(c, selection)
+	if err != nil {
+		return err
+	}
+
+	collector, cleanup, err := setupGcpReporting(c.Context, outputFormat, outputFile, projectIDs)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	collector.Emit(gcp.NewScanStarted(query, projectIDs))
+	_, allErrs := scanProjects(c, configObj, query, projectIDs, collector)
+	collector.Emit(reporting.ScanComplete{})
+
+	return allErrs.ErrorOrNil()
+}
+
+// scanProjects scans the projects one after the other, with a copy of the query for each of them. Projects that
+// can't be scanned are reported as general errors and left out of the returned scans.
+func scanProjects(c *cli.Context, configObj config.Config, query *gcp.Query, projectIDs []string,
+	collector *reporting.Collector) ([]projectScan, *multierror.Error) {
+	var scans []projectScan
+	var allErrs *multierror.Error
+
+	for _, projectID := range projectIDs {
+		if c.Context.Err() != nil {
+			break
+		}
+		emitter := reporting.ProjectEmitter{Project: projectID, Emitter: collector}
+
+		projectQuery := *query
+		projectQuery.ProjectID = projectID
+		project, err := gcp.GetAllResources(c.Context, &projectQuery, configObj, emitter)
+		if err != nil {
+			logging.Errorf("Unable to scan project %s: %v", projectID, err)
+			emitter.Emit(reporting.GeneralError{
+				Description: "Unable to scan project",
+				Error:       err.Error(),
+			})
+			allErrs = multierror.Append(allErrs, ProjectNukeError{ProjectID: projectID, Underlying: err})
+			continue
+		}
+		scans = append(scans, projectScan{query: &projectQuery, project: project})
+	}
+
+	return scans, allErrs
+}
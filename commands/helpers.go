@@ -1,20 +1,32 @@
 package commands
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 	"time"
 
+	"github.com/gruntwork-io/cloud-nuke/aws"
 	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/journal"
 	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/nuke"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"github.com/gruntwork-io/go-commons/errors"
 	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
 	"github.com/urfave/cli/v2"
 )
 
-// loadConfigFile loads and parses a config file from the given path
+// configSchema returns the sections of the resource types of every cloud.
+func configSchema() config.Schema {
+	return append(aws.ConfigSchema(), gcp.ConfigSchema()...)
+}
+
+// loadConfigFile loads and parses a config file from the given path. Resource types can be configured by the names
+// accepted by --resource-type, and sections that don't belong to a known resource type are rejected.
 func loadConfigFile(configFilePath string) (config.Config, error) {
 	if configFilePath == "" {
 		return config.Config{}, nil
@@ -24,7 +36,7 @@ func loadConfigFile(configFilePath string) (config.Config, error) {
 		EventName: "Reading config file",
 	}, map[string]interface{}{})
 
-	configObjPtr, err := config.GetConfig(configFilePath)
+	configObjPtr, err := config.GetConfigWithSchema(configFilePath, configSchema())
 	if err != nil {
 		telemetry.TrackEvent(commonTelemetry.EventContext{
 			EventName: "Error reading config file",
@@ -53,6 +65,37 @@ func parseAndApplyTimeFilters(c *cli.Context, configObj *config.Config) error {
 	return nil
 }
 
+// openJournal creates the journal requested by --journal, or loads the one to resume from with --resume.
+// Returns nil if journaling was not requested. The scope identifies the account or project being nuked, and
+// resourceTypes the resource types selected to nuke, so a journal is never resumed against another run.
+func openJournal(c *cli.Context, scope string, resourceTypes []string) (*journal.Journal, error) {
+	journalFile := c.String(FlagJournal)
+	resumeFile := c.String(FlagResume)
+
+	switch {
+	case journalFile != "" && resumeFile != "":
+		return nil, errors.WithStackTrace(JournalAndResumeBothPassedError{})
+	case resumeFile != "":
+		return journal.Resume(resumeFile, scope, resourceTypes)
+	case journalFile != "":
+		return journal.Create(journalFile, scope, resourceTypes)
+	}
+	return nil, nil
+}
+
+// parseMaxDeleteMode returns true if --max-delete-mode asks to trim the resources to nuke to the delete limits,
+// rather than aborting the run.
+func parseMaxDeleteMode(c *cli.Context) (bool, error) {
+	switch mode := c.String(FlagMaxDeleteMode); mode {
+	case "", MaxDeleteModeAbort:
+		return false, nil
+	case MaxDeleteModeTrim:
+		return true, nil
+	default:
+		return false, errors.WithStackTrace(InvalidMaxDeleteModeError{Value: mode})
+	}
+}
+
 // parseAndApplyTimeout parses the timeout flag and applies it to the config
 func parseAndApplyTimeout(c *cli.Context, configObj *config.Config) error {
 	timeout, err := parseTimeoutDurationParam(FlagTimeout, c.String(FlagTimeout))
@@ -67,9 +110,48 @@ func parseAndApplyTimeout(c *cli.Context, configObj *config.Config) error {
 	return nil
 }
 
+// parseAndApplyProtectionTags parses the protection tag flags and applies them to the config
+func parseAndApplyProtectionTags(c *cli.Context, configObj *config.Config) error {
+	tags, err := parseProtectionTagFlags(c.StringSlice(FlagProtectionTag))
+	if err != nil {
+		return err
+	}
+
+	configObj.AddProtectionTags(tags)
+	return nil
+}
+
+// parseProtectionTagFlags parses --protection-tag flag values (format: key or key=value). The value portion is
+// treated as a regex pattern, and tags without a value protect resources whatever their value.
+func parseProtectionTagFlags(tagValues []string) ([]config.ProtectionTag, error) {
+	var tags []config.ProtectionTag
+	for _, tagValue := range tagValues {
+		key, value, hasValue := strings.Cut(tagValue, "=")
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		if key == "" || (hasValue && value == "") {
+			return nil, errors.WithStackTrace(InvalidTagFormatError{Value: tagValue})
+		}
+
+		tag := config.ProtectionTag{Key: key}
+		if hasValue {
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, errors.WithStackTrace(InvalidTagRegexError{Value: tagValue, Underlying: err})
+			}
+			tag.Value = &config.Expression{RE: *re}
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
 // confirmNuke handles the nuke confirmation prompt and countdown
 // Returns true if the nuke should proceed, false otherwise
-func confirmNuke(c *cli.Context, hasResources bool) (bool, error) {
+// confirmationWord is the word to type at the prompt, see awsConfirmationWord.
+func confirmNuke(c *cli.Context, hasResources bool, confirmationWord string) (bool, error) {
 	if !hasResources {
 		telemetry.TrackEvent(commonTelemetry.EventContext{
 			EventName: "No resources to nuke",
@@ -92,9 +174,9 @@ func confirmNuke(c *cli.Context, hasResources bool) (bool, error) {
 		}, map[string]interface{}{})
 
 		promptMessage := fmt.Sprintf("\nAre you sure you want to nuke all listed resources? Enter '%s' to confirm (or exit with ^C) ",
-			NukeConfirmationWord)
+			confirmationWord)
 
-		proceed, err := renderNukeConfirmationPrompt(promptMessage, MaxConfirmationAttempts)
+		proceed, err := renderNukeConfirmationPrompt(c.Context, promptMessage, confirmationWord, MaxConfirmationAttempts)
 		if err != nil {
 			telemetry.TrackEvent(commonTelemetry.EventContext{
 				EventName: "Error confirming nuke",
@@ -123,13 +205,26 @@ func confirmNuke(c *cli.Context, hasResources bool) (bool, error) {
 
 	for i := ForceNukeCountdown; i > 0; i-- {
 		fmt.Printf("%d...", i)
-		time.Sleep(1 * time.Second)
+		select {
+		case <-c.Context.Done():
+			fmt.Println()
+			logging.Info("Nuke cancelled before it started.")
+			return false, nil
+		case <-time.After(1 * time.Second):
+		}
 	}
 	fmt.Println()
 
 	return true, nil
 }
 
+// cliConfirmation confirms the nuke of a nuke.Run with confirmNuke.
+func cliConfirmation(c *cli.Context, confirmationWord string) nuke.ConfirmFunc {
+	return func(_ context.Context, found []reporting.ResourceFound) (bool, error) {
+		return confirmNuke(c, len(found) > 0, confirmationWord)
+	}
+}
+
 // parseLogLevel parses and sets the log level from CLI context
 func parseLogLevel(c *cli.Context) error {
 	logLevel := c.String(FlagLogLevel)
@@ -169,6 +264,27 @@ func parseDurationParam(flagName string, paramValue string) (*time.Time, error)
 	return &excludeAfter, nil
 }
 
+// parseLabelFlags parses key=value label filters. Unlike tag filters, label values are matched exactly.
+func parseLabelFlags(labelValues []string) (map[string]string, error) {
+	if len(labelValues) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	labels := make(map[string]string, len(labelValues))
+	for _, labelValue := range labelValues {
+		key, value, ok := strings.Cut(labelValue, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, errors.WithStackTrace(InvalidLabelFormatError{Value: labelValue})
+		}
+		if _, exists := labels[key]; exists {
+			return nil, errors.WithStackTrace(DuplicateLabelKeyError{Key: key})
+		}
+		labels[key] = strings.TrimSpace(value)
+	}
+	return labels, nil
+}
+
 // parseTagFlags parses --include-tag flag values (format: key=value) into a map
 // of tag key to compiled regex Expression. The value portion is treated as a regex pattern.
 // Returns nil if no tags are provided.
@@ -1,10 +1,22 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/aws/resources"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
 )
 
 func TestParseTagFlags(t *testing.T) {
@@ -83,3 +95,248 @@ func TestParseTagFlags(t *testing.T) {
 		assert.False(t, expr.RE.MatchString("dev-staging"), "anchored regex should not match partial")
 	})
 }
+
+func TestParseProtectionTagFlags(t *testing.T) {
+	tags, err := parseProtectionTagFlags(nil)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	tags, err = parseProtectionTagFlags([]string{"do-not-delete", " lifecycle = ^permanent$ "})
+	require.NoError(t, err)
+	require.Len(t, tags, 2)
+	assert.Equal(t, config.ProtectionTag{Key: "do-not-delete"}, tags[0])
+	assert.Equal(t, "lifecycle", tags[1].Key)
+	assert.Equal(t, "^permanent$", tags[1].Value.RE.String())
+
+	for _, input := range []string{"=permanent", "lifecycle=", ""} {
+		_, err := parseProtectionTagFlags([]string{input})
+		assert.ErrorContains(t, err, "Invalid tag format", input)
+	}
+
+	_, err = parseProtectionTagFlags([]string{"lifecycle=[invalid"})
+	assert.ErrorContains(t, err, "Invalid regex")
+}
+
+func TestParseMaxDeleteMode(t *testing.T) {
+	newContext := func(mode string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String(FlagMaxDeleteMode, mode, "")
+		return cli.NewContext(cli.NewApp(), set, nil)
+	}
+
+	trim, err := parseMaxDeleteMode(newContext(MaxDeleteModeAbort))
+	require.NoError(t, err)
+	assert.False(t, trim)
+
+	trim, err = parseMaxDeleteMode(newContext(MaxDeleteModeTrim))
+	require.NoError(t, err)
+	assert.True(t, trim)
+
+	_, err = parseMaxDeleteMode(newContext("ignore"))
+	var modeErr InvalidMaxDeleteModeError
+	require.ErrorAs(t, err, &modeErr)
+	assert.Equal(t, "ignore", modeErr.Value)
+}
+
+func TestParseAccountSelection(t *testing.T) {
+	newContext := func(accountIds []string, plan string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.Bool(FlagOrgAccounts, false, "")
+		set.Var(cli.NewStringSlice(accountIds...), FlagAccountIds, "")
+		set.Var(cli.NewStringSlice(), FlagExcludeAccountIds, "")
+		set.String(FlagAssumeRoleName, aws.DefaultAssumeRoleName, "")
+		set.String(FlagPlan, "", "")
+		if plan != "" {
+			require.NoError(t, set.Set(FlagPlan, plan))
+		}
+		return cli.NewContext(cli.NewApp(), set, nil)
+	}
+
+	selection, err := parseAccountSelection(newContext(nil, "plan.json"))
+	require.NoError(t, err, "single-account runs accept every flag")
+	assert.False(t, selection.IsMultiAccount())
+
+	selection, err = parseAccountSelection(newContext([]string{"111111111111"}, ""))
+	require.NoError(t, err)
+	assert.True(t, selection.IsMultiAccount())
+	assert.Equal(t, "arn:aws:iam::111111111111:role/OrganizationAccountAccessRole", selection.RoleArn("111111111111"))
+
+	_, err = parseAccountSelection(newContext([]string{"111111111111"}, "plan.json"))
+	var conflictErr MultiAccountFlagConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, FlagPlan, conflictErr.Flag)
+
+	_, err = parseAccountSelection(newContext([]string{"sandbox"}, ""))
+	var accountErr aws.InvalidAccountIdError
+	require.ErrorAs(t, err, &accountErr)
+}
+
+func TestRejectPlanFilterFlags(t *testing.T) {
+	newContext := func(plan string, regions ...string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.String(FlagPlan, "", "")
+		set.Var(cli.NewStringSlice(), FlagRegion, "")
+		set.String(FlagOlderThan, "", "")
+		set.Int(FlagMaxPasses, DefaultMaxPasses, "")
+		set.Var(cli.NewStringSlice(), FlagProtectionTag, "")
+		if plan != "" {
+			require.NoError(t, set.Set(FlagPlan, plan))
+		}
+		for _, region := range regions {
+			require.NoError(t, set.Set(FlagRegion, region))
+		}
+		require.NoError(t, set.Set(FlagMaxPasses, "3"))
+		require.NoError(t, set.Set(FlagProtectionTag, "do-not-delete"))
+		return cli.NewContext(cli.NewApp(), set, nil)
+	}
+
+	require.NoError(t, rejectPlanFilterFlags(newContext("", "us-east-1")), "filters apply without a plan")
+	require.NoError(t, rejectPlanFilterFlags(newContext("plan.json")), "execution settings and protection tags apply to plans")
+
+	err := rejectPlanFilterFlags(newContext("plan.json", "us-east-1"))
+	var conflictErr PlanFlagConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, FlagRegion, conflictErr.Flag)
+}
+
+func TestResolveAccounts_RefusesAccountsNotAllowed(t *testing.T) {
+	c := cli.NewContext(cli.NewApp(), flag.NewFlagSet("test", flag.ContinueOnError), nil)
+	selection := aws.AccountSelection{AccountIds: []string{"111111111111", "222222222222"}}
+
+	accountIds, err := resolveAccounts(c, selection, config.Accounts{Allow: []string{"111111111111", "222222222222"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"111111111111", "222222222222"}, accountIds)
+
+	_, err = resolveAccounts(c, selection, config.Accounts{Block: []string{"222222222222"}})
+	var notAllowed aws.AccountNotAllowedError
+	require.ErrorAs(t, err, &notAllowed)
+	assert.Equal(t, "222222222222", notAllowed.AccountId)
+}
+
+func TestAccountsConfirmationWords(t *testing.T) {
+	withResources := func(ids ...string) *aws.AwsAccountResources {
+		res := resources.NewAwsResource(resourcetest.NewFake("widget", ids...).Listed(t, config.Config{}))
+		return &aws.AwsAccountResources{Resources: map[string]aws.AwsResources{
+			"us-east-1": {Resources: []*resources.AwsResource{&res}},
+		}}
+	}
+	scans := []accountScan{
+		{accountId: "111111111111", account: withResources("a")},
+		{accountId: "222222222222", account: withResources("b")},
+		{accountId: "333333333333", account: withResources()},
+		{accountId: "444444444444", account: withResources("c")},
+	}
+
+	accounts := config.Accounts{Aliases: map[string]string{
+		"111111111111": "sandbox",
+		"222222222222": "staging",
+		"333333333333": "production",
+	}}
+	assert.Equal(t, []string{"sandbox", "staging"}, accountsConfirmationWords(scans, accounts))
+	assert.Equal(t, []string{NukeConfirmationWord}, accountsConfirmationWords(scans, config.Accounts{}))
+}
+
+func TestParseLabelFlags(t *testing.T) {
+	labels, err := parseLabelFlags([]string{"env=sandbox", "team = platform", "ephemeral="})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"env": "sandbox", "team": "platform", "ephemeral": ""}, labels)
+
+	_, err = parseLabelFlags([]string{"env"})
+	var formatErr InvalidLabelFormatError
+	require.ErrorAs(t, err, &formatErr)
+
+	_, err = parseLabelFlags([]string{"env=a", "env=b"})
+	var duplicateErr DuplicateLabelKeyError
+	require.ErrorAs(t, err, &duplicateErr)
+	assert.Equal(t, "env", duplicateErr.Key)
+}
+
+func TestParseProjectSelection(t *testing.T) {
+	newContext := func(projectIDs []string, journal string) *cli.Context {
+		set := flag.NewFlagSet("test", flag.ContinueOnError)
+		set.Var(cli.NewStringSlice(projectIDs...), FlagProjectID, "")
+		set.Var(cli.NewStringSlice(), FlagFolderID, "")
+		set.String(FlagOrganizationID, "", "")
+		set.Var(cli.NewStringSlice(), FlagProjectLabel, "")
+		set.String(FlagJournal, "", "")
+		if journal != "" {
+			require.NoError(t, set.Set(FlagJournal, journal))
+		}
+		return cli.NewContext(cli.NewApp(), set, nil)
+	}
+
+	selection, err := parseProjectSelection(newContext([]string{"sandbox"}, "journal.jsonl"))
+	require.NoError(t, err, "single-project runs can be journaled")
+	assert.False(t, selection.IsMultiProject())
+
+	_, err = parseProjectSelection(newContext([]string{"sandbox-a", "sandbox-b"}, "journal.jsonl"))
+	var conflictErr MultiProjectFlagConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, FlagJournal, conflictErr.Flag)
+
+	_, err = parseProjectSelection(newContext(nil, ""))
+	assert.Error(t, err, "a project, folder or organization is required")
+}
+
+func TestResolveProjects_RefusesNoProjects(t *testing.T) {
+	c := cli.NewContext(cli.NewApp(), flag.NewFlagSet("test", flag.ContinueOnError), nil)
+
+	projectIDs, err := resolveProjects(c, gcp.ProjectSelection{ProjectIDs: []string{"sandbox", "staging"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sandbox", "staging"}, projectIDs)
+
+	_, err = resolveProjects(c, gcp.ProjectSelection{})
+	require.ErrorAs(t, err, &NoProjectsSelectedError{})
+}
+
+func TestLoadConfigFile_RegisteredNames(t *testing.T) {
+	t.Setenv("DISABLE_TELEMETRY", "true")
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("ec2-keypairs:\n  timeout: 5m\ngcs-bucket:\n  timeout: 1m\nS3:\n  timeout: 2m\n"), 0600))
+
+	configObj, err := loadConfigFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "5m", configObj.EC2KeyPairs.Timeout)
+	assert.Equal(t, "1m", configObj.GCSBucket.Timeout)
+	assert.Equal(t, "2m", configObj.S3.Timeout)
+
+	require.NoError(t, os.WriteFile(path, []byte("ec2-keypair:\n  timeout: 5m\n"), 0600))
+	_, err = loadConfigFile(path)
+	var readErr ConfigFileReadError
+	require.ErrorAs(t, err, &readErr)
+
+	// The config of the scheduled nuke keeps loading with the YAML keys of Config
+	_, err = loadConfigFile("../.github/nuke_config.yml")
+	require.NoError(t, err)
+}
+
+func TestConfigCommands(t *testing.T) {
+	t.Setenv("DISABLE_TELEMETRY", "true")
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("s3:\n  include:\n    names_regex:\n      - \"([\"\n"), 0600))
+
+	var out bytes.Buffer
+	app := CreateCli("test-version")
+	app.Writer = &out
+
+	err := app.Run([]string{"cloud-nuke", "config", "validate", path})
+	var invalidErr InvalidConfigError
+	require.ErrorAs(t, err, &invalidErr)
+	assert.Equal(t, 1, invalidErr.Problems)
+	assert.Contains(t, out.String(), "line 4: s3.include.names_regex[0]: invalid regular expression")
+
+	out.Reset()
+	require.NoError(t, app.Run([]string{"cloud-nuke", "config", "validate", "../.github/nuke_config.yml"}))
+	assert.Contains(t, out.String(), "no problems found")
+
+	out.Reset()
+	require.NoError(t, app.Run([]string{"cloud-nuke", "config", "schema"}))
+	var jsonSchema map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &jsonSchema))
+	assert.Contains(t, jsonSchema["properties"], "ec2-keypairs")
+	assert.Contains(t, jsonSchema["properties"], "gcs-bucket")
+}
@@ -1,6 +1,10 @@
 package commands
 
 import (
+	"bufio"
+	"context"
+	"io"
+	"os"
 	"strings"
 
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -8,33 +12,83 @@ import (
 	"github.com/pterm/pterm"
 )
 
+// promptInput is where the answers to the confirmation prompt are read from.
+var promptInput io.Reader = os.Stdin
+
+// promptAnswer is a line read from promptInput, or the error that ended the input.
+type promptAnswer struct {
+	text string
+	err  error
+}
+
 // renderNukeConfirmationPrompt displays a confirmation prompt before nuking resources.
-// Returns true if the user confirms, false otherwise.
-func renderNukeConfirmationPrompt(prompt string, numRetryCount int) (bool, error) {
+// Returns true if the user confirms, false otherwise. The prompt stops waiting for an answer once ctx is
+// cancelled, e.g., on ^C, and returns the cause of the cancellation.
+func renderNukeConfirmationPrompt(ctx context.Context, prompt string, confirmationWord string, numRetryCount int) (bool, error) {
 	prompts := 0
 
 	pterm.Println()
 	pterm.Warning.Println("THE NEXT STEPS ARE DESTRUCTIVE AND COMPLETELY IRREVERSIBLE, PROCEED WITH CAUTION!!!")
 
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	answers := readPromptAnswers(ctx, promptInput)
+
 	for prompts < numRetryCount {
-		confirmPrompt := pterm.DefaultInteractiveTextInput.WithMultiLine(false)
-		input, err := confirmPrompt.Show(prompt)
-		if err != nil {
-			logging.Errorf("[Failed to render prompt] %s", err)
-			return false, errors.WithStackTrace(err)
+		pterm.Print(pterm.DefaultInteractiveTextInput.TextStyle.Sprintf("%s: ", prompt))
+
+		var answer promptAnswer
+		select {
+		case <-ctx.Done():
+			pterm.Println()
+			return false, errors.WithStackTrace(context.Cause(ctx))
+		case answer = <-answers:
+		}
+		if answer.err != nil {
+			logging.Errorf("[Failed to render prompt] %s", answer.err)
+			return false, errors.WithStackTrace(answer.err)
 		}
 
-		response := strings.ToLower(strings.TrimSpace(input))
-		if response == "nuke" {
+		if strings.EqualFold(strings.TrimSpace(answer.text), confirmationWord) {
 			pterm.Println()
 			return true, nil
 		}
 
 		pterm.Println()
-		pterm.Error.Printfln("Invalid value was entered: %s. Try again.", input)
+		pterm.Error.Printfln("Invalid value was entered: %s. Try again.", answer.text)
 		prompts++
 	}
 
 	pterm.Println()
 	return false, nil
 }
+
+// readPromptAnswers reads the lines of input in the background, until the input ends or ctx is cancelled.
+func readPromptAnswers(ctx context.Context, input io.Reader) <-chan promptAnswer {
+	answers := make(chan promptAnswer)
+
+	go func() {
+		send := func(answer promptAnswer) bool {
+			select {
+			case answers <- answer:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		scanner := bufio.NewScanner(input)
+		for scanner.Scan() {
+			if !send(promptAnswer{text: scanner.Text()}) {
+				return
+			}
+		}
+		err := scanner.Err()
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+		send(promptAnswer{err: err})
+	}()
+
+	return answers
+}
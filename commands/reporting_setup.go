@@ -1,16 +1,23 @@
 package commands
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
 	"github.com/gruntwork-io/cloud-nuke/logging"
 	"github.com/gruntwork-io/cloud-nuke/renderers"
 	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/pterm/pterm"
 )
 
 // setupReporting creates a collector and appropriate renderer based on output format.
 // Returns the collector, cleanup function (which calls Complete() and closes writer), and any error.
 // The jsonConfig is used when outputFormat is "json"; ignored otherwise.
-func setupReporting(outputFormat string, outputFile string, jsonConfig renderers.JSONRendererConfig) (
+// If ctx is interrupted by a signal, renderers are notified so the results they flush are marked as partial.
+func setupReporting(ctx context.Context, outputFormat string, outputFile string, jsonConfig renderers.JSONRendererConfig) (
 	*reporting.Collector, func(), error) {
 	writer, writerCleanup, err := renderers.GetOutputWriter(outputFile)
 	if err != nil {
@@ -19,8 +26,21 @@ func setupReporting(outputFormat string, outputFile string, jsonConfig renderers
 
 	collector := reporting.NewCollector()
 
+	// Renderers are notified as soon as the run is interrupted, and at the latest before they flush their output
+	var notifyOnce sync.Once
+	emitInterrupted := func() {
+		notifyOnce.Do(func() {
+			if interrupted, ok := interruption(ctx); ok {
+				collector.Emit(reporting.Interrupted{Reason: interrupted.Error()})
+			}
+		})
+	}
+	stopInterruptNotification := context.AfterFunc(ctx, emitInterrupted)
+
 	// Combined cleanup: mark collector closed then close writer
 	cleanup := func() {
+		stopInterruptNotification()
+		emitInterrupted()
 		collector.Complete()
 		if err := writerCleanup(); err != nil {
 			logging.Errorf("Failed to close output writer: %v", err)
@@ -37,15 +57,38 @@ func setupReporting(outputFormat string, outputFile string, jsonConfig renderers
 	return collector, cleanup, nil
 }
 
-// printResourceTypes prints a list of resource types with a section header.
+// printResourceTypes prints a list of resource types with a section header, followed by the categories that
+// group them. Categories and globs of resource types can be passed to --resource-type like resource types.
 // This is a simple helper that doesn't use the event-driven pattern.
-func printResourceTypes(sectionTitle string, resourceTypes []string) error {
+func printResourceTypes(sectionTitle string, resourceTypes []string, categories map[string][]string) error {
 	pterm.DefaultSection.WithTopPadding(1).WithBottomPadding(0).Println(sectionTitle)
 
+	categoriesOf := make(map[string][]string)
+	for _, category := range resource.AllCategories {
+		for _, resourceType := range categories[category] {
+			categoriesOf[resourceType] = append(categoriesOf[resourceType], category)
+		}
+	}
+
 	var items []pterm.BulletListItem
 	for _, resourceType := range resourceTypes {
-		items = append(items, pterm.BulletListItem{Level: 0, Text: resourceType})
+		text := resourceType
+		if len(categoriesOf[resourceType]) > 0 {
+			text = fmt.Sprintf("%s (%s)", resourceType, strings.Join(categoriesOf[resourceType], ", "))
+		}
+		items = append(items, pterm.BulletListItem{Level: 0, Text: text})
+	}
+	if err := pterm.DefaultBulletList.WithItems(items).Render(); err != nil {
+		return err
 	}
 
+	pterm.DefaultSection.WithTopPadding(1).WithBottomPadding(0).Println("Categories")
+
+	items = nil
+	for _, category := range resource.AllCategories {
+		if len(categories[category]) > 0 {
+			items = append(items, pterm.BulletListItem{Level: 0, Text: fmt.Sprintf("%s (%d resource types)", category, len(categories[category]))})
+		}
+	}
 	return pterm.DefaultBulletList.WithItems(items).Render()
 }
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	goerrors "github.com/gruntwork-io/go-commons/errors"
+	"github.com/urfave/cli/v2"
+)
+
+// ExitCodeInterrupted is the exit code of a run interrupted by SIGINT or SIGTERM (128 + SIGINT).
+const ExitCodeInterrupted = 130
+
+// withInterruptHandling returns a context that is cancelled, with an InterruptedError as its cause, on the
+// first SIGINT or SIGTERM. In-flight API calls are aborted through the context and no new batches are started.
+// Default signal handling is then restored, so a second signal terminates the process immediately.
+func withInterruptHandling(ctx context.Context) context.Context {
+	ctx, cancel := context.WithCancelCause(ctx)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case sig := <-signals:
+			signal.Stop(signals)
+			logging.Warnf("Received %s, stopping after in-flight operations. Send the signal again to exit immediately.", sig)
+			cancel(InterruptedError{Signal: sig.String()})
+		case <-ctx.Done():
+			signal.Stop(signals)
+		}
+	}()
+
+	return ctx
+}
+
+// interruption returns the InterruptedError that cancelled ctx, if it was cancelled by a signal.
+func interruption(ctx context.Context) (InterruptedError, bool) {
+	var interrupted InterruptedError
+	ok := errors.As(context.Cause(ctx), &interrupted)
+	return interrupted, ok
+}
+
+// withInterruptExitCode wraps a command action so that runs interrupted by a signal exit with
+// ExitCodeInterrupted, regardless of the error returned by the action.
+func withInterruptExitCode(action cli.ActionFunc) cli.ActionFunc {
+	return func(c *cli.Context) error {
+		err := action(c)
+		if interrupted, ok := interruption(c.Context); ok {
+			return goerrors.ErrorWithExitCode{Err: interrupted, ExitCode: ExitCodeInterrupted}
+		}
+		return err
+	}
+}
@@ -0,0 +1,103 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/renderers"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	goerrors "github.com/gruntwork-io/go-commons/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestWithInterruptExitCode(t *testing.T) {
+	actionErr := errors.New("nuke failed")
+	action := withInterruptExitCode(func(c *cli.Context) error { return actionErr })
+
+	t.Run("not interrupted", func(t *testing.T) {
+		err := action(&cli.Context{Context: context.Background()})
+		assert.Equal(t, actionErr, err)
+	})
+
+	t.Run("interrupted", func(t *testing.T) {
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(InterruptedError{Signal: syscall.SIGTERM.String()})
+
+		err := action(&cli.Context{Context: ctx})
+		var exitErr goerrors.ErrorWithExitCode
+		require.True(t, errors.As(err, &exitErr))
+		assert.Equal(t, ExitCodeInterrupted, exitErr.ExitCode)
+		assert.Contains(t, exitErr.Error(), "terminated")
+	})
+
+	t.Run("cancelled without a signal", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := action(&cli.Context{Context: ctx})
+		assert.Equal(t, actionErr, err)
+	})
+}
+
+func TestSetupReporting_MarksInterruptedOutput(t *testing.T) {
+	outputFile := t.TempDir() + "/out.json"
+	ctx, cancel := context.WithCancelCause(context.Background())
+
+	collector, cleanup, err := setupReporting(ctx, "json", outputFile, renderers.JSONRendererConfig{Command: "inspect-aws"})
+	require.NoError(t, err)
+
+	cancel(InterruptedError{Signal: "interrupt"})
+	cleanup()
+	// Nothing is emitted once the output has been flushed
+	collector.Emit(reporting.Interrupted{Reason: "late"})
+
+	output, err := os.ReadFile(outputFile)
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"interrupted": true`)
+}
+
+func TestRenderNukeConfirmationPrompt_Interrupted(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	defer reader.Close()
+	defer writer.Close()
+
+	original := promptInput
+	promptInput = reader
+	defer func() { promptInput = original }()
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(InterruptedError{Signal: syscall.SIGINT.String()})
+
+	// Nothing is ever typed, so only the interruption can end the prompt
+	proceed, err := renderNukeConfirmationPrompt(ctx, "Are you sure you want to nuke all listed resources? Enter 'nuke' to confirm (or exit with ^C)", "nuke", 2)
+	assert.False(t, proceed)
+	var interrupted InterruptedError
+	require.True(t, errors.As(err, &interrupted))
+
+	err = withInterruptExitCode(func(c *cli.Context) error { return err })(&cli.Context{Context: ctx})
+	var exitErr goerrors.ErrorWithExitCode
+	require.True(t, errors.As(err, &exitErr))
+	assert.Equal(t, ExitCodeInterrupted, exitErr.ExitCode)
+}
+
+func TestRenderNukeConfirmationPrompt_Answers(t *testing.T) {
+	original := promptInput
+	defer func() { promptInput = original }()
+
+	promptInput = strings.NewReader("no\nNUKE\n")
+	proceed, err := renderNukeConfirmationPrompt(context.Background(), "Enter 'nuke' to confirm", "nuke", 2)
+	require.NoError(t, err)
+	assert.True(t, proceed)
+
+	promptInput = strings.NewReader("no\nnope\n")
+	proceed, err = renderNukeConfirmationPrompt(context.Background(), "Enter 'nuke' to confirm", "nuke", 2)
+	require.NoError(t, err)
+	assert.False(t, proceed)
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"slices"
+)
+
+// Accounts restricts the AWS accounts cloud-nuke runs against, and names them.
+type Accounts struct {
+	// Allow lists the only accounts cloud-nuke may run against. Empty allows every account that isn't blocked.
+	Allow []string `yaml:"allow"`
+	// Block lists the accounts cloud-nuke must never run against.
+	Block []string `yaml:"block"`
+	// Aliases names accounts, keyed by account ID. The alias of an account must be typed to confirm its nuke.
+	Aliases map[string]string `yaml:"aliases"`
+}
+
+// IsRestricted returns true if some accounts are not allowed.
+func (a Accounts) IsRestricted() bool {
+	return len(a.Allow) > 0 || len(a.Block) > 0
+}
+
+// IsAllowed returns true if cloud-nuke may run against the account.
+func (a Accounts) IsAllowed(accountId string) bool {
+	if slices.Contains(a.Block, accountId) {
+		return false
+	}
+	return len(a.Allow) == 0 || slices.Contains(a.Allow, accountId)
+}
+
+// Alias returns the alias of the account, or an empty string if it has none.
+func (a Accounts) Alias(accountId string) string {
+	return a.Aliases[accountId]
+}
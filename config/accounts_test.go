@@ -0,0 +1,27 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccounts(t *testing.T) {
+	t.Parallel()
+
+	unrestricted := Accounts{Aliases: map[string]string{"111111111111": "sandbox"}}
+	assert.False(t, unrestricted.IsRestricted())
+	assert.True(t, unrestricted.IsAllowed("222222222222"))
+	assert.Equal(t, "sandbox", unrestricted.Alias("111111111111"))
+	assert.Empty(t, unrestricted.Alias("222222222222"))
+
+	blocked := Accounts{Block: []string{"111111111111"}}
+	assert.True(t, blocked.IsRestricted())
+	assert.False(t, blocked.IsAllowed("111111111111"))
+	assert.True(t, blocked.IsAllowed("222222222222"))
+
+	allowed := Accounts{Allow: []string{"111111111111", "222222222222"}, Block: []string{"222222222222"}}
+	assert.True(t, allowed.IsAllowed("111111111111"))
+	assert.False(t, allowed.IsAllowed("222222222222"), "blocked accounts are never allowed")
+	assert.False(t, allowed.IsAllowed("333333333333"))
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"regexp"
 	"strings"
 	"time"
@@ -159,166 +161,86 @@ type Config struct {
 	CloudFunction    ResourceType `yaml:"CloudFunction"`
 	ArtifactRegistry ResourceType `yaml:"ArtifactRegistry"`
 	GcpPubSubTopic   ResourceType `yaml:"GcpPubSubTopic"`
+
+	// Custom holds the config of resource types registered by code importing cloud-nuke, keyed by resource type
+	// name. See CustomResourceType.
+	Custom map[string]*ResourceType `yaml:"Custom"`
+
+	// Hooks run around the deletion of every resource type, before the hooks of the resource type.
+	Hooks Hooks `yaml:"Hooks"`
+
+	// Accounts restricts the AWS accounts cloud-nuke runs against.
+	Accounts Accounts `yaml:"accounts"`
+
+	// Global holds the settings merged into every resource type by GetConfig.
+	Global GlobalResourceType `yaml:"global"`
+
+	// ProtectionTags protect the resources holding any of them, of every resource type, on top of the default
+	// cloud-nuke-excluded=true tag.
+	ProtectionTags []ProtectionTag `yaml:"protection_tags"`
+
+	// protectionTags are the tags added with AddProtectionTags, which protect every resource type.
+	protectionTags []ProtectionTag
+
+	// customDefaults is the config of custom resource types missing from Custom. It only holds the settings
+	// applied to every resource type, e.g., by AddTimeout.
+	customDefaults ResourceType
 }
 
-// allResourceTypes returns pointers to the embedded ResourceType for every
-// resource field in Config. This replaces the old reflection-based approach
-// with a type-safe enumeration. If you add a new field to Config, add it here
-// too — TestAllResourceTypesComplete will catch any omission.
+// allResourceTypes returns pointers to the embedded ResourceType for every resource field in Config, derived from
+// its fields, followed by the custom resource types.
 func (c *Config) allResourceTypes() []*ResourceType {
-	return []*ResourceType{
-		&c.ACM,
-		&c.ACMPCA,
-		&c.AMI,
-		&c.APIGateway,
-		&c.APIGatewayV2,
-		&c.AccessAnalyzer,
-		&c.AutoScalingGroup,
-		&c.AppRunnerService,
-		&c.BackupVault,
-		&c.ManagedPrometheus,
-		&c.CloudWatchAlarm,
-		&c.CloudWatchDashboard,
-		&c.CloudWatchLogGroup,
-		&c.CloudMapNamespace,
-		&c.CloudMapService,
-		&c.CloudTrailTrail,
-		&c.CloudFrontDistribution,
-		&c.CloudFormationStack,
-		&c.CodeDeployApplications,
-		&c.ConfigServiceRecorder,
-		&c.ConfigServiceRule,
-		&c.DataPipeline,
-		&c.DataSyncLocation,
-		&c.DataSyncTask,
-		&c.DBGlobalClusters,
-		&c.DBClusters.ResourceType,
-		&c.DBInstances.ResourceType,
-		&c.DBGlobalClusterMemberships,
-		&c.DBSubnetGroups,
-		&c.DynamoDB,
-		&c.EBSVolume,
-		&c.ElasticBeanstalk,
-		&c.EC2,
-		&c.EC2DedicatedHosts,
-		&c.EC2DHCPOption,
-		&c.EC2KeyPairs,
-		&c.EC2IPAM,
-		&c.EC2IPAMByoasn,
-		&c.EC2IPAMCustomAllocation,
-		&c.EC2IPAMPool,
-		&c.EC2IPAMResourceDiscovery,
-		&c.EC2IPAMScope,
-		&c.EC2Endpoint.ResourceType,
-		&c.EC2Subnet.ResourceType,
-		&c.EC2PlacementGroups,
-		&c.EgressOnlyInternetGateway,
-		&c.ECRRepository,
-		&c.ECSCluster,
-		&c.ECSService,
-		&c.EKSCluster,
-		&c.ELBv1,
-		&c.ELBv2,
-		&c.ElasticFileSystem,
-		&c.ElasticIP,
-		&c.ElastiCache,
-		&c.ElastiCacheParameterGroup,
-		&c.ElastiCacheServerless,
-		&c.ElastiCacheSubnetGroup,
-		&c.EventBridge,
-		&c.EventBridgeArchive,
-		&c.EventBridgeRule,
-		&c.EventBridgeSchedule,
-		&c.EventBridgeScheduleGroup,
-		&c.Grafana,
-		&c.GuardDuty,
-		&c.IAMGroups,
-		&c.IAMPolicies,
-		&c.IAMInstanceProfiles,
-		&c.IAMRoles,
-		&c.IAMServiceLinkedRoles,
-		&c.IAMUsers,
-		&c.KMSCustomerKeys.ResourceType,
-		&c.KinesisStream,
-		&c.KinesisFirehose,
-		&c.LambdaFunction,
-		&c.LambdaLayer,
-		&c.LaunchConfiguration,
-		&c.LaunchTemplate,
-		&c.MacieMember,
-		&c.MSKCluster,
-		&c.MQBroker,
-		&c.NATGateway.ResourceType,
-		&c.OIDCProvider,
-		&c.OpenSearchDomain,
-		&c.Redshift,
-		&c.RedshiftSnapshotCopyGrant,
-		&c.ResourceShare,
-		&c.RDSSnapshot,
-		&c.RDSClusterSnapshot,
-		&c.RDSParameterGroup,
-		&c.RDSProxy,
-		&c.S3,
-		&c.S3AccessPoint,
-		&c.S3ObjectLambdaAccessPoint,
-		&c.S3MultiRegionAccessPoint,
-		&c.SESIdentity,
-		&c.SESConfigurationSet,
-		&c.SESReceiptRuleSet,
-		&c.SESReceiptFilter,
-		&c.SESEmailTemplates,
-		&c.SNS,
-		&c.SQS,
-		&c.SageMakerEndpoint,
-		&c.SageMakerEndpointConfig,
-		&c.SageMakerNotebook,
-		&c.SageMakerStudioDomain,
-		&c.SecretsManager,
-		&c.SSMParameter,
-		&c.SecurityHub,
-		&c.Snapshots,
-		&c.TransitGateway,
-		&c.TransitGatewayRouteTable,
-		&c.TransitGatewayVPCAttachment,
-		&c.TransitGatewayPeeringAttachment,
-		&c.VPC.ResourceType,
-		&c.Route53HostedZone,
-		&c.Route53CIDRCollection,
-		&c.Route53TrafficPolicy,
-		&c.InternetGateway.ResourceType,
-		&c.NetworkACL,
-		&c.NetworkInterface.ResourceType,
-		&c.SecurityGroup.ResourceType,
-		&c.NetworkFirewall,
-		&c.NetworkFirewallPolicy,
-		&c.NetworkFirewallRuleGroup,
-		&c.NetworkFirewallTLSConfig,
-		&c.NetworkFirewallResourcePolicy,
-		&c.VPCLatticeServiceNetwork,
-		&c.VPCLatticeService,
-		&c.VPCLatticeTargetGroup,
-		&c.RouteTable.ResourceType,
-		&c.VPCPeeringConnection,
-		&c.GCSBucket,
-		&c.CloudFunction,
-		&c.ArtifactRegistry,
-		&c.GcpPubSubTopic,
-	}
-}
-
-// allEC2ResourceTypes returns pointers to the EC2ResourceType fields in Config.
+	var resourceTypes []*ResourceType
+	for _, field := range c.resourceTypeFields() {
+		resourceTypes = append(resourceTypes, field.resourceType)
+	}
+	return append(append(resourceTypes, &c.customDefaults), c.customResourceTypes()...)
+}
+
+// customResourceTypes returns pointers to the custom resource types. They are copied first, so that settings
+// applied to a config are not shared with the configs it was copied from.
+func (c *Config) customResourceTypes() []*ResourceType {
+	if c.Custom == nil {
+		return nil
+	}
+
+	custom := make(map[string]*ResourceType, len(c.Custom))
+	resourceTypes := make([]*ResourceType, 0, len(c.Custom))
+	for name, rt := range c.Custom {
+		copied := ResourceType{}
+		if rt != nil {
+			copied = *rt
+		}
+		custom[name] = &copied
+		resourceTypes = append(resourceTypes, &copied)
+	}
+	c.Custom = custom
+	return resourceTypes
+}
+
+// CustomResourceType returns the config of a resource type registered by code importing cloud-nuke, from the
+// Custom section of the config file. It is read for resource types that have no ConfigKey.
+func (c Config) CustomResourceType(name string) ResourceType {
+	if rt, ok := c.Custom[name]; ok && rt != nil {
+		return *rt
+	}
+	return c.customDefaults
+}
+
+// allEC2ResourceTypes returns pointers to the EC2ResourceType fields in Config, derived from its fields.
 // These are the only fields that have a DefaultOnly flag.
 func (c *Config) allEC2ResourceTypes() []*EC2ResourceType {
-	return []*EC2ResourceType{
-		&c.EC2Endpoint,
-		&c.EC2Subnet,
-		&c.NATGateway,
-		&c.VPC,
-		&c.InternetGateway,
-		&c.NetworkInterface,
-		&c.SecurityGroup,
-		&c.RouteTable,
+	var resourceTypes []*EC2ResourceType
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if !v.Type().Field(i).IsExported() {
+			continue
+		}
+		if ec2, ok := v.Field(i).Addr().Interface().(*EC2ResourceType); ok {
+			resourceTypes = append(resourceTypes, ec2)
+		}
 	}
+	return resourceTypes
 }
 
 func (c *Config) AddIncludeAfterTime(includeAfter *time.Time) {
@@ -400,6 +322,11 @@ type ResourceType struct {
 	ExcludeRule        FilterRule `yaml:"exclude"`
 	Timeout            string     `yaml:"timeout"`
 	ProtectUntilExpire *bool      `yaml:"protect_until_expire"`
+	MaxDelete          *int       `yaml:"max_delete"`
+	Hooks              Hooks      `yaml:"hooks"`
+
+	// protectionTags are the tags protecting resources on top of the default exclusion tag, see AddProtectionTags.
+	protectionTags []ProtectionTag
 }
 
 type FilterRule struct {
@@ -408,6 +335,7 @@ type FilterRule struct {
 	TimeBefore   *time.Time            `yaml:"time_before"`
 	Tags         map[string]Expression `yaml:"tags"`
 	TagsOperator string                `yaml:"tags_operator"` // "AND" or "OR" - defaults to "OR" for backward compatibility
+	Expression   *FilterExpression     `yaml:"expression"`
 }
 
 type Expression struct {
@@ -432,8 +360,18 @@ func (expression *Expression) UnmarshalText(data []byte) error {
 	return nil
 }
 
-// GetConfig - Unmarshall the config file and parse it into a config object.
+// GetConfig - Unmarshall the config file and parse it into a config object. The global section is merged into
+// every resource type. Resource types are only configured by the YAML keys of the fields of Config, see
+// GetConfigWithSchema to also configure them by registered name.
 func GetConfig(filePath string) (*Config, error) {
+	return GetConfigWithSchema(filePath, nil)
+}
+
+// GetConfigWithSchema - Unmarshall the config file and parse it into a config object, like GetConfig. Resource types
+// can also be configured by their registered name in the schema (e.g., "ec2-keypairs" for "EC2KeyPairs"),
+// including the resource types read from Custom, and sections that don't belong to a resource type of the schema
+// are rejected. A nil schema accepts the YAML keys of the fields of Config only.
+func GetConfigWithSchema(filePath string, schema Schema) (*Config, error) {
 	var configObj Config
 
 	absolutePath, err := filepath.Abs(filePath)
@@ -446,11 +384,29 @@ func GetConfig(filePath string) (*Config, error) {
 		return nil, err
 	}
 
+	if len(schema) > 0 {
+		yamlFile, err = schema.resolve(yamlFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = yaml.UnmarshalStrict(yamlFile, &configObj)
 	if err != nil {
 		return nil, err
 	}
 
+	configObj.applyGlobal()
+
+	if err := configObj.validateProtectionTags(); err != nil {
+		return nil, err
+	}
+	configObj.AddProtectionTags(configObj.ProtectionTags)
+
+	if err := configObj.validateHooks(); err != nil {
+		return nil, err
+	}
+
 	return &configObj, nil
 }
 
@@ -553,6 +509,8 @@ type ResourceValue struct {
 	Name *string
 	Time *time.Time
 	Tags map[string]string
+	// Attributes holds other properties of the resource that filter expressions can read, e.g., its state.
+	Attributes map[string]interface{}
 }
 
 func (r ResourceType) ShouldIncludeBasedOnTime(time time.Time) bool {
@@ -577,6 +535,19 @@ func (r ResourceType) hasTimeFilter() bool {
 		r.ExcludeRule.TimeBefore != nil
 }
 
+// GetTimeout returns the execution timeout configured for the resource type.
+// A zero duration means no timeout is configured.
+func (r ResourceType) GetTimeout() (time.Duration, error) {
+	if r.Timeout == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(r.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %q: %w", r.Timeout, err)
+	}
+	return timeout, nil
+}
+
 func (r ResourceType) getExclusionTag() string {
 	return DefaultAwsResourceExclusionTagKey
 }
@@ -613,10 +584,9 @@ func (r ResourceType) ShouldIncludeBasedOnTag(tags map[string]string) bool {
 	}
 
 	// Handle exclude rule first
-	exclusionTag := r.getExclusionTag()
-	exclusionTagValue := r.getExclusionTagValue()
-	if value, ok := tags[exclusionTag]; ok {
-		if matches(strings.ToLower(value), []Expression{*exclusionTagValue}) {
+	for _, protectionTag := range r.getProtectionTags() {
+		if protectionTag.matches(tags) {
+			logging.Debugf("[Skip] the resource is protected by the %s tag", protectionTag)
 			return false
 		}
 	}
@@ -669,5 +639,34 @@ func (r ResourceType) ShouldInclude(value ResourceValue) bool {
 		return false
 	}
 
+	if !r.ShouldIncludeBasedOnExpression(value) {
+		return false
+	}
+
+	return true
+}
+
+// ShouldIncludeBasedOnExpression checks the resource against the expressions of the include and exclude rules.
+func (r ResourceType) ShouldIncludeBasedOnExpression(value ResourceValue) bool {
+	if r.ExcludeRule.Expression != nil {
+		matched, err := r.ExcludeRule.Expression.Matches(value)
+		if err != nil {
+			logging.Debugf("Exclude expression can't be evaluated against the resource - excluding for safety: %v", err)
+			return false
+		}
+		if matched {
+			return false
+		}
+	}
+
+	if r.IncludeRule.Expression != nil {
+		matched, err := r.IncludeRule.Expression.Matches(value)
+		if err != nil {
+			logging.Debugf("Include expression can't be evaluated against the resource - excluding for safety: %v", err)
+			return false
+		}
+		return matched
+	}
+
 	return true
 }
@@ -375,6 +375,19 @@ func TestAddIncludeAndExcludeAfterTime(t *testing.T) {
 	assert.Equal(t, testConfig.ACM.IncludeRule.TimeAfter, now)
 }
 
+func TestGetTimeout(t *testing.T) {
+	timeout, err := ResourceType{}.GetTimeout()
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+
+	timeout, err = ResourceType{Timeout: "90s"}.GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 90*time.Second, timeout)
+
+	_, err = ResourceType{Timeout: "later"}.GetTimeout()
+	assert.Error(t, err)
+}
+
 func TestGetExclusionTag(t *testing.T) {
 	testConfig := &Config{}
 	require.Equal(t, DefaultAwsResourceExclusionTagKey, testConfig.ACM.getExclusionTag())
@@ -931,8 +944,7 @@ func TestShouldIncludeBasedOnIncludeRuleTags(t *testing.T) {
 
 func TestAllResourceTypesComplete(t *testing.T) {
 	// This test uses reflection to verify that allResourceTypes() covers every
-	// struct field in Config. If you add a new field to Config, add it to
-	// allResourceTypes() — this test will fail until you do.
+	// struct field in Config, and that every field has a type it knows about.
 	c := &Config{}
 	got := c.allResourceTypes()
 
@@ -960,6 +972,13 @@ func TestAllResourceTypesComplete(t *testing.T) {
 			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
 		case reflect.TypeOf(KMSCustomerKeyResourceType{}):
 			rtPtr = field.FieldByName("ResourceType").Addr().Pointer()
+		case reflect.TypeOf(map[string]*ResourceType{}):
+			// Custom resource types are covered by TestCustomResourceType
+			continue
+		case reflect.TypeOf(Hooks{}), reflect.TypeOf(Accounts{}), reflect.TypeOf(GlobalResourceType{}),
+			reflect.TypeOf([]ProtectionTag{}):
+			// Settings that are not about a resource type
+			continue
 		default:
 			t.Fatalf("Config field %q has unexpected type %s", fieldName, field.Type())
 		}
@@ -1016,3 +1035,23 @@ func TestShouldIncludeBasedOnTag_NilTagsSafety(t *testing.T) {
 	r2 := ResourceType{}
 	assert.True(t, r2.ShouldIncludeBasedOnTag(nil))
 }
+
+func TestCustomResourceType(t *testing.T) {
+	content := []byte("Custom:\n  internal-service-tag:\n    include:\n      names_regex:\n        - \"^test-\"\n")
+	tmpFile := filepath.Join(t.TempDir(), "custom.yaml")
+	require.NoError(t, os.WriteFile(tmpFile, content, 0644))
+
+	configObj, err := GetConfig(tmpFile)
+	require.NoError(t, err)
+
+	timeout := 5 * time.Minute
+	configObj.AddTimeout(&timeout)
+
+	custom := configObj.CustomResourceType("internal-service-tag")
+	require.Len(t, custom.IncludeRule.NamesRegExp, 1)
+	assert.Equal(t, "^test-", custom.IncludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "5m0s", custom.Timeout)
+
+	// Resource types missing from the Custom section still get the settings applied to every resource type
+	assert.Equal(t, "5m0s", configObj.CustomResourceType("unconfigured").Timeout)
+}
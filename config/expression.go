@@ -0,0 +1,106 @@
+package config
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+)
+
+// expressionCostLimit bounds the cost of evaluating a filter expression against a resource, so that an expression
+// can't stall a run, e.g., by looping over large lists.
+const expressionCostLimit = 100000
+
+// FilterExpression is a filter written in CEL (https://cel.dev), for filters that combine the name, creation time,
+// tags and attributes of a resource, e.g., `name.startsWith("ci-") && (tags[?"team"].orValue("") == "qa" || time <
+// now - duration("72h"))`. Expressions are evaluated with these variables:
+//   - name (string): the name of the resource, empty when the resource has none,
+//   - time (timestamp): the creation time of the resource, unset when it isn't known,
+//   - tags (map of strings): the tags of the resource, empty when the resource type has no tag support,
+//   - attributes (map): the attributes of the resource, see ResourceValue,
+//   - now (timestamp): the time the expression is evaluated at.
+//
+// Expressions can't read anything else, and their evaluation is bounded, see expressionCostLimit.
+type FilterExpression struct {
+	Source  string
+	program cel.Program
+}
+
+// expressionEnv returns the CEL environment filter expressions are compiled in.
+var expressionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.OptionalTypes(),
+		cel.Variable("name", cel.StringType),
+		cel.Variable("time", cel.TimestampType),
+		cel.Variable("tags", cel.MapType(cel.StringType, cel.StringType)),
+		cel.Variable("attributes", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("now", cel.TimestampType),
+	)
+})
+
+// ParseFilterExpression compiles a filter expression, which must evaluate to a bool.
+func ParseFilterExpression(source string) (*FilterExpression, error) {
+	env, err := expressionEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(source)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType {
+		return nil, fmt.Errorf("invalid expression %q: evaluates to %s, not bool", source, ast.OutputType())
+	}
+
+	program, err := env.Program(ast, cel.CostLimit(expressionCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression %q: %w", source, err)
+	}
+	return &FilterExpression{Source: source, program: program}, nil
+}
+
+// UnmarshalText - Internally used by yaml.Unmarshal to unmarshall a FilterExpression field
+func (expression *FilterExpression) UnmarshalText(data []byte) error {
+	parsed, err := ParseFilterExpression(string(data))
+	if err != nil {
+		return err
+	}
+
+	*expression = *parsed
+	return nil
+}
+
+// Matches evaluates the expression against a resource. An error is returned when the expression can't be evaluated,
+// e.g., when it reads the creation time of a resource that has none, or a missing tag with tags["team"].
+func (expression *FilterExpression) Matches(value ResourceValue) (bool, error) {
+	variables := map[string]interface{}{
+		"name":       "",
+		"tags":       map[string]string{},
+		"attributes": map[string]interface{}{},
+		"now":        time.Now(),
+	}
+	if value.Name != nil {
+		variables["name"] = *value.Name
+	}
+	if value.Time != nil {
+		variables["time"] = *value.Time
+	}
+	if value.Tags != nil {
+		variables["tags"] = value.Tags
+	}
+	if value.Attributes != nil {
+		variables["attributes"] = value.Attributes
+	}
+
+	out, _, err := expression.program.Eval(variables)
+	if err != nil {
+		return false, fmt.Errorf("evaluating expression %q: %w", expression.Source, err)
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("evaluating expression %q: got %v, not a bool", expression.Source, out.Value())
+	}
+	return matched, nil
+}
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustParseFilterExpression(t *testing.T, source string) *FilterExpression {
+	expression, err := ParseFilterExpression(source)
+	require.NoError(t, err)
+	return expression
+}
+
+func TestParseFilterExpression_Rejects(t *testing.T) {
+	for _, source := range []string{
+		`name.startsWith(`,
+		`name + "-suffix"`,
+		`region == "us-east-1"`,
+	} {
+		_, err := ParseFilterExpression(source)
+		assert.Error(t, err, source)
+	}
+}
+
+func TestFilterExpressionMatches(t *testing.T) {
+	expression := mustParseFilterExpression(t,
+		`name.startsWith("ci-") && (tags[?"team"].orValue("") == "qa" || time < now - duration("72h"))`)
+
+	old := time.Now().Add(-96 * time.Hour)
+	recent := time.Now().Add(-time.Hour)
+	tests := []struct {
+		name     string
+		value    ResourceValue
+		expected bool
+	}{
+		{"qa", ResourceValue{Name: aws.String("ci-1"), Time: &recent, Tags: map[string]string{"team": "qa"}}, true},
+		{"old", ResourceValue{Name: aws.String("ci-2"), Time: &old, Tags: map[string]string{}}, true},
+		{"recent", ResourceValue{Name: aws.String("ci-3"), Time: &recent, Tags: map[string]string{"team": "data"}}, false},
+		{"name", ResourceValue{Name: aws.String("prod-1"), Time: &old, Tags: map[string]string{"team": "qa"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := expression.Matches(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+
+	matched, err := mustParseFilterExpression(t, `attributes["state"] == "stopped"`).Matches(ResourceValue{
+		Attributes: map[string]interface{}{"state": "stopped"},
+	})
+	require.NoError(t, err)
+	assert.True(t, matched)
+
+	// Resources without a creation time can't be compared with a time
+	_, err = mustParseFilterExpression(t, `time < now`).Matches(ResourceValue{Name: aws.String("ci-1")})
+	assert.Error(t, err)
+}
+
+func TestShouldIncludeBasedOnExpression(t *testing.T) {
+	recent := time.Now()
+	withInclude := ResourceType{IncludeRule: FilterRule{Expression: mustParseFilterExpression(t, `name.startsWith("ci-")`)}}
+	withExclude := ResourceType{ExcludeRule: FilterRule{Expression: mustParseFilterExpression(t, `tags["keep"] == "true"`)}}
+
+	assert.True(t, withInclude.ShouldInclude(ResourceValue{Name: aws.String("ci-1")}))
+	assert.False(t, withInclude.ShouldInclude(ResourceValue{Name: aws.String("prod-1")}))
+
+	assert.True(t, withExclude.ShouldInclude(ResourceValue{Name: aws.String("ci-1"), Tags: map[string]string{"keep": "false"}}))
+	assert.False(t, withExclude.ShouldInclude(ResourceValue{Name: aws.String("ci-1"), Tags: map[string]string{"keep": "true"}}))
+	// The exclude expression fails on resources without the keep tag, which are excluded for safety
+	assert.False(t, withExclude.ShouldInclude(ResourceValue{Name: aws.String("ci-1"), Time: &recent, Tags: map[string]string{}}))
+}
+
+func TestGetConfig_Expression(t *testing.T) {
+	configObj, err := GetConfig(writeConfig(t, `
+global:
+  exclude:
+    expression: 'tags[?"keep"].orValue("") == "true"'
+S3:
+  include:
+    expression: name.startsWith("ci-") && "team" in tags
+`))
+	require.NoError(t, err)
+	require.NotNil(t, configObj.S3.IncludeRule.Expression)
+	assert.Equal(t, `name.startsWith("ci-") && "team" in tags`, configObj.S3.IncludeRule.Expression.Source)
+	require.NotNil(t, configObj.EC2.ExcludeRule.Expression)
+
+	_, err = GetConfig(writeConfig(t, "S3:\n  include:\n    expression: name.size()\n"))
+	assert.ErrorContains(t, err, "not bool")
+}
@@ -0,0 +1,55 @@
+package config
+
+// GlobalResourceType holds the settings shared by every resource type. GetConfig merges them into every resource
+// type, and the settings of a resource type override them.
+type GlobalResourceType struct {
+	IncludeRule        FilterRule `yaml:"include"`
+	ExcludeRule        FilterRule `yaml:"exclude"`
+	Timeout            string     `yaml:"timeout"`
+	ProtectUntilExpire *bool      `yaml:"protect_until_expire"`
+}
+
+// applyGlobal merges the global section into every resource type, including custom resource types.
+func (c *Config) applyGlobal() {
+	for _, rt := range c.allResourceTypes() {
+		rt.IncludeRule = rt.IncludeRule.withDefaults(c.Global.IncludeRule)
+		rt.ExcludeRule = rt.ExcludeRule.withDefaults(c.Global.ExcludeRule)
+		if rt.Timeout == "" {
+			rt.Timeout = c.Global.Timeout
+		}
+		if rt.ProtectUntilExpire == nil {
+			rt.ProtectUntilExpire = c.Global.ProtectUntilExpire
+		}
+	}
+}
+
+// withDefaults returns the rule, with the settings it doesn't set taken from defaults. Tags are merged, and the tags
+// of the rule override the tags of defaults with the same key.
+func (r FilterRule) withDefaults(defaults FilterRule) FilterRule {
+	if len(r.NamesRegExp) == 0 {
+		r.NamesRegExp = defaults.NamesRegExp
+	}
+	if r.TimeAfter == nil {
+		r.TimeAfter = defaults.TimeAfter
+	}
+	if r.TimeBefore == nil {
+		r.TimeBefore = defaults.TimeBefore
+	}
+	if r.TagsOperator == "" {
+		r.TagsOperator = defaults.TagsOperator
+	}
+	if r.Expression == nil {
+		r.Expression = defaults.Expression
+	}
+	if len(defaults.Tags) > 0 {
+		tags := make(map[string]Expression, len(defaults.Tags)+len(r.Tags))
+		for key, value := range defaults.Tags {
+			tags[key] = value
+		}
+		for key, value := range r.Tags {
+			tags[key] = value
+		}
+		r.Tags = tags
+	}
+	return r
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfig_Global(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+global:
+  exclude:
+    names_regex:
+      - ^prod-
+    tags:
+      team: platform
+  timeout: 10m
+  protect_until_expire: false
+S3:
+  exclude:
+    names_regex:
+      - ^logs-
+    tags:
+      team: data
+  timeout: 1h
+Custom:
+  internal-service-tag:
+    include:
+      names_regex:
+        - ^test-
+`), 0600))
+
+	configObj, err := GetConfig(path)
+	require.NoError(t, err)
+
+	// Resource types without settings of their own get the global ones
+	assert.Equal(t, "^prod-", configObj.EC2.ExcludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "platform", tagPattern(configObj.EC2.ExcludeRule.Tags, "team"))
+	assert.Equal(t, "10m", configObj.EC2.Timeout)
+	assert.False(t, *configObj.EC2.ProtectUntilExpire)
+	assert.Equal(t, "10m", configObj.CustomResourceType("unconfigured").Timeout)
+
+	// The settings of a resource type override the global ones
+	require.Len(t, configObj.S3.ExcludeRule.NamesRegExp, 1)
+	assert.Equal(t, "^logs-", configObj.S3.ExcludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "data", tagPattern(configObj.S3.ExcludeRule.Tags, "team"))
+	assert.Equal(t, "1h", configObj.S3.Timeout)
+
+	custom := configObj.CustomResourceType("internal-service-tag")
+	assert.Equal(t, "^test-", custom.IncludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "^prod-", custom.ExcludeRule.NamesRegExp[0].RE.String())
+}
+
+func TestFilterRule_WithDefaults(t *testing.T) {
+	defaults := FilterRule{
+		NamesRegExp:  []Expression{{RE: *regexp.MustCompile("^prod-")}},
+		Tags:         map[string]Expression{"team": {RE: *regexp.MustCompile("platform")}, "env": {RE: *regexp.MustCompile("prod")}},
+		TagsOperator: "AND",
+	}
+	rule := FilterRule{Tags: map[string]Expression{"team": {RE: *regexp.MustCompile("data")}}}
+
+	merged := rule.withDefaults(defaults)
+	assert.Equal(t, defaults.NamesRegExp, merged.NamesRegExp)
+	assert.Equal(t, "data", tagPattern(merged.Tags, "team"))
+	assert.Equal(t, "prod", tagPattern(merged.Tags, "env"))
+	assert.Equal(t, "AND", merged.TagsOperator)
+	assert.Len(t, rule.Tags, 1, "the tags of the rule are not modified")
+
+	expression, err := ParseFilterExpression(`name == "keep"`)
+	require.NoError(t, err)
+	assert.Same(t, expression, FilterRule{}.withDefaults(FilterRule{Expression: expression}).Expression)
+	override, err := ParseFilterExpression(`name == "other"`)
+	require.NoError(t, err)
+	assert.Same(t, override, FilterRule{Expression: override}.withDefaults(FilterRule{Expression: expression}).Expression)
+
+	assert.Equal(t, FilterRule{}, FilterRule{}.withDefaults(FilterRule{}))
+}
+
+func TestApplyGlobalComplete(t *testing.T) {
+	// Like TestAllResourceTypesComplete, this test uses reflection to verify that the global section reaches the
+	// ResourceType of every struct field in Config.
+	c := &Config{Global: GlobalResourceType{Timeout: "10m"}}
+	c.applyGlobal()
+
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		fieldName := v.Type().Field(i).Name
+
+		// Fields are read with reflection only, since customDefaults is unexported
+		var rt reflect.Value
+		switch field.Type() {
+		case reflect.TypeOf(ResourceType{}):
+			rt = field
+		case reflect.TypeOf(EC2ResourceType{}), reflect.TypeOf(AWSProtectableResourceType{}),
+			reflect.TypeOf(KMSCustomerKeyResourceType{}):
+			rt = field.FieldByName("ResourceType")
+		case reflect.TypeOf(map[string]*ResourceType{}):
+			// Custom resource types are covered by TestGetConfig_Global
+			continue
+		case reflect.TypeOf(Hooks{}), reflect.TypeOf(Accounts{}), reflect.TypeOf(GlobalResourceType{}),
+			reflect.TypeOf([]ProtectionTag{}):
+			// Settings that are not about a resource type
+			continue
+		default:
+			t.Fatalf("Config field %q has unexpected type %s", fieldName, field.Type())
+		}
+
+		assert.Equal(t, "10m", rt.FieldByName("Timeout").String(), "global section is not merged into Config field %q", fieldName)
+	}
+}
+
+// tagPattern returns the pattern of a tag expression, since map values are not addressable.
+func tagPattern(tags map[string]Expression, key string) string {
+	expression := tags[key]
+	return expression.RE.String()
+}
@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultHookTimeout bounds the hooks that don't set a timeout.
+const DefaultHookTimeout = 30 * time.Second
+
+// Hooks are run around the deletion of each batch of resources. Hooks at the top level of the config run for every
+// resource type, before the hooks of the resource type.
+type Hooks struct {
+	// PreDelete hooks run before a batch is deleted, and can veto identifiers of the batch. A batch whose pre-delete
+	// hook fails is not deleted.
+	PreDelete []Hook `yaml:"pre_delete"`
+	// PostDelete hooks run once a batch is deleted, with the result of each deletion.
+	PostDelete []Hook `yaml:"post_delete"`
+}
+
+// Hook runs a command, or posts to a URL, with a JSON document describing the batch. Set one of Command and URL.
+type Hook struct {
+	// Command is the program to run and its arguments. The document is written to its standard input.
+	Command []string `yaml:"command"`
+	// URL receives the document as the body of a POST request.
+	URL string `yaml:"url"`
+	// Headers are added to the requests posted to URL.
+	Headers map[string]string `yaml:"headers"`
+	// Timeout bounds the hook, e.g., "10s". Defaults to DefaultHookTimeout.
+	Timeout string `yaml:"timeout"`
+}
+
+// With returns the hooks followed by the other hooks.
+func (h Hooks) With(other Hooks) Hooks {
+	return Hooks{
+		PreDelete:  append(append([]Hook{}, h.PreDelete...), other.PreDelete...),
+		PostDelete: append(append([]Hook{}, h.PostDelete...), other.PostDelete...),
+	}
+}
+
+// Validate ensures every hook is either a command or a URL, with a valid timeout.
+func (h Hooks) Validate() error {
+	for _, hook := range append(append([]Hook{}, h.PreDelete...), h.PostDelete...) {
+		if err := hook.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate ensures the hook is either a command or a URL, with a valid timeout.
+func (h Hook) Validate() error {
+	if (len(h.Command) == 0) == (h.URL == "") {
+		return fmt.Errorf("invalid hook: set one of command and url")
+	}
+	if len(h.Headers) > 0 && h.URL == "" {
+		return fmt.Errorf("invalid hook %s: headers are only sent to urls", h)
+	}
+	if _, err := h.GetTimeout(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the timeout of the hook, or DefaultHookTimeout if it doesn't set one.
+func (h Hook) GetTimeout() (time.Duration, error) {
+	if h.Timeout == "" {
+		return DefaultHookTimeout, nil
+	}
+	timeout, err := time.ParseDuration(h.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q of hook %s", h.Timeout, h)
+	}
+	return timeout, nil
+}
+
+// String describes the hook in logs and errors.
+func (h Hook) String() string {
+	if h.URL != "" {
+		return h.URL
+	}
+	if len(h.Command) > 0 {
+		return h.Command[0]
+	}
+	return "<empty>"
+}
+
+// validateHooks ensures the hooks of the config, and of every resource type, are valid.
+func (c *Config) validateHooks() error {
+	if err := c.Hooks.Validate(); err != nil {
+		return err
+	}
+	for _, rt := range c.allResourceTypes() {
+		if err := rt.Hooks.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnlyHooksAndProtection returns a config holding the hooks and the protection tags of the config, and of every
+// resource type, without any other setting, e.g., to nuke resources listed without the filters of the config, while
+// still running its hooks and sparing the resources it protects.
+func (c Config) OnlyHooksAndProtection() Config {
+	onlyHooks := Config{Hooks: c.Hooks, ProtectionTags: c.ProtectionTags}
+
+	resourceTypes := onlyHooks.resourceTypesByKey()
+	for key, rt := range c.resourceTypesByKey() {
+		resourceTypes[key].Hooks = rt.Hooks
+	}
+	for name, rt := range c.Custom {
+		if rt == nil || (len(rt.Hooks.PreDelete) == 0 && len(rt.Hooks.PostDelete) == 0) {
+			continue
+		}
+		if onlyHooks.Custom == nil {
+			onlyHooks.Custom = make(map[string]*ResourceType)
+		}
+		onlyHooks.Custom[name] = &ResourceType{Hooks: rt.Hooks}
+	}
+	onlyHooks.AddProtectionTags(c.protectionTags)
+	return onlyHooks
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHook_Validate(t *testing.T) {
+	assert.NoError(t, Hook{Command: []string{"true"}}.Validate())
+	assert.NoError(t, Hook{URL: "https://cmdb.example.com/hooks", Headers: map[string]string{"Authorization": "token"}}.Validate())
+
+	assert.Error(t, Hook{}.Validate())
+	assert.Error(t, Hook{Command: []string{"true"}, URL: "https://cmdb.example.com/hooks"}.Validate())
+	assert.Error(t, Hook{Command: []string{"true"}, Headers: map[string]string{"Authorization": "token"}}.Validate())
+	assert.Error(t, Hook{Command: []string{"true"}, Timeout: "soon"}.Validate())
+}
+
+func TestHook_GetTimeout(t *testing.T) {
+	timeout, err := Hook{Command: []string{"true"}}.GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultHookTimeout, timeout)
+
+	timeout, err = Hook{Command: []string{"true"}, Timeout: "5s"}.GetTimeout()
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Second, timeout)
+}
+
+func TestHooks_With(t *testing.T) {
+	global := Hooks{PreDelete: []Hook{{Command: []string{"global"}}}}
+	resourceType := Hooks{PreDelete: []Hook{{Command: []string{"local"}}}, PostDelete: []Hook{{URL: "https://example.com"}}}
+
+	assert.Equal(t, Hooks{
+		PreDelete:  []Hook{{Command: []string{"global"}}, {Command: []string{"local"}}},
+		PostDelete: []Hook{{URL: "https://example.com"}},
+	}, global.With(resourceType))
+}
+
+func TestGetConfig_Hooks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+Hooks:
+  post_delete:
+    - url: https://cmdb.example.com/hooks
+CloudWatchLogGroup:
+  hooks:
+    pre_delete:
+      - command: ["./archive-log-group.sh"]
+        timeout: 5m
+`), 0600))
+
+	configObj, err := GetConfig(path)
+	require.NoError(t, err)
+	assert.Equal(t, "https://cmdb.example.com/hooks", configObj.Hooks.PostDelete[0].URL)
+	assert.Equal(t, []string{"./archive-log-group.sh"}, configObj.CloudWatchLogGroup.Hooks.PreDelete[0].Command)
+
+	require.NoError(t, os.WriteFile(path, []byte(`
+EC2:
+  hooks:
+    pre_delete:
+      - timeout: 5m
+`), 0600))
+	_, err = GetConfig(path)
+	assert.Error(t, err)
+}
+
+func TestConfig_OnlyHooksAndProtection(t *testing.T) {
+	configObj := Config{
+		Hooks: Hooks{PostDelete: []Hook{{URL: "https://cmdb.example.com/hooks"}}},
+		CloudWatchLogGroup: ResourceType{
+			IncludeRule: FilterRule{NamesRegExp: []Expression{{RE: *regexp.MustCompile("^ci-")}}},
+			Hooks:       Hooks{PreDelete: []Hook{{Command: []string{"./archive-log-group.sh"}}}},
+		},
+		VPC: EC2ResourceType{DefaultOnly: true, ResourceType: ResourceType{
+			Hooks: Hooks{PreDelete: []Hook{{Command: []string{"./check-vpc.sh"}}}},
+		}},
+		Custom: map[string]*ResourceType{
+			"widget":  {Timeout: "5m", Hooks: Hooks{PostDelete: []Hook{{URL: "https://widgets.example.com"}}}},
+			"gadget":  {Timeout: "5m"},
+			"nothing": nil,
+		},
+	}
+
+	onlyHooks := configObj.OnlyHooksAndProtection()
+	assert.Equal(t, configObj.Hooks, onlyHooks.Hooks)
+	assert.Equal(t, ResourceType{Hooks: configObj.CloudWatchLogGroup.Hooks}, onlyHooks.CloudWatchLogGroup)
+	assert.Equal(t, EC2ResourceType{ResourceType: ResourceType{Hooks: configObj.VPC.Hooks}}, onlyHooks.VPC)
+	assert.Equal(t, map[string]*ResourceType{"widget": {Hooks: configObj.Custom["widget"].Hooks}}, onlyHooks.Custom)
+
+	// Protection tags, from the config file or added afterwards, are kept for every resource type
+	configObj.ProtectionTags = []ProtectionTag{{Key: "do-not-delete"}}
+	configObj.AddProtectionTags(configObj.ProtectionTags)
+	configObj.AddProtectionTags([]ProtectionTag{{Key: "lifecycle", Value: &Expression{RE: *regexp.MustCompile("permanent")}}})
+
+	protected := configObj.OnlyHooksAndProtection()
+	assert.Equal(t, configObj.ProtectionTags, protected.ProtectionTags)
+	defaultTag := DefaultAwsResourceExclusionTagKey + "=" + DefaultAwsResourceExclusionTagValue
+	for _, rt := range []ResourceType{protected.CloudWatchLogGroup, protected.VPC.ResourceType, protected.S3,
+		*protected.Custom["widget"], protected.CustomResourceType("gadget")} {
+		assert.Equal(t, []string{defaultTag, "do-not-delete", "lifecycle=permanent"}, protectionTagStrings(rt))
+		assert.Empty(t, rt.IncludeRule.NamesRegExp)
+	}
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// jsonSchemaDialect is the JSON Schema version of the document returned by JSONSchema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema returns a JSON Schema of the config file, for editors to autocomplete and check config files. Every
+// section of the schema can be keyed by its name or its alias, like in GetConfigWithSchema. The types of the
+// settings are derived from Config, so that the JSON Schema follows the settings GetConfig reads.
+func JSONSchema(schema Schema) map[string]interface{} {
+	definitions := make(map[string]interface{})
+	properties := make(map[string]interface{})
+
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		key := yamlKey(t.Field(i))
+		if key == "" || key == customKey {
+			continue
+		}
+		properties[key] = jsonSchemaOf(t.Field(i).Type, definitions)
+	}
+
+	custom := make(map[string]interface{})
+	fields := configFieldTypes()
+	for _, section := range schema {
+		if section.Key == "" {
+			custom[section.Name] = jsonSchemaOf(reflect.TypeOf(ResourceType{}), definitions)
+			properties[section.Name] = custom[section.Name]
+			continue
+		}
+		properties[section.Name] = jsonSchemaOf(fields[section.Key], definitions)
+	}
+
+	customSchema := map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": jsonSchemaOf(reflect.TypeOf(ResourceType{}), definitions),
+	}
+	if len(schema) > 0 {
+		customSchema["properties"] = custom
+		customSchema["additionalProperties"] = false
+	}
+	properties[customKey] = customSchema
+
+	// tags_operator is matched case-insensitively
+	filterRule := definitions["FilterRule"].(map[string]interface{})
+	filterRule["properties"].(map[string]interface{})["tags_operator"] = map[string]interface{}{
+		"type":    "string",
+		"pattern": "^([Aa][Nn][Dd]|[Oo][Rr])$",
+	}
+
+	return map[string]interface{}{
+		"$schema":              jsonSchemaDialect,
+		"title":                "cloud-nuke config",
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+		"$defs":                definitions,
+	}
+}
+
+// jsonSchemaOf returns the JSON Schema of the YAML documents a value of type t is read from. Structs are defined
+// once in definitions, and referenced.
+func jsonSchemaOf(t reflect.Type, definitions map[string]interface{}) map[string]interface{} {
+	switch t {
+	case reflect.TypeOf(time.Time{}):
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case reflect.TypeOf(Expression{}):
+		return map[string]interface{}{"type": "string", "format": "regex"}
+	case reflect.TypeOf(FilterExpression{}):
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return jsonSchemaOf(t.Elem(), definitions)
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice:
+		return map[string]interface{}{"type": "array", "items": jsonSchemaOf(t.Elem(), definitions)}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": jsonSchemaOf(t.Elem(), definitions)}
+	case reflect.Struct:
+		ref := map[string]interface{}{"$ref": "#/$defs/" + t.Name()}
+		if _, ok := definitions[t.Name()]; ok {
+			return ref
+		}
+		// Reserve the definition first, for types referencing themselves
+		definitions[t.Name()] = nil
+
+		properties := make(map[string]interface{})
+		addStructProperties(t, properties, definitions)
+		definitions[t.Name()] = map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+		return ref
+	}
+	return map[string]interface{}{}
+}
+
+// addStructProperties adds the JSON Schema of every field of a struct to properties, including those of its inlined
+// structs.
+func addStructProperties(t reflect.Type, properties map[string]interface{}, definitions map[string]interface{}) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("yaml"), ",")
+		if slices.Contains(tag[1:], "inline") {
+			addStructProperties(field.Type, properties, definitions)
+			continue
+		}
+		properties[tag[0]] = jsonSchemaOf(field.Type, definitions)
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ProtectionTag protects the resources tagged with Key, and a value matching Value, from being nuked, like the
+// default cloud-nuke-excluded=true tag.
+type ProtectionTag struct {
+	Key string `yaml:"key"`
+	// Value is a regular expression matched against the lowercased value of the tag. When it isn't set, resources
+	// tagged with Key are protected whatever the value.
+	Value *Expression `yaml:"value"`
+}
+
+func (t ProtectionTag) String() string {
+	if t.Value == nil {
+		return t.Key
+	}
+	return fmt.Sprintf("%s=%s", t.Key, t.Value.RE.String())
+}
+
+// matches returns whether the tags of a resource hold the protection tag.
+func (t ProtectionTag) matches(tags map[string]string) bool {
+	value, ok := tags[t.Key]
+	if !ok {
+		return false
+	}
+	return t.Value == nil || t.Value.RE.MatchString(strings.ToLower(value))
+}
+
+// AddProtectionTags protects the resources holding any of the tags, of every resource type, on top of the default
+// cloud-nuke-excluded=true tag.
+func (c *Config) AddProtectionTags(tags []ProtectionTag) {
+	if len(tags) == 0 {
+		return
+	}
+	c.protectionTags = append(slices.Clip(c.protectionTags), tags...)
+	for _, rt := range c.allResourceTypes() {
+		rt.protectionTags = append(slices.Clip(rt.protectionTags), tags...)
+	}
+}
+
+// validateProtectionTags rejects protection tags without a key.
+func (c *Config) validateProtectionTags() error {
+	for i, tag := range c.ProtectionTags {
+		if tag.Key == "" {
+			return fmt.Errorf("invalid protection_tags[%d]: key is required", i)
+		}
+	}
+	return nil
+}
+
+// getProtectionTags returns the tags protecting the resources of the resource type: the default exclusion tag,
+// followed by those added with AddProtectionTags.
+func (r ResourceType) getProtectionTags() []ProtectionTag {
+	return append([]ProtectionTag{{Key: r.getExclusionTag(), Value: r.getExclusionTagValue()}}, r.protectionTags...)
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetConfig_ProtectionTags(t *testing.T) {
+	configObj, err := GetConfig(writeConfig(t, `
+protection_tags:
+  - key: do-not-delete
+  - key: lifecycle
+    value: ^permanent$
+S3:
+  include:
+    names_regex:
+      - ^test-
+`))
+	require.NoError(t, err)
+	require.Len(t, configObj.ProtectionTags, 2)
+
+	for _, rt := range []ResourceType{configObj.S3, configObj.VPC.ResourceType, configObj.GCSBucket, configObj.CustomResourceType("internal-service-tag")} {
+		assert.False(t, rt.ShouldIncludeBasedOnTag(map[string]string{"do-not-delete": ""}))
+		assert.False(t, rt.ShouldIncludeBasedOnTag(map[string]string{"lifecycle": "Permanent"}))
+		assert.False(t, rt.ShouldIncludeBasedOnTag(map[string]string{DefaultAwsResourceExclusionTagKey: "true"}))
+		assert.True(t, rt.ShouldIncludeBasedOnTag(map[string]string{"lifecycle": "permanently-temporary"}))
+		assert.True(t, rt.ShouldIncludeBasedOnTag(map[string]string{}))
+	}
+
+	_, err = GetConfig(writeConfig(t, "protection_tags:\n  - value: permanent\n"))
+	assert.ErrorContains(t, err, "key is required")
+}
+
+func TestAddProtectionTags(t *testing.T) {
+	var configObj Config
+	configObj.AddProtectionTags([]ProtectionTag{{Key: "do-not-delete"}})
+	configObj.AddProtectionTags([]ProtectionTag{{Key: "lifecycle", Value: &Expression{RE: *regexp.MustCompile("permanent")}}})
+
+	defaultTag := DefaultAwsResourceExclusionTagKey + "=" + DefaultAwsResourceExclusionTagValue
+	assert.Equal(t, []string{defaultTag, "do-not-delete", "lifecycle=permanent"}, protectionTagStrings(configObj.ACM))
+	assert.Equal(t, []string{defaultTag, "do-not-delete", "lifecycle=permanent"}, protectionTagStrings(configObj.GcpPubSubTopic))
+
+	// Without protection tags, only the default exclusion tag protects resources
+	assert.Equal(t, []string{defaultTag}, protectionTagStrings(ResourceType{}))
+}
+
+func protectionTagStrings(rt ResourceType) []string {
+	var tags []string
+	for _, tag := range rt.getProtectionTags() {
+		tags = append(tags, tag.String())
+	}
+	return tags
+}
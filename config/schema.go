@@ -0,0 +1,252 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// customKey is the YAML key of the section holding the config of resource types that have no field in Config.
+const customKey = "Custom"
+
+// Section is the section of a config file configuring a registered resource type.
+type Section struct {
+	// Name is the registered name of the resource type (e.g., "ec2-keypairs"), which keys its section.
+	Name string
+	// Key is the YAML key of the field of Config the resource type reads its config from (e.g., "EC2KeyPairs"),
+	// kept as an alias of Name. Empty for resource types that read their config from Custom.
+	Key string
+	// NoTags marks resource types whose resources are listed without their tags, so that include tag rules exclude
+	// all of them.
+	NoTags bool
+}
+
+// Schema lists the sections of the registered resource types, see resource.ConfigSchema.
+type Schema []Section
+
+// Lookup returns the section keyed by name or by alias.
+func (s Schema) Lookup(key string) (Section, bool) {
+	for _, section := range s {
+		if section.Name == key || (section.Key != "" && section.Key == key) {
+			return section, true
+		}
+	}
+	return Section{}, false
+}
+
+// resourceTypeField is a field of Config configuring a resource type.
+type resourceTypeField struct {
+	// key is the YAML key of the field.
+	key string
+	// resourceType is the ResourceType of the field, or the one it embeds.
+	resourceType *ResourceType
+}
+
+// resourceTypeFields returns the fields of Config configuring a resource type, in the order they are declared.
+// The fields of Config are the only list of the resource types it configures: every other list is derived from it.
+func (c *Config) resourceTypeFields() []resourceTypeField {
+	resourceTypeType := reflect.TypeOf(ResourceType{})
+	var fields []resourceTypeField
+
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		key := yamlKey(v.Type().Field(i))
+		field := v.Field(i)
+		if key == "" || field.Kind() != reflect.Struct {
+			continue
+		}
+		if field.Type() != resourceTypeType {
+			// EC2ResourceType and the like embed the ResourceType
+			field = field.FieldByName("ResourceType")
+		}
+		if field.IsValid() && field.Type() == resourceTypeType {
+			fields = append(fields, resourceTypeField{key: key, resourceType: field.Addr().Interface().(*ResourceType)})
+		}
+	}
+	return fields
+}
+
+// fieldByKey returns the field of Config with the YAML key key, or an invalid value if there is none.
+func (c *Config) fieldByKey(key string) reflect.Value {
+	v := reflect.ValueOf(c).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if yamlKey(v.Type().Field(i)) == key {
+			return v.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// resourceTypesByKey returns the ResourceType of every field of Config configuring a resource type, keyed by the
+// YAML key of the field.
+func (c *Config) resourceTypesByKey() map[string]*ResourceType {
+	byKey := make(map[string]*ResourceType)
+	for _, field := range c.resourceTypeFields() {
+		byKey[field.key] = field.resourceType
+	}
+	return byKey
+}
+
+// ResourceTypeByKey returns the config of the resource type read from the field of Config with the YAML key key
+// (e.g., "EC2KeyPairs"), and whether there is such a field. Resource types are configured by their key, see
+// resource.Resource.
+func (c Config) ResourceTypeByKey(key string) (ResourceType, bool) {
+	rt, ok := c.resourceTypesByKey()[key]
+	if !ok {
+		return ResourceType{}, false
+	}
+	return *rt, true
+}
+
+// EC2ResourceTypeByKey returns the config of the resource type read from the EC2ResourceType field of Config with
+// the YAML key key (e.g., "VPC"), and whether there is such a field.
+func (c Config) EC2ResourceTypeByKey(key string) (EC2ResourceType, bool) {
+	field := c.fieldByKey(key)
+	if !field.IsValid() || field.Type() != reflect.TypeOf(EC2ResourceType{}) {
+		return EC2ResourceType{}, false
+	}
+	return field.Interface().(EC2ResourceType), true
+}
+
+// settingKeys returns the YAML keys of the fields of Config that don't configure a resource type, e.g., Hooks.
+func settingKeys() []string {
+	resourceTypes := (&Config{}).resourceTypesByKey()
+
+	var keys []string
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		key := yamlKey(t.Field(i))
+		if _, ok := resourceTypes[key]; key != "" && !ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// yamlKey returns the YAML key of a field of Config, or an empty string for unexported fields.
+func yamlKey(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
+	}
+	return strings.Split(field.Tag.Get("yaml"), ",")[0]
+}
+
+// resolve rewrites a config file so that the sections keyed by the registered name of a resource type are keyed as
+// Config expects them, see resolveSections. Config files that don't need to be rewritten are returned as is, so that
+// errors point at their lines.
+func (s Schema) resolve(yamlFile []byte) ([]byte, error) {
+	var document yaml.MapSlice
+	if err := yaml.Unmarshal(yamlFile, &document); err != nil {
+		return nil, err
+	}
+
+	resolved, rewritten, err := s.resolveSections(document)
+	if err != nil || !rewritten {
+		return yamlFile, err
+	}
+	return yaml.Marshal(resolved)
+}
+
+// resolveSections rewrites the sections of a config file keyed by the registered name of a resource type to the
+// key of the field of Config the resource type reads from, or under Custom, and rejects the sections that don't
+// belong to a registered resource type. It returns whether the document was rewritten.
+func (s Schema) resolveSections(document yaml.MapSlice) (yaml.MapSlice, bool, error) {
+	settings := settingKeys()
+	aliases := (&Config{}).resourceTypesByKey()
+
+	var resolved yaml.MapSlice
+	var custom yaml.MapSlice
+	customIndex := -1
+	seen := make(map[string]string)
+	rewritten := false
+
+	for _, item := range document {
+		key, ok := item.Key.(string)
+		if !ok {
+			return nil, false, fmt.Errorf("invalid config section %v: keys must be strings", item.Key)
+		}
+
+		if key == customKey {
+			entries, err := customEntries(item.Value)
+			if err != nil {
+				return nil, false, err
+			}
+			for _, entry := range entries {
+				name := fmt.Sprint(entry.Key)
+				if section, ok := s.Lookup(name); !ok || section.Key != "" {
+					return nil, false, fmt.Errorf("invalid config section %s.%s: %s is not a registered resource type configured under %s", customKey, name, name, customKey)
+				}
+				if err := markSeen(seen, name, customKey+"."+name); err != nil {
+					return nil, false, err
+				}
+			}
+			custom = append(custom, entries...)
+			customIndex = len(resolved)
+			resolved = append(resolved, item)
+			continue
+		}
+
+		if slices.Contains(settings, key) {
+			resolved = append(resolved, item)
+			continue
+		}
+
+		section, ok := s.Lookup(key)
+		if !ok {
+			if _, isAlias := aliases[key]; isAlias {
+				// Config fields whose resource type isn't registered, e.g., resource types of another cloud
+				resolved = append(resolved, item)
+				continue
+			}
+			return nil, false, fmt.Errorf("unknown config section %s: not a registered resource type, nor a config key", key)
+		}
+
+		if err := markSeen(seen, section.Name, key); err != nil {
+			return nil, false, err
+		}
+		switch {
+		case section.Key == "":
+			custom = append(custom, yaml.MapItem{Key: section.Name, Value: item.Value})
+			rewritten = true
+		case section.Key != key:
+			resolved = append(resolved, yaml.MapItem{Key: section.Key, Value: item.Value})
+			rewritten = true
+		default:
+			resolved = append(resolved, item)
+		}
+	}
+
+	if len(custom) > 0 {
+		if customIndex < 0 {
+			resolved = append(resolved, yaml.MapItem{Key: customKey})
+			customIndex = len(resolved) - 1
+		}
+		resolved[customIndex].Value = custom
+	}
+	return resolved, rewritten, nil
+}
+
+// customEntries returns the entries of the Custom section.
+func customEntries(value interface{}) (yaml.MapSlice, error) {
+	if value == nil {
+		return nil, nil
+	}
+	entries, ok := value.(yaml.MapSlice)
+	if !ok {
+		return nil, fmt.Errorf("invalid config section %s: must be a map keyed by resource type name", customKey)
+	}
+	return entries, nil
+}
+
+// markSeen records the key a resource type is configured with, and rejects resource types configured twice, e.g.,
+// by name and by alias.
+func markSeen(seen map[string]string, name string, key string) error {
+	if previous, ok := seen[name]; ok {
+		return fmt.Errorf("resource type %s is configured twice, by %s and by %s", name, previous, key)
+	}
+	seen[name] = key
+	return nil
+}
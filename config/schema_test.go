@@ -0,0 +1,108 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSchema = Schema{
+	{Name: "ec2-keypairs", Key: "EC2KeyPairs"},
+	{Name: "vpc", Key: "VPC"},
+	{Name: "kms-customer-key", Key: "KMSCustomerKeys"},
+	{Name: "internal-service-tag"},
+}
+
+func writeConfig(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestGetConfigWithSchema(t *testing.T) {
+	configObj, err := GetConfigWithSchema(writeConfig(t, `
+ec2-keypairs:
+  include:
+    time_after: 2020-01-01T00:00:00Z
+vpc:
+  default_only: true
+KMSCustomerKeys:
+  include_unaliased_keys: true
+internal-service-tag:
+  include:
+    names_regex:
+      - ^test-
+S3:
+  timeout: 5m
+global:
+  timeout: 10m
+`), testSchema)
+	require.NoError(t, err)
+
+	assert.Equal(t, "2020-01-01T00:00:00Z", configObj.EC2KeyPairs.IncludeRule.TimeAfter.Format("2006-01-02T15:04:05Z07:00"))
+	assert.True(t, configObj.VPC.DefaultOnly)
+	assert.True(t, configObj.KMSCustomerKeys.IncludeUnaliasedKeys)
+	assert.Equal(t, "^test-", configObj.CustomResourceType("internal-service-tag").IncludeRule.NamesRegExp[0].RE.String())
+	assert.Equal(t, "5m", configObj.S3.Timeout, "config fields of resource types missing from the schema are accepted")
+	assert.Equal(t, "10m", configObj.EC2KeyPairs.Timeout)
+}
+
+func TestGetConfigWithSchema_Rejects(t *testing.T) {
+	testCases := []struct {
+		name    string
+		content string
+		message string
+	}{
+		{"unknown section", "ec2-keypair:\n  timeout: 5m\n", "unknown config section ec2-keypair"},
+		{"name and alias", "ec2-keypairs:\n  timeout: 5m\nEC2KeyPairs:\n  timeout: 5m\n", "configured twice"},
+		{"name and custom", "internal-service-tag:\n  timeout: 5m\nCustom:\n  internal-service-tag:\n    timeout: 5m\n", "configured twice"},
+		{"unregistered custom", "Custom:\n  unknown:\n    timeout: 5m\n", "Custom.unknown"},
+		{"built-in custom", "Custom:\n  vpc:\n    timeout: 5m\n", "Custom.vpc"},
+		{"unknown setting", "vpc:\n  bogus: true\n", "bogus"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := GetConfigWithSchema(writeConfig(t, tc.content), testSchema)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.message)
+		})
+	}
+}
+
+func TestGetConfig_IgnoresSchema(t *testing.T) {
+	_, err := GetConfig(writeConfig(t, "ec2-keypairs:\n  timeout: 5m\n"))
+	assert.Error(t, err, "registered names are only accepted with a schema")
+
+	configObj, err := GetConfig(writeConfig(t, "Custom:\n  unknown:\n    timeout: 5m\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "5m", configObj.CustomResourceType("unknown").Timeout)
+}
+
+func TestResourceTypeByKey(t *testing.T) {
+	c := Config{}
+	c.EC2KeyPairs.Timeout = "5m"
+	c.VPC.Timeout = "10m"
+	c.VPC.DefaultOnly = true
+
+	rt, ok := c.ResourceTypeByKey("EC2KeyPairs")
+	require.True(t, ok)
+	assert.Equal(t, "5m", rt.Timeout)
+
+	// Fields embedding the ResourceType are found too
+	rt, ok = c.ResourceTypeByKey("VPC")
+	require.True(t, ok)
+	assert.Equal(t, "10m", rt.Timeout)
+
+	ec2, ok := c.EC2ResourceTypeByKey("VPC")
+	require.True(t, ok)
+	assert.True(t, ec2.DefaultOnly)
+
+	_, ok = c.ResourceTypeByKey("Hooks")
+	assert.False(t, ok)
+	_, ok = c.EC2ResourceTypeByKey("EC2KeyPairs")
+	assert.False(t, ok)
+}
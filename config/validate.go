@@ -0,0 +1,296 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// Problem is an issue found in a config file by ValidateConfigFile.
+type Problem struct {
+	// Line is the line of the config file the problem is at, or 0 when it isn't known.
+	Line int
+	// Path locates the setting in the config file, e.g., "S3.include.names_regex[0]".
+	Path    string
+	Message string
+}
+
+func (p Problem) String() string {
+	location := p.Path
+	if p.Line > 0 {
+		location = fmt.Sprintf("line %d: %s", p.Line, p.Path)
+	}
+	if location == "" {
+		return p.Message
+	}
+	return fmt.Sprintf("%s: %s", location, p.Message)
+}
+
+// ValidateConfigFile checks a config file against the schema, and returns every problem found rather than the first
+// one: sections and settings that don't exist, invalid regular expressions, time windows that can't match anything,
+// unknown tags_operator values, and include tag rules of resource types listed without their tags, which exclude
+// every resource. Problems that GetConfigWithSchema would reject the config file for are reported too. An error is
+// returned when the config file can't be read.
+func ValidateConfigFile(filePath string, schema Schema) ([]Problem, error) {
+	yamlFile, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yamlv3.Node
+	if err := yamlv3.Unmarshal(yamlFile, &document); err != nil {
+		return []Problem{{Message: err.Error()}}, nil
+	}
+
+	v := &validator{schema: schema, seen: make(map[string]string)}
+	if len(document.Content) > 0 {
+		v.validateDocument(document.Content[0])
+	}
+
+	// Report what the checks above missed, e.g., invalid hooks, once everything they found is fixed
+	if len(v.problems) == 0 {
+		if _, err := GetConfigWithSchema(filePath, schema); err != nil {
+			v.problems = append(v.problems, Problem{Message: err.Error()})
+		}
+	}
+	return v.problems, nil
+}
+
+// validator collects the problems of a config file.
+type validator struct {
+	schema   Schema
+	seen     map[string]string
+	problems []Problem
+}
+
+func (v *validator) report(node *yamlv3.Node, path string, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{Line: node.Line, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// validateDocument validates the top-level sections of a config file.
+func (v *validator) validateDocument(node *yamlv3.Node) {
+	if node.Kind != yamlv3.MappingNode {
+		v.report(node, "", "config file must be a map of sections")
+		return
+	}
+
+	fields := configFieldTypes()
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, value := node.Content[i], node.Content[i+1]
+		key := keyNode.Value
+
+		switch key {
+		case customKey:
+			v.validateCustom(value)
+			continue
+		case "global":
+			v.validateSection(value, key, reflect.TypeOf(GlobalResourceType{}), false)
+			continue
+		}
+
+		if slices.Contains(settingKeys(), key) {
+			// Hooks, accounts and protection tags are checked when the config file is loaded
+			continue
+		}
+
+		section, ok := v.schema.Lookup(key)
+		if !ok {
+			if fieldType, isAlias := fields[key]; isAlias {
+				// Config fields whose resource type isn't registered, e.g., resource types of another cloud
+				v.validateSection(value, key, fieldType, false)
+				continue
+			}
+			v.report(keyNode, key, "unknown section: not a resource type listed by --list-resource-types, nor a config key")
+			continue
+		}
+
+		v.markSeen(keyNode, key, section.Name)
+		fieldType := reflect.TypeOf(ResourceType{})
+		if section.Key != "" {
+			fieldType = fields[section.Key]
+		}
+		v.validateSection(value, key, fieldType, section.NoTags)
+	}
+}
+
+// validateCustom validates the sections under Custom.
+func (v *validator) validateCustom(node *yamlv3.Node) {
+	if node.Kind == yamlv3.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	if node.Kind != yamlv3.MappingNode {
+		v.report(node, customKey, "must be a map keyed by resource type name")
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, value := node.Content[i], node.Content[i+1]
+		path := customKey + "." + keyNode.Value
+
+		section, ok := v.schema.Lookup(keyNode.Value)
+		if len(v.schema) > 0 && (!ok || section.Key != "") {
+			v.report(keyNode, path, "%s is not a registered resource type configured under %s", keyNode.Value, customKey)
+			continue
+		}
+		v.markSeen(keyNode, path, keyNode.Value)
+		v.validateSection(value, path, reflect.TypeOf(ResourceType{}), section.NoTags)
+	}
+}
+
+// markSeen reports resource types configured twice, e.g., by name and by alias.
+func (v *validator) markSeen(node *yamlv3.Node, path string, name string) {
+	if len(v.schema) == 0 {
+		return
+	}
+	if previous, ok := v.seen[name]; ok {
+		v.report(node, path, "resource type %s is already configured by %s", name, previous)
+		return
+	}
+	v.seen[name] = path
+}
+
+// validateSection validates the section of a resource type, whose settings are the YAML keys of sectionType.
+func (v *validator) validateSection(node *yamlv3.Node, path string, sectionType reflect.Type, noTags bool) {
+	if node.Kind == yamlv3.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	if node.Kind != yamlv3.MappingNode {
+		v.report(node, path, "must be a map of settings")
+		return
+	}
+
+	allowed := yamlKeys(sectionType)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, value := node.Content[i], node.Content[i+1]
+		settingPath := path + "." + keyNode.Value
+
+		switch {
+		case !slices.Contains(allowed, keyNode.Value):
+			v.report(keyNode, settingPath, "unknown setting, expected one of %s", strings.Join(allowed, ", "))
+		case keyNode.Value == "include":
+			v.validateRule(value, settingPath, true, noTags)
+		case keyNode.Value == "exclude":
+			v.validateRule(value, settingPath, false, noTags)
+		case keyNode.Value == "timeout":
+			if duration, err := time.ParseDuration(value.Value); err != nil || duration <= 0 {
+				v.report(value, settingPath, "invalid timeout %q, e.g., 10m", value.Value)
+			}
+		}
+	}
+}
+
+// validateRule validates an include or exclude rule.
+func (v *validator) validateRule(node *yamlv3.Node, path string, include bool, noTags bool) {
+	if node.Kind == yamlv3.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	if node.Kind != yamlv3.MappingNode {
+		v.report(node, path, "must be a map of filters")
+		return
+	}
+
+	allowed := yamlKeys(reflect.TypeOf(FilterRule{}))
+	var timeAfter, timeBefore *yamlv3.Node
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode, value := node.Content[i], node.Content[i+1]
+		filterPath := path + "." + keyNode.Value
+
+		switch keyNode.Value {
+		case "names_regex":
+			if value.Kind != yamlv3.SequenceNode {
+				v.report(value, filterPath, "must be a list of regular expressions")
+				continue
+			}
+			for j, item := range value.Content {
+				v.validateRegexp(item, fmt.Sprintf("%s[%d]", filterPath, j))
+			}
+		case "tags":
+			if value.Kind != yamlv3.MappingNode {
+				v.report(value, filterPath, "must be a map of tag names to regular expressions")
+				continue
+			}
+			for j := 0; j+1 < len(value.Content); j += 2 {
+				v.validateRegexp(value.Content[j+1], filterPath+"."+value.Content[j].Value)
+			}
+			if include && noTags && len(value.Content) > 0 {
+				v.report(keyNode, filterPath, "resources of this type are listed without their tags, so include tags exclude all of them")
+			}
+		case "tags_operator":
+			if operator := strings.ToUpper(value.Value); operator != "AND" && operator != "OR" {
+				v.report(value, filterPath, "unknown operator %q, expected AND or OR", value.Value)
+			}
+		case "expression":
+			if _, err := ParseFilterExpression(value.Value); err != nil {
+				v.report(value, filterPath, "%v", err)
+			}
+		case "time_after":
+			timeAfter = value
+			v.validateTime(value, filterPath)
+		case "time_before":
+			timeBefore = value
+			v.validateTime(value, filterPath)
+		default:
+			v.report(keyNode, filterPath, "unknown filter, expected one of %s", strings.Join(allowed, ", "))
+		}
+	}
+
+	if timeAfter != nil && timeBefore != nil {
+		var after, before time.Time
+		if timeAfter.Decode(&after) == nil && timeBefore.Decode(&before) == nil && after.After(before) {
+			v.report(timeAfter, path+".time_after", "time_after %s is later than time_before %s, so the rule matches nothing",
+				timeAfter.Value, timeBefore.Value)
+		}
+	}
+}
+
+func (v *validator) validateRegexp(node *yamlv3.Node, path string) {
+	if _, err := regexp.Compile(node.Value); err != nil {
+		v.report(node, path, "invalid regular expression: %v", err)
+	}
+}
+
+func (v *validator) validateTime(node *yamlv3.Node, path string) {
+	var t time.Time
+	if err := node.Decode(&t); err != nil {
+		v.report(node, path, "invalid time %q, e.g., 2024-01-01T00:00:00Z", node.Value)
+	}
+}
+
+// configFieldTypes returns the type of every field of Config configuring a resource type, keyed by its YAML key.
+func configFieldTypes() map[string]reflect.Type {
+	resourceTypes := (&Config{}).resourceTypesByKey()
+
+	types := make(map[string]reflect.Type)
+	t := reflect.TypeOf(Config{})
+	for i := 0; i < t.NumField(); i++ {
+		key := yamlKey(t.Field(i))
+		if _, ok := resourceTypes[key]; ok {
+			types[key] = t.Field(i).Type
+		}
+	}
+	return types
+}
+
+// yamlKeys returns the YAML keys of the fields of a struct, including those of its inlined structs.
+func yamlKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("yaml"), ",")
+		if slices.Contains(tag[1:], "inline") {
+			keys = append(keys, yamlKeys(field.Type)...)
+			continue
+		}
+		keys = append(keys, tag[0])
+	}
+	return keys
+}
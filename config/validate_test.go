@@ -0,0 +1,127 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigFile(t *testing.T) {
+	schema := append(Schema{{Name: "iam-group", Key: "IAMGroups", NoTags: true}}, testSchema...)
+
+	problems, err := ValidateConfigFile(writeConfig(t, `global:
+  exclude:
+    names_regex:
+      - "(["
+ec2-keypairs:
+  include:
+    time_after: 2024-01-01T00:00:00Z
+    time_before: 2023-01-01T00:00:00Z
+    tags_operator: XOR
+  exclude:
+    expression: name.size()
+  max_deletes: 3
+iam-group:
+  include:
+    tags:
+      team: "*"
+  exclude:
+    tags:
+      team: platform
+EC2KeyPairs: {}
+Bogus: {}
+Custom:
+  internal-service-tag:
+    timeout: soon
+  unknown-type: {}
+`), schema)
+	require.NoError(t, err)
+
+	assert.Equal(t, []Problem{
+		{Line: 4, Path: "global.exclude.names_regex[0]", Message: "invalid regular expression: error parsing regexp: missing closing ]: `[`"},
+		{Line: 9, Path: "ec2-keypairs.include.tags_operator", Message: `unknown operator "XOR", expected AND or OR`},
+		{Line: 7, Path: "ec2-keypairs.include.time_after", Message: "time_after 2024-01-01T00:00:00Z is later than time_before 2023-01-01T00:00:00Z, so the rule matches nothing"},
+		{Line: 11, Path: "ec2-keypairs.exclude.expression", Message: `invalid expression "name.size()": evaluates to int, not bool`},
+		{Line: 12, Path: "ec2-keypairs.max_deletes", Message: "unknown setting, expected one of include, exclude, timeout, protect_until_expire, max_delete, hooks"},
+		{Line: 16, Path: "iam-group.include.tags.team", Message: "invalid regular expression: error parsing regexp: missing argument to repetition operator: `*`"},
+		{Line: 15, Path: "iam-group.include.tags", Message: "resources of this type are listed without their tags, so include tags exclude all of them"},
+		{Line: 20, Path: "EC2KeyPairs", Message: "resource type ec2-keypairs is already configured by ec2-keypairs"},
+		{Line: 21, Path: "Bogus", Message: "unknown section: not a resource type listed by --list-resource-types, nor a config key"},
+		{Line: 24, Path: "Custom.internal-service-tag.timeout", Message: `invalid timeout "soon", e.g., 10m`},
+		{Line: 25, Path: "Custom.unknown-type", Message: "unknown-type is not a registered resource type configured under Custom"},
+	}, problems)
+}
+
+func TestValidateConfigFile_Valid(t *testing.T) {
+	problems, err := ValidateConfigFile(writeConfig(t, `
+ec2-keypairs:
+  include:
+    names_regex:
+      - ^test-
+    tags:
+      team: platform
+    tags_operator: and
+    time_after: 2023-01-01T00:00:00Z
+    time_before: 2024-01-01T00:00:00Z
+  exclude:
+    expression: 'tags[?"keep"].orValue("") == "true"'
+vpc:
+  default_only: true
+S3:
+  timeout: 10m
+`), testSchema)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestValidateConfigFile_LoadErrors(t *testing.T) {
+	problems, err := ValidateConfigFile(writeConfig(t, `
+Hooks:
+  pre_delete:
+    - timeout: 5m
+`), testSchema)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.Zero(t, problems[0].Line)
+
+	_, err = ValidateConfigFile("does-not-exist.yaml", testSchema)
+	assert.Error(t, err)
+}
+
+func TestProblemString(t *testing.T) {
+	assert.Equal(t, "line 3: S3.timeout: invalid", Problem{Line: 3, Path: "S3.timeout", Message: "invalid"}.String())
+	assert.Equal(t, "S3: invalid", Problem{Path: "S3", Message: "invalid"}.String())
+	assert.Equal(t, "invalid", Problem{Message: "invalid"}.String())
+}
+
+func TestJSONSchema(t *testing.T) {
+	jsonSchema := JSONSchema(testSchema)
+	properties := jsonSchema["properties"].(map[string]interface{})
+
+	// Sections are keyed by name and by alias
+	assert.Equal(t, map[string]interface{}{"$ref": "#/$defs/ResourceType"}, properties["ec2-keypairs"])
+	assert.Equal(t, map[string]interface{}{"$ref": "#/$defs/ResourceType"}, properties["EC2KeyPairs"])
+	assert.Equal(t, map[string]interface{}{"$ref": "#/$defs/EC2ResourceType"}, properties["vpc"])
+	assert.Equal(t, map[string]interface{}{"$ref": "#/$defs/ResourceType"}, properties["internal-service-tag"])
+	assert.Contains(t, properties, "global")
+	assert.Contains(t, properties, "Hooks")
+	assert.NotContains(t, properties, "customDefaults")
+
+	custom := properties["Custom"].(map[string]interface{})
+	assert.Equal(t, false, custom["additionalProperties"])
+	assert.Contains(t, custom["properties"], "internal-service-tag")
+	assert.NotContains(t, custom["properties"], "ec2-keypairs")
+
+	definitions := jsonSchema["$defs"].(map[string]interface{})
+	ec2 := definitions["EC2ResourceType"].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Contains(t, ec2, "default_only")
+	assert.Contains(t, ec2, "include")
+
+	filterRule := definitions["FilterRule"].(map[string]interface{})["properties"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"type": "string", "format": "date-time"}, filterRule["time_after"])
+	assert.Equal(t, map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string", "format": "regex"},
+	}, filterRule["names_regex"])
+}
@@ -0,0 +1,59 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/util"
+)
+
+// scopeCircuitBreakerThreshold is the number of consecutive resource types whose circuit breakers trip with
+// the same class of failure after which the rest of the scope is skipped.
+const scopeCircuitBreakerThreshold = 3
+
+// nukeBreakers holds the circuit breakers of a nuke run. They carry over between passes, so a resource type or
+// scope that was given up on is not attempted again.
+type nukeBreakers struct {
+	// resourceTypes trip after consecutive failures of a resource type in a scope, keyed by scope and type
+	resourceTypes *util.CircuitBreakers
+	// scopes trip after consecutive resource types of a scope tripped, keyed by scope
+	scopes *util.CircuitBreakers
+}
+
+// newNukeBreakers creates the circuit breakers of a nuke run. A threshold of 0 or less disables them.
+func newNukeBreakers(threshold int) nukeBreakers {
+	scopeThreshold := scopeCircuitBreakerThreshold
+	if threshold <= 0 {
+		scopeThreshold = 0
+	}
+	return nukeBreakers{
+		resourceTypes: util.NewCircuitBreakers(threshold),
+		scopes:        util.NewCircuitBreakers(scopeThreshold),
+	}
+}
+
+// reportCircuitBreakerTrip reports, once, that the remaining identifiers of a resource type in a scope, or of
+// every resource type left in a scope when resourceType is empty, are skipped. Returns the error for the trip.
+func reportCircuitBreakerTrip(collector reporting.Emitter, resourceType string, scope string, class string,
+	skipped int) error {
+	err := util.CircuitBreakerTrippedError{ResourceType: resourceType, Region: scope, ErrorClass: class}
+	logging.Errorf("%s, skipping %d remaining resources", err.Error(), skipped)
+
+	description := fmt.Sprintf("Circuit breaker tripped, skipped %d remaining %s in %s", skipped, resourceType, scope)
+	if resourceType == "" {
+		description = fmt.Sprintf("Circuit breaker tripped, skipped %d remaining resources in %s", skipped, scope)
+	}
+	collector.Emit(reporting.GeneralError{
+		ResourceType: resourceType,
+		Description:  description,
+		Error:        err.Error(),
+	})
+	collector.Emit(reporting.CircuitBreakerTripped{
+		ResourceType: resourceType,
+		Region:       scope,
+		ErrorClass:   class,
+		Skipped:      skipped,
+	})
+	return err
+}
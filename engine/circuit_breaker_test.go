@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newDeniedResource returns a listed resource named name whose deletions are all denied, nuked one identifier per
+// batch.
+func newDeniedResource(t *testing.T, name string, ids ...string) resource.NukeableResource {
+	fake := resourcetest.NewFake(name, ids...)
+	fake.BatchSize = 1
+	fake.DeleteErr = func(id string) error {
+		return &smithy.GenericAPIError{Code: "AccessDenied", Message: "not authorized to delete " + id}
+	}
+	return fake.Listed(t, config.Config{})
+}
+
+func TestNuke_CircuitBreakerSkipsResourceType(t *testing.T) {
+	res := newDeniedResource(t, "denied", "a", "b", "c", "d", "e")
+
+	events, err := nukeInScope(t, Settings{CircuitBreakerThreshold: 2}, res)
+
+	var trippedErr util.CircuitBreakerTrippedError
+	require.ErrorAs(t, err, &trippedErr)
+	assert.Equal(t, util.CircuitBreakerTrippedError{ResourceType: "denied", Region: "us-east-1", ErrorClass: "AccessDenied"}, trippedErr)
+
+	assert.Len(t, resourcetest.EventsOf[reporting.ResourceDeleted](events), 2)
+	assert.Equal(t, []reporting.CircuitBreakerTripped{
+		{ResourceType: "denied", Region: "us-east-1", ErrorClass: "AccessDenied", Skipped: 3},
+	}, resourcetest.EventsOf[reporting.CircuitBreakerTripped](events))
+}
+
+func TestNuke_CircuitBreakerSkipsScope(t *testing.T) {
+	var res []resource.NukeableResource
+	for _, name := range []string{"denied-1", "denied-2", "denied-3", "denied-4"} {
+		res = append(res, newDeniedResource(t, name, "a", "b"))
+	}
+
+	events, err := nukeInScope(t, Settings{CircuitBreakerThreshold: 2}, res...)
+
+	require.Error(t, err)
+	assert.Len(t, resourcetest.EventsOf[reporting.ResourceDeleted](events), 6, "the last resource type is not attempted")
+
+	tripped := resourcetest.EventsOf[reporting.CircuitBreakerTripped](events)
+	require.Len(t, tripped, 4)
+	assert.Equal(t, reporting.CircuitBreakerTripped{Region: "us-east-1", ErrorClass: "AccessDenied", Skipped: 2}, tripped[3])
+}
+
+func TestNuke_CircuitBreakerDisabled(t *testing.T) {
+	res := newDeniedResource(t, "denied", "a", "b", "c", "d", "e")
+
+	events, err := nukeInScope(t, Settings{}, res)
+
+	require.Error(t, err)
+	assert.Len(t, resourcetest.EventsOf[reporting.ResourceDeleted](events), 5)
+	assert.Empty(t, resourcetest.EventsOf[reporting.CircuitBreakerTripped](events))
+}
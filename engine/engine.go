@@ -0,0 +1,126 @@
+// Package engine scans and nukes the resources of any cloud. Clouds plug in as a Provider, which enumerates the
+// scopes of a run (e.g., regions) and initializes the registered resources of a scope, while the engine owns the
+// loops on top of resource.NukeableResource: concurrent scans, dependency ordering, batching, throttling retries,
+// timeouts, circuit breakers, multiple passes, journaling, verification and the events emitted along the way.
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/journal"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// GlobalScope is the pseudo-scope of resources that are not tied to a region. It is processed on its own, after
+// every other scope.
+const GlobalScope = "global"
+
+// Provider is a cloud the engine can scan and nuke.
+type Provider interface {
+	// Name is the name of the cloud, e.g., "aws".
+	Name() string
+	// Scopes returns the scopes of the run, e.g., regions, in the order their events are reported.
+	Scopes() []string
+	// Resources returns the registered resources of a scope, initialized for that scope. An error aborts the scan.
+	Resources(ctx context.Context, scope string) ([]resource.NukeableResource, error)
+	// Dependencies returns the resource types each resource type registered in a scope depends on, directly or
+	// through other registered resource types, see resource.TransitiveDependencies. Resources are nuked in the
+	// order they impose, even when the resource types linking them are not selected.
+	Dependencies(scope string) map[string][]string
+}
+
+// ErrorClassifier is implemented by providers whose API errors are classified differently than AWS errors, which
+// is the default.
+type ErrorClassifier interface {
+	// IsThrottlingError returns true if the deletion was rate limited, and should be retried after backing off.
+	IsThrottlingError(err error) bool
+	// IsWarningError returns true if the deletion failure is expected to resolve itself, e.g., a dependency that is
+	// still being deleted.
+	IsWarningError(err error) bool
+	// ErrorClass returns the class of the error, so that repeated failures of the same kind trip circuit breakers.
+	ErrorClass(err error) string
+}
+
+// ListErrorFilter is implemented by providers that ignore some errors listing resources, e.g., APIs that are
+// disabled in a GCP project.
+type ListErrorFilter interface {
+	// IgnoreListError returns true if the error listing the resource type should not be reported.
+	IgnoreListError(resourceType string, err error) bool
+}
+
+// defaultErrorClassifier classifies errors with the util package, which understands AWS errors.
+type defaultErrorClassifier struct{}
+
+func (defaultErrorClassifier) IsThrottlingError(err error) bool { return util.IsThrottlingError(err) }
+func (defaultErrorClassifier) IsWarningError(err error) bool    { return util.IsWarningError(err) }
+func (defaultErrorClassifier) ErrorClass(err error) string      { return util.ErrorClass(err) }
+
+// errorClassifierOf returns the error classifier of the provider, or the default one.
+func errorClassifierOf(p Provider) ErrorClassifier {
+	if classifier, ok := p.(ErrorClassifier); ok {
+		return classifier
+	}
+	return defaultErrorClassifier{}
+}
+
+// Settings are the options of a run that the engine applies the same way to every provider.
+type Settings struct {
+	// ResourceTypes are the resource types to scan. Empty, or containing "all", scans every resource type.
+	ResourceTypes []string
+	// ExcludeResourceTypes are never scanned, even when listed in ResourceTypes.
+	ExcludeResourceTypes []string
+	ExcludeFirstSeen     bool
+	// Timeout bounds each listing and batch of deletions, and is kept when verifying deletions.
+	Timeout *time.Duration
+	// ParallelScopes is the maximum number of scopes scanned or nuked concurrently.
+	// Values of 1 or less process scopes sequentially.
+	ParallelScopes int
+	// MaxPasses is the maximum number of nuke passes. Identifiers that failed or warned in a pass are
+	// retried in the next one. Values of 1 or less nuke in a single pass.
+	MaxPasses int
+	// Journal records deletion results and completed scopes. Nil disables journaling.
+	Journal *journal.Journal
+	// Verify lists the nuked resource types again once the nuke is complete, and reports the deleted identifiers
+	// that are still present.
+	Verify bool
+	// VerifyGracePeriod is how long deleted identifiers that are still present are listed again, every
+	// verifyPollInterval, before being reported, so that asynchronous deletions (e.g., RDS, EKS, CloudFront) can
+	// complete. Zero lists them once.
+	VerifyGracePeriod time.Duration
+	// MaxDelete caps the number of identifiers nuked in a run. Zero means no limit.
+	MaxDelete int
+	// TrimToMaxDelete nukes identifiers up to the delete limits and skips the rest, instead of refusing to nuke.
+	TrimToMaxDelete bool
+	// CircuitBreakerThreshold is the number of consecutive failures of the same class after which the remaining
+	// identifiers of a resource type in a scope are skipped. Values of 0 or less disable the circuit breakers.
+	CircuitBreakerThreshold int
+}
+
+// IsNukeable checks whether a resource type is selected by the requested resource types and exclude lists. An
+// empty include list or the special value "all" selects everything, minus any excluded types.
+func IsNukeable(resourceType string, resourceTypes []string, excludeResourceTypes []string) bool {
+	if collections.ListContainsElement(excludeResourceTypes, resourceType) {
+		return false
+	}
+	return len(resourceTypes) == 0 ||
+		collections.ListContainsElement(resourceTypes, "all") ||
+		collections.ListContainsElement(resourceTypes, resourceType)
+}
+
+// SplitGlobalScope separates the global pseudo-scope from regular scopes, preserving their order.
+// Global resources are processed in isolation rather than alongside regional ones.
+func SplitGlobalScope(scopes []string) ([]string, bool) {
+	var regional []string
+	hasGlobal := false
+	for _, scope := range scopes {
+		if scope == GlobalScope {
+			hasGlobal = true
+			continue
+		}
+		regional = append(regional, scope)
+	}
+	return regional, hasGlobal
+}
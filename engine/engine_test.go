@@ -0,0 +1,280 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/journal"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProvider serves the same resources in every scope, optionally ignoring listing errors.
+type fakeProvider struct {
+	scopes       []string
+	resources    func(scope string) []resource.NukeableResource
+	ignore       func(resourceType string, err error) bool
+	dependencies map[string][]string
+}
+
+func (p fakeProvider) Name() string     { return "fake" }
+func (p fakeProvider) Scopes() []string { return p.scopes }
+
+func (p fakeProvider) Resources(ctx context.Context, scope string) ([]resource.NukeableResource, error) {
+	return p.resources(scope), nil
+}
+
+func (p fakeProvider) Dependencies(scope string) map[string][]string {
+	return p.dependencies
+}
+
+func (p fakeProvider) IgnoreListError(resourceType string, err error) bool {
+	return p.ignore != nil && p.ignore(resourceType, err)
+}
+
+// newListedResource returns a resource of the given type listing ids, or failing with listErr when it is set.
+func newListedResource(name string, listErr error, ids ...string) resource.NukeableResource {
+	fake := resourcetest.NewFake(name, ids...)
+	fake.ListErr = listErr
+	return fake.Resource()
+}
+
+// nukeInScope nukes resources that have been listed, in the us-east-1 scope, and returns the events of the nuke.
+func nukeInScope(t *testing.T, settings Settings, res ...resource.NukeableResource) ([]reporting.Event, error) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	p := fakeProvider{scopes: []string{"us-east-1"}}
+	found := &Resources{ByScope: map[string][]resource.NukeableResource{"us-east-1": res}}
+	collector, renderer := resourcetest.NewCollector()
+	err := Nuke(context.Background(), p, found, settings, collector)
+	return renderer.Events(), err
+}
+
+func TestIsNukeable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsNukeable("ec2", nil, nil))
+	assert.True(t, IsNukeable("ec2", []string{"all"}, nil))
+	assert.True(t, IsNukeable("ec2", []string{"ec2", "s3"}, nil))
+	assert.False(t, IsNukeable("iam", []string{"ec2", "s3"}, nil))
+	assert.False(t, IsNukeable("ec2", []string{"all"}, []string{"ec2"}))
+}
+
+func TestSplitGlobalScope(t *testing.T) {
+	t.Parallel()
+
+	scopes, hasGlobal := SplitGlobalScope([]string{"us-east-1", GlobalScope, "eu-west-1"})
+	assert.Equal(t, []string{"us-east-1", "eu-west-1"}, scopes)
+	assert.True(t, hasGlobal)
+
+	scopes, hasGlobal = SplitGlobalScope([]string{"us-east-1"})
+	assert.Equal(t, []string{"us-east-1"}, scopes)
+	assert.False(t, hasGlobal)
+}
+
+func TestTargets_Count(t *testing.T) {
+	t.Parallel()
+
+	targets := make(Targets)
+	targets.Add("us-east-1", "ec2", "i-1")
+	targets.Add("us-east-1", "ec2", "i-2")
+	targets.Add("global", "iam-role", "role")
+	assert.Equal(t, 3, targets.Count())
+}
+
+func TestScan(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+	disabled := errors.New("SERVICE_DISABLED")
+
+	p := fakeProvider{
+		scopes: []string{GlobalScope, "us-east-1", "us-west-2"},
+		resources: func(scope string) []resource.NukeableResource {
+			return []resource.NukeableResource{
+				newListedResource("ec2", nil, scope+"-i-1"),
+				newListedResource("excluded", nil, scope+"-x"),
+				newListedResource("disabled", disabled),
+				newListedResource("broken", errors.New("boom")),
+			}
+		},
+		ignore: func(resourceType string, err error) bool { return errors.Is(err, disabled) },
+	}
+	collector, renderer := resourcetest.NewCollector()
+	settings := Settings{ExcludeResourceTypes: []string{"excluded"}, ParallelScopes: 2}
+
+	found, err := Scan(context.Background(), p, settings, config.Config{}, collector)
+
+	require.NoError(t, err)
+	assert.Len(t, found.ByScope, 3)
+	assert.Equal(t, 3, found.TotalResourceCount())
+
+	// Found resources and errors are reported per scope, in the order of the provider's scopes
+	var reported []string
+	for _, event := range renderer.Events() {
+		switch e := event.(type) {
+		case reporting.ResourceFound:
+			reported = append(reported, e.Identifier)
+		case reporting.GeneralError:
+			reported = append(reported, e.ResourceType)
+		}
+	}
+	assert.Equal(t, []string{"global-i-1", "broken", "us-east-1-i-1", "broken", "us-west-2-i-1", "broken"}, reported)
+}
+
+func TestNuke_UsesProviderScopes(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	p := fakeProvider{scopes: []string{"us-east-1"}}
+	found := &Resources{
+		ByScope: map[string][]resource.NukeableResource{
+			"us-east-1": {newListedResource("ec2", nil, "i-1")},
+			"us-west-2": {newListedResource("ec2", nil, "i-2")},
+		},
+	}
+	for _, scopeResources := range found.ByScope {
+		_, err := scopeResources[0].GetAndSetIdentifiers(context.Background(), config.Config{})
+		require.NoError(t, err)
+	}
+	collector, renderer := resourcetest.NewCollector()
+
+	require.NoError(t, Nuke(context.Background(), p, found, Settings{}, collector))
+
+	var deleted []string
+	for _, e := range resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events()) {
+		deleted = append(deleted, e.Region+"/"+e.Identifier)
+	}
+	assert.Equal(t, []string{"us-east-1/i-1"}, deleted)
+}
+
+func TestNuke_OrdersByTransitiveDependencies(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	// vpc depends on security-group through ec2-subnet, which is not selected
+	p := fakeProvider{
+		scopes: []string{"us-east-1"},
+		dependencies: map[string][]string{
+			"vpc":            {"ec2-subnet", "security-group"},
+			"ec2-subnet":     {"security-group"},
+			"security-group": nil,
+		},
+	}
+	found := &Resources{
+		ByScope: map[string][]resource.NukeableResource{
+			"us-east-1": {newListedResource("vpc", nil, "vpc-1"), newListedResource("security-group", nil, "sg-1")},
+		},
+	}
+	for _, res := range found.ByScope["us-east-1"] {
+		_, err := res.GetAndSetIdentifiers(context.Background(), config.Config{})
+		require.NoError(t, err)
+	}
+	collector, renderer := resourcetest.NewCollector()
+
+	require.NoError(t, Nuke(context.Background(), p, found, Settings{}, collector))
+
+	var deleted []string
+	for _, e := range resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events()) {
+		deleted = append(deleted, e.Identifier)
+	}
+	assert.Equal(t, []string{"sg-1", "vpc-1"}, deleted)
+}
+
+func TestNuke_OnlyCompletesScopesListedWithoutErrors(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	j, err := journal.Create(filepath.Join(t.TempDir(), "journal.jsonl"), "fake/test", []string{"ec2", "broken"})
+	require.NoError(t, err)
+	defer j.Close()
+
+	p := fakeProvider{
+		scopes: []string{"us-east-1", "us-west-2"},
+		resources: func(scope string) []resource.NukeableResource {
+			if scope == "us-west-2" {
+				return []resource.NukeableResource{
+					newListedResource("ec2", nil, scope+"-i-1"),
+					newListedResource("broken", errors.New("boom")),
+				}
+			}
+			return []resource.NukeableResource{newListedResource("ec2", nil, scope+"-i-1")}
+		},
+	}
+	collector, _ := resourcetest.NewCollector()
+	settings := Settings{Journal: j}
+
+	found, err := Scan(context.Background(), p, settings, config.Config{}, collector)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"us-west-2": true}, found.IncompleteScopes)
+
+	require.NoError(t, Nuke(context.Background(), p, found, settings, collector))
+	assert.True(t, j.RegionComplete("us-east-1"))
+	assert.False(t, j.RegionComplete("us-west-2"))
+}
+
+func TestNuke_VerifyWaitsForAsynchronousDeletions(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+	verifyPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { verifyPollInterval = 15 * time.Second })
+
+	nukeAndVerify := func(listedAfterDeletion int, gracePeriod time.Duration) ([]reporting.Event, error) {
+		fake := resourcetest.NewFake("async", "db-1")
+		fake.Lingering = func(id string) bool {
+			listedAfterDeletion--
+			return listedAfterDeletion >= 0
+		}
+
+		p := fakeProvider{scopes: []string{"us-east-1"}}
+		found := &Resources{ByScope: map[string][]resource.NukeableResource{"us-east-1": {fake.Listed(t, config.Config{})}}}
+		collector, renderer := resourcetest.NewCollector()
+		err := Nuke(context.Background(), p, found, Settings{Verify: true, VerifyGracePeriod: gracePeriod}, collector)
+		return renderer.Events(), err
+	}
+
+	// Gone after a few listings, within the grace period
+	_, err := nukeAndVerify(3, time.Minute)
+	require.NoError(t, err)
+
+	// Still present once the grace period is over
+	events, err := nukeAndVerify(1000, 50*time.Millisecond)
+	require.ErrorContains(t, err, "1 nuked resources are still present")
+	assert.Equal(t, []reporting.ResourceStillPresent{{ResourceType: "async", Region: "us-east-1", Identifier: "db-1"}},
+		resourcetest.EventsOf[reporting.ResourceStillPresent](events))
+
+	// Listed once without a grace period
+	_, err = nukeAndVerify(1, 0)
+	require.ErrorContains(t, err, "1 nuked resources are still present")
+}
+
+// protectedConfig returns a config protecting the resources tagged with do-not-delete, on top of the default
+// exclusion tag.
+func protectedConfig() config.Config {
+	configObj := config.Config{}
+	configObj.AddProtectionTags([]config.ProtectionTag{{Key: "do-not-delete"}})
+	return configObj
+}
+
+func TestScan_SkipsProtectedResources(t *testing.T) {
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	fake := resourcetest.NewFake("ec2", "i-1", "i-2", "i-3")
+	fake.Tags = map[string]map[string]string{
+		"i-2": {"do-not-delete": "yes"},
+		"i-3": {config.DefaultAwsResourceExclusionTagKey: "true"},
+	}
+	p := fakeProvider{
+		scopes:    []string{"us-east-1"},
+		resources: func(scope string) []resource.NukeableResource { return []resource.NukeableResource{fake.Resource()} },
+	}
+	collector, _ := resourcetest.NewCollector()
+
+	found, err := Scan(context.Background(), p, Settings{}, protectedConfig(), collector)
+
+	require.NoError(t, err)
+	require.Len(t, found.ByScope["us-east-1"], 1)
+	assert.Equal(t, []string{"i-1"}, found.ByScope["us-east-1"][0].ResourceIdentifiers())
+}
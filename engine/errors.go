@@ -0,0 +1,12 @@
+package engine
+
+import "fmt"
+
+// ResourcesStillPresentError is returned when verification finds nuked identifiers that still exist.
+type ResourcesStillPresentError struct {
+	Count int
+}
+
+func (err ResourcesStillPresentError) Error() string {
+	return fmt.Sprintf("%d nuked resources are still present after verification", err.Count)
+}
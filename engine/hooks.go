@@ -0,0 +1,234 @@
+package engine
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+)
+
+const (
+	// PreDeleteHook is the event of the hooks run before a batch is deleted.
+	PreDeleteHook = "pre_delete"
+	// PostDeleteHook is the event of the hooks run once a batch is deleted.
+	PostDeleteHook = "post_delete"
+
+	// maxHookResponseSize bounds the response read from a hook.
+	maxHookResponseSize = 1 << 20
+)
+
+// HookPayload is the JSON document sent to a hook, on the standard input of commands and as the body of requests.
+type HookPayload struct {
+	Event        string   `json:"event"`
+	ResourceType string   `json:"resource_type"`
+	Region       string   `json:"region"`
+	Identifiers  []string `json:"identifiers"`
+	// Results holds the result of each deletion, for post-delete hooks.
+	Results []HookResult `json:"results,omitempty"`
+}
+
+// HookResult is the result of the deletion of an identifier, as sent to post-delete hooks.
+type HookResult struct {
+	Identifier string `json:"identifier"`
+	Success    bool   `json:"success"`
+	Error      string `json:"error,omitempty"`
+}
+
+// HookResponse is the JSON document a pre-delete hook can reply with, on its standard output or as the body of its
+// response. An empty reply vetoes nothing.
+type HookResponse struct {
+	// Veto lists the identifiers of the batch that must not be deleted.
+	Veto []string `json:"veto"`
+}
+
+// HookError is returned when a hook can't be run, fails, or replies with an invalid document.
+type HookError struct {
+	Event      string
+	Hook       string
+	Underlying error
+}
+
+func (err HookError) Error() string {
+	return fmt.Sprintf("%s hook %s failed: %v", err.Event, err.Hook, err.Underlying)
+}
+
+func (err HookError) Unwrap() error {
+	return err.Underlying
+}
+
+// HookVetoError is the deletion error of the identifiers vetoed by a pre-delete hook.
+type HookVetoError struct {
+	Hook string
+}
+
+func (err HookVetoError) Error() string {
+	return fmt.Sprintf("deletion vetoed by pre_delete hook %s", err.Hook)
+}
+
+// hooksOf returns the hooks of a resource type: those of the config, followed by those of the resource type.
+func (r *run) hooksOf(res resource.NukeableResource) config.Hooks {
+	if r.found == nil {
+		return config.Hooks{}
+	}
+	return r.found.Config.Hooks.With(res.GetAndSetResourceConfig(r.found.Config).Hooks)
+}
+
+// runPreDeleteHooks runs the pre-delete hooks of a batch, and returns the identifiers that can be deleted along with
+// the results of those that were vetoed. The hooks after the first failing one are not run.
+func runPreDeleteHooks(ctx context.Context, hooks []config.Hook, resourceType string, scope string,
+	batch []string) ([]string, []resource.NukeResult, error) {
+	allowed := batch
+	var vetoed []resource.NukeResult
+	for _, hook := range hooks {
+		if len(allowed) == 0 {
+			break
+		}
+
+		response, err := runHook(ctx, hook, HookPayload{
+			Event:        PreDeleteHook,
+			ResourceType: resourceType,
+			Region:       scope,
+			Identifiers:  allowed,
+		})
+		if err != nil {
+			return nil, vetoed, err
+		}
+
+		var remaining []string
+		for _, id := range allowed {
+			if slices.Contains(response.Veto, id) {
+				logging.Infof("[Vetoed] %s %s by pre_delete hook %s", resourceType, id, hook)
+				vetoed = append(vetoed, resource.NukeResult{Identifier: id, Error: HookVetoError{Hook: hook.String()}})
+				continue
+			}
+			remaining = append(remaining, id)
+		}
+		allowed = remaining
+	}
+	return allowed, vetoed, nil
+}
+
+// runPostDeleteHooks runs the post-delete hooks of a batch. Failures are reported as general errors, since the
+// deletions already happened.
+func runPostDeleteHooks(ctx context.Context, hooks []config.Hook, resourceType string, scope string,
+	results []resource.NukeResult, collector reporting.Emitter) {
+	if len(results) == 0 {
+		return
+	}
+
+	payload := HookPayload{Event: PostDeleteHook, ResourceType: resourceType, Region: scope}
+	for _, result := range results {
+		hookResult := HookResult{Identifier: result.Identifier, Success: result.Error == nil}
+		if result.Error != nil {
+			hookResult.Error = result.Error.Error()
+		}
+		payload.Identifiers = append(payload.Identifiers, result.Identifier)
+		payload.Results = append(payload.Results, hookResult)
+	}
+
+	for _, hook := range hooks {
+		if _, err := runHook(ctx, hook, payload); err != nil {
+			logging.Errorf("[%s] %s: %v", scope, resourceType, err)
+			collector.Emit(reporting.GeneralError{
+				ResourceType: resourceType,
+				Description:  fmt.Sprintf("Post-delete hook failed for %s in %s", resourceType, scope),
+				Error:        err.Error(),
+			})
+		}
+	}
+}
+
+// runHook runs a hook with the payload, within the timeout of the hook, and parses its reply.
+func runHook(ctx context.Context, hook config.Hook, payload HookPayload) (HookResponse, error) {
+	hookError := func(err error) error {
+		return HookError{Event: payload.Event, Hook: hook.String(), Underlying: err}
+	}
+
+	if err := hook.Validate(); err != nil {
+		return HookResponse{}, hookError(err)
+	}
+	timeout, err := hook.GetTimeout()
+	if err != nil {
+		return HookResponse{}, hookError(err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return HookResponse{}, hookError(err)
+	}
+
+	var reply []byte
+	if hook.URL != "" {
+		reply, err = postHook(ctx, hook, body)
+	} else {
+		reply, err = execHook(ctx, hook, body)
+	}
+	if err != nil {
+		return HookResponse{}, hookError(err)
+	}
+
+	var response HookResponse
+	if len(bytes.TrimSpace(reply)) == 0 {
+		return response, nil
+	}
+	if err := json.Unmarshal(reply, &response); err != nil {
+		return HookResponse{}, hookError(fmt.Errorf("invalid reply: %w", err))
+	}
+	return response, nil
+}
+
+// execHook runs the command of a hook with the body on its standard input, and returns its standard output.
+func execHook(ctx context.Context, hook config.Hook, body []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, hook.Command[0], hook.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return nil, fmt.Errorf("%w: %s", err, output)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// postHook posts the body to the URL of a hook, and returns the body of the response.
+func postHook(ctx context.Context, hook config.Hook, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range hook.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	reply, err := io.ReadAll(io.LimitReader(resp.Body, maxHookResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return reply, nil
+}
@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newHookedResource returns a fake resource type with identifiers a and b, whose hooks are read from the Custom
+// section of the config.
+func newHookedResource() *resourcetest.Fake {
+	return resourcetest.NewFake("hooked", "a", "b")
+}
+
+func newHookedRun(configObj config.Config) *run {
+	return &run{classifier: defaultErrorClassifier{}, found: &Resources{Config: configObj}}
+}
+
+func TestNukeBatch_PreDeleteHookVetoes(t *testing.T) {
+	fake := newHookedResource()
+	configObj := config.Config{Custom: map[string]*config.ResourceType{
+		"hooked": {Hooks: config.Hooks{PreDelete: []config.Hook{{
+			Command: []string{"sh", "-c", `cat > /dev/null; echo '{"veto": ["b"]}'`},
+		}}}},
+	}}
+
+	collector, renderer := resourcetest.NewCollector()
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, time.Millisecond)
+	err := newHookedRun(configObj).nukeBatch(context.Background(), fake.Resource(), "us-east-1", []string{"a", "b"}, limiter, nil, collector)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, fake.Existing())
+	results := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, results, 2)
+	assert.Equal(t, "b", results[0].Identifier)
+	assert.False(t, results[0].Success)
+	assert.Contains(t, results[0].Error, "vetoed")
+	assert.True(t, results[1].Success)
+}
+
+func TestNukeBatch_PreDeleteHookFails(t *testing.T) {
+	fake := newHookedResource()
+	configObj := config.Config{Hooks: config.Hooks{PreDelete: []config.Hook{{Command: []string{"false"}}}}}
+
+	collector, renderer := resourcetest.NewCollector()
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, time.Millisecond)
+	err := newHookedRun(configObj).nukeBatch(context.Background(), fake.Resource(), "us-east-1", []string{"a", "b"}, limiter, nil, collector)
+
+	var hookErr HookError
+	require.ErrorAs(t, err, &hookErr)
+	assert.Equal(t, PreDeleteHook, hookErr.Event)
+	assert.Equal(t, []string{"a", "b"}, fake.Existing())
+	for _, result := range resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events()) {
+		assert.False(t, result.Success)
+	}
+}
+
+func TestNukeBatch_PostDeleteHook(t *testing.T) {
+	var payloads []HookPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		var payload HookPayload
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		payloads = append(payloads, payload)
+	}))
+	defer server.Close()
+
+	fake := newHookedResource()
+	configObj := config.Config{Hooks: config.Hooks{PostDelete: []config.Hook{
+		{URL: server.URL, Headers: map[string]string{"Authorization": "secret"}},
+	}}}
+
+	collector, _ := resourcetest.NewCollector()
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, time.Millisecond)
+	err := newHookedRun(configObj).nukeBatch(context.Background(), fake.Resource(), "us-east-1", []string{"a", "b"}, limiter, nil, collector)
+
+	require.NoError(t, err)
+	require.Len(t, payloads, 1)
+	assert.Equal(t, HookPayload{
+		Event:        PostDeleteHook,
+		ResourceType: "hooked",
+		Region:       "us-east-1",
+		Identifiers:  []string{"a", "b"},
+		Results:      []HookResult{{Identifier: "a", Success: true}, {Identifier: "b", Success: true}},
+	}, payloads[0])
+}
+
+func TestRunHook_InvalidReply(t *testing.T) {
+	_, err := runHook(context.Background(), config.Hook{Command: []string{"echo", "not json"}}, HookPayload{Event: PreDeleteHook})
+
+	var hookErr HookError
+	require.ErrorAs(t, err, &hookErr)
+}
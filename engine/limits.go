@@ -0,0 +1,96 @@
+package engine
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// EnforceDeleteLimits checks the identifiers to nuke against settings.MaxDelete and the max_delete of each
+// resource type in the config. When a limit is exceeded, a resource.DeleteLimitExceededError is returned, unless
+// settings.TrimToMaxDelete is set, in which case only the identifiers within the limits are kept as targets and
+// the rest are skipped with a warning.
+func EnforceDeleteLimits(found *Resources, settings Settings) error {
+	limits := resource.DeleteLimits{
+		MaxDelete:       settings.MaxDelete,
+		MaxDeleteByType: make(map[string]int),
+	}
+	for _, scopeResources := range found.ByScope {
+		for _, res := range scopeResources {
+			if maxDelete := res.GetAndSetResourceConfig(found.Config).MaxDelete; maxDelete != nil {
+				limits.MaxDeleteByType[res.ResourceName()] = *maxDelete
+			}
+		}
+	}
+
+	targets := found.TargetList()
+	if !settings.TrimToMaxDelete {
+		return errors.WithStackTrace(limits.Check(targets))
+	}
+
+	kept, trimmed := limits.Trim(targets)
+	if len(trimmed) == 0 {
+		return nil
+	}
+
+	skipped := make(map[string]int)
+	var skippedTypes []string
+	for _, target := range trimmed {
+		if skipped[target.ResourceType] == 0 {
+			skippedTypes = append(skippedTypes, target.ResourceType)
+		}
+		skipped[target.ResourceType]++
+	}
+	for _, resourceType := range skippedTypes {
+		logging.Warnf("Skipping %d %s to stay within the delete limits", skipped[resourceType], resourceType)
+	}
+
+	found.Targets = make(Targets)
+	for _, target := range kept {
+		found.Targets.Add(target.Region, target.ResourceType, target.Identifier)
+	}
+	return nil
+}
+
+// EnforceTotalDeleteLimit checks the identifiers to nuke across several runs, e.g., the accounts of a multi-account
+// run, against settings.MaxDelete, once EnforceDeleteLimits was enforced on each of them. When the limit is exceeded,
+// a resource.DeleteLimitExceededError is returned, unless settings.TrimToMaxDelete is set, in which case identifiers
+// are kept in the order of found until the limit is reached, and the rest are skipped with a warning.
+func EnforceTotalDeleteLimit(found []*Resources, settings Settings) error {
+	if settings.MaxDelete <= 0 {
+		return nil
+	}
+
+	targetLists := make([][]resource.Target, len(found))
+	var allTargets []resource.Target
+	for i, f := range found {
+		targetLists[i] = f.TargetList()
+		allTargets = append(allTargets, targetLists[i]...)
+	}
+
+	limits := resource.DeleteLimits{MaxDelete: settings.MaxDelete}
+	if !settings.TrimToMaxDelete {
+		return errors.WithStackTrace(limits.Check(allTargets))
+	}
+
+	remaining := settings.MaxDelete
+	skipped := 0
+	for i, f := range found {
+		targets := targetLists[i]
+		kept := targets[:min(remaining, len(targets))]
+		remaining -= len(kept)
+		if len(kept) == len(targets) {
+			continue
+		}
+
+		skipped += len(targets) - len(kept)
+		f.Targets = make(Targets)
+		for _, target := range kept {
+			f.Targets.Add(target.Region, target.ResourceType, target.Identifier)
+		}
+	}
+	if skipped > 0 {
+		logging.Warnf("Skipping %d resources to stay within the limit of %d resources", skipped, settings.MaxDelete)
+	}
+	return nil
+}
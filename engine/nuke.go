@@ -0,0 +1,409 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+	"github.com/hashicorp/go-multierror"
+)
+
+// maxThrottledAttempts is the number of times a throttled deletion is attempted before it is reported as failed.
+const maxThrottledAttempts = 6
+
+// run holds the state of a nuke that carries over between passes.
+type run struct {
+	found      *Resources
+	settings   Settings
+	scopes     []string
+	classifier ErrorClassifier
+	// dependencies returns the transitive dependencies of the resource types of a scope, see Provider.
+	dependencies func(scope string) map[string][]string
+
+	// Throttling is tracked per service and scope
+	limiters *util.RateLimiters
+	breakers nukeBreakers
+}
+
+// Nuke nukes the resources found by Scan in the scopes of the provider. Up to settings.ParallelScopes scopes are
+// nuked concurrently. Global resources are nuked on their own, once all regional resources have been processed.
+// Within a scope, resources are nuked after the resources they depend on, in batches paced by a rate limiter
+// shared by all resource types of the same service.
+//
+// When settings.MaxPasses is greater than 1, identifiers that failed or warned are re-listed and retried in
+// further passes, until every deletion succeeds, a pass makes no progress, or MaxPasses is reached.
+//
+// When settings.Verify is set, the deleted identifiers are listed again once all passes are done, and those still
+// present are reported as ResourceStillPresent events and as a ResourcesStillPresentError.
+func Nuke(ctx context.Context, p Provider, found *Resources, settings Settings, collector reporting.Emitter) error {
+	// Emit NukeStarted event (CLIRenderer will initialize progress bar)
+	collector.Emit(reporting.NukeStarted{Total: found.TotalResourceCount()})
+
+	telemetry.TrackEvent(commonTelemetry.EventContext{
+		EventName: "Begin nuking resources",
+	}, map[string]interface{}{})
+
+	ctx = context.WithValue(ctx, util.ExcludeFirstSeenTagKey, settings.ExcludeFirstSeen)
+
+	maxPasses := settings.MaxPasses
+	if maxPasses < 1 {
+		maxPasses = 1
+	}
+
+	r := &run{
+		found:        found,
+		settings:     settings,
+		scopes:       p.Scopes(),
+		classifier:   errorClassifierOf(p),
+		dependencies: p.Dependencies,
+		limiters:     util.NewRateLimiters(util.DefaultThrottleInitialDelay, util.DefaultThrottleMaxDelay),
+		breakers:     newNukeBreakers(settings.CircuitBreakerThreshold),
+	}
+
+	// Errors that are not tied to a retried identifier (e.g., timeouts) are kept from every pass
+	var persistentErrors *multierror.Error
+	var passErr error
+
+	// The first pass targets every identifier found during the scan, or the planned identifiers
+	targets := found.Targets
+	deleted := make(Targets)
+	for pass := 1; pass <= maxPasses; pass++ {
+		if pass > 1 {
+			logging.Infof("Starting nuke pass %d of %d for %d resources", pass, maxPasses, targets.Count())
+			collector.Emit(reporting.NukePassStarted{Pass: pass, Total: targets.Count()})
+		}
+
+		// Deletions are only tagged with their pass when retries are enabled
+		passTag := 0
+		if maxPasses > 1 {
+			passTag = pass
+		}
+		recorder := newFailureRecorder(collector, passTag)
+		passErr = r.nukeAllScopes(ctx, targets, recorder)
+		for scope, byType := range recorder.succeeded {
+			for resourceType, identifiers := range byType {
+				for _, id := range identifiers {
+					deleted.Add(scope, resourceType, id)
+				}
+			}
+		}
+
+		if len(recorder.failed) == 0 || pass == maxPasses || ctx.Err() != nil {
+			break
+		}
+
+		retry := relistFailures(ctx, found, recorder.failed)
+		if retry.Count() == 0 {
+			// Everything that failed is gone, e.g., deletions that were still in progress
+			passErr = nil
+			break
+		}
+		if recorder.succeeded.Count() == 0 && retry.Count() == recorder.failed.Count() {
+			logging.Infof("Nuke pass %d made no progress, not retrying %d resources", pass, retry.Count())
+			break
+		}
+		if recorder.generalErrors > 0 {
+			persistentErrors = multierror.Append(persistentErrors, passErr)
+		}
+		targets = retry
+	}
+
+	if settings.Verify && deleted.Count() > 0 && ctx.Err() == nil {
+		if stillPresent := verifyNuked(ctx, found, settings, deleted, collector); stillPresent > 0 {
+			persistentErrors = multierror.Append(persistentErrors, ResourcesStillPresentError{Count: stillPresent})
+		}
+	}
+
+	// Emit NukeComplete event (triggers final output in renderers)
+	collector.Emit(reporting.NukeComplete{})
+
+	if passErr != nil {
+		persistentErrors = multierror.Append(persistentErrors, passErr)
+	}
+	return persistentErrors.ErrorOrNil()
+}
+
+// nukeAllScopes runs a single nuke pass over every scope of the run.
+func (r *run) nukeAllScopes(ctx context.Context, targets Targets, collector reporting.Emitter) error {
+	var mu sync.Mutex
+	var allErrors *multierror.Error
+
+	nukeScope := func(scope string) {
+		if targets != nil && len(targets[scope]) == 0 {
+			return
+		}
+
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: "Creating session for region",
+		}, map[string]interface{}{
+			"region": scope,
+		})
+
+		if err := r.nukeScope(ctx, scope, targets, collector); err != nil {
+			mu.Lock()
+			allErrors = multierror.Append(allErrors, err)
+			mu.Unlock()
+		} else if ctx.Err() == nil && !r.found.IncompleteScopes[scope] {
+			// Scopes are only skipped on resume once nothing in them failed, listing included
+			if err := r.settings.Journal.CompleteRegion(scope); err != nil {
+				logging.Errorf("Unable to record %s as complete in the journal: %v", scope, err)
+			}
+		}
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: "Done Nuking Region",
+		}, map[string]interface{}{
+			"region":        scope,
+			"resourceCount": len(r.found.ByScope[scope]),
+		})
+	}
+
+	regional, nukeGlobal := SplitGlobalScope(r.scopes)
+	util.ForEachConcurrently(regional, r.settings.ParallelScopes, nukeScope)
+	if nukeGlobal {
+		nukeScope(GlobalScope)
+	}
+
+	return allErrors.ErrorOrNil()
+}
+
+// nukeScope nukes the resources found in a scope. When targets is nil every identifier found during the scan is
+// nuked, otherwise only the identifiers listed in targets for the scope are. Identifiers that are not nukable, or
+// that the journal records as already nuked, are skipped.
+// A resource type whose deletions keep failing the same way is skipped once its circuit breaker trips, and so is
+// the rest of the scope once several resource types in a row tripped.
+func (r *run) nukeScope(ctx context.Context, scope string, targets Targets, collector reporting.Emitter) error {
+	var allErrors *multierror.Error
+
+	orderedResources, err := resource.SortByDependencies(r.found.ByScope[scope], resourceName,
+		transitiveDependencies(r.dependencies(scope)))
+	if err != nil {
+		return fmt.Errorf("[%s] unable to determine deletion order: %w", scope, err)
+	}
+
+	pendingIdentifiers := func(res resource.NukeableResource) []string {
+		identifiers := res.ResourceIdentifiers()
+		if targets != nil {
+			identifiers = targets[scope][res.ResourceName()]
+		}
+
+		var pending []string
+		for _, id := range r.settings.Journal.Pending(scope, res.ResourceName(), identifiers) {
+			if nukable, reason := res.IsNukable(id); !nukable {
+				logging.Debugf("[Skipping] %s %s because %v", res.ResourceName(), id, reason)
+				continue
+			}
+			pending = append(pending, id)
+		}
+		return pending
+	}
+
+	scopeBreaker := r.breakers.scopes.For(scope)
+	for i, res := range orderedResources {
+		if tripped, _ := scopeBreaker.Tripped(); tripped {
+			logging.Debugf("[%s] Skipping the remaining resource types, the circuit breaker tripped", scope)
+			return allErrors.ErrorOrNil()
+		}
+
+		identifiers := pendingIdentifiers(res)
+		if len(identifiers) == 0 {
+			continue
+		}
+
+		breaker := r.breakers.resourceTypes.For(scope + "/" + res.ResourceName())
+		if tripped, _ := breaker.Tripped(); tripped {
+			logging.Debugf("[%s] Skipping %s, its circuit breaker tripped", scope, res.ResourceName())
+			continue
+		}
+
+		// Split api calls into batches
+		logging.Debugf("Terminating %d %s in batches", len(identifiers), res.ResourceName())
+		batches := util.Split(identifiers, res.MaxBatchSize())
+		limiter := r.limiters.For(scope + "/" + res.ServiceName())
+
+		for b, batch := range batches {
+			// No new batches are started once the run is cancelled
+			if ctx.Err() != nil {
+				return allErrors.ErrorOrNil()
+			}
+
+			// Emit progress event (CLIRenderer updates its progress bar)
+			collector.Emit(reporting.NukeProgress{
+				ResourceType: res.ResourceName(),
+				Region:       scope,
+				BatchSize:    len(batch),
+			})
+
+			err := r.nukeBatch(ctx, res, scope, batch, limiter, breaker, collector)
+			if err != nil {
+				// Stop nuking the scope once the run is cancelled
+				if ctx.Err() != nil {
+					return multierror.Append(allErrors, fmt.Errorf("[%s] %s: %w", scope, res.ResourceName(), err)).ErrorOrNil()
+				}
+
+				allErrors = multierror.Append(allErrors, fmt.Errorf("[%s] %s: %w", scope, res.ResourceName(), err))
+
+				// A batch that ran out of time is reported once, and the remaining batches of this
+				// resource type are skipped so the next resource type can proceed
+				if util.IsResourceExecutionTimeout(err) {
+					collector.Emit(reporting.GeneralError{
+						ResourceType: res.ResourceName(),
+						Description:  fmt.Sprintf("Timed out nuking %s in %s", res.ResourceName(), scope),
+						Error:        err.Error(),
+					})
+					break
+				}
+
+				// Report to telemetry - aggregated metrics of failures per resources.
+				telemetry.TrackEvent(commonTelemetry.EventContext{
+					EventName: fmt.Sprintf("error:Nuke:%s", res.ResourceName()),
+				}, map[string]interface{}{
+					"region": scope,
+				})
+			}
+
+			// The remaining batches of a resource type that keeps failing the same way are skipped
+			if tripped, class := breaker.Tripped(); tripped {
+				skipped := 0
+				for _, remaining := range batches[b+1:] {
+					skipped += len(remaining)
+				}
+				allErrors = multierror.Append(allErrors,
+					reportCircuitBreakerTrip(collector, res.ResourceName(), scope, class, skipped))
+				break
+			}
+		}
+
+		// Only resource types attempted in this pass count towards the scope's circuit breaker
+		tripped, class := breaker.Tripped()
+		if !tripped {
+			scopeBreaker.Succeeded()
+			continue
+		}
+		if scopeBreaker.Failed(class) {
+			skipped := 0
+			for _, remaining := range orderedResources[i+1:] {
+				skipped += len(pendingIdentifiers(remaining))
+			}
+			allErrors = multierror.Append(allErrors, reportCircuitBreakerTrip(collector, "", scope, class, skipped))
+			return allErrors.ErrorOrNil()
+		}
+	}
+
+	return allErrors.ErrorOrNil()
+}
+
+// nukeBatch nukes a batch of identifiers and emits a ResourceDeleted event for each of them. The pre-delete hooks
+// of the resource type run first, and can veto identifiers; when one of them fails, the batch isn't deleted. The
+// post-delete hooks run once the batch is deleted. Identifiers whose deletion was throttled are retried, after
+// backing off, until they are no longer throttled or maxThrottledAttempts is reached.
+func (r *run) nukeBatch(ctx context.Context, res resource.NukeableResource, scope string, batch []string,
+	limiter *util.AdaptiveRateLimiter, breaker *util.CircuitBreaker, collector reporting.Emitter) error {
+	hooks := r.hooksOf(res)
+
+	pending, vetoed, err := runPreDeleteHooks(ctx, hooks.PreDelete, res.ResourceName(), scope, batch)
+	if err != nil {
+		logging.Errorf("[%s] %s: %v", scope, res.ResourceName(), err)
+		for _, id := range batch {
+			if !slices.ContainsFunc(vetoed, func(result resource.NukeResult) bool { return result.Identifier == id }) {
+				vetoed = append(vetoed, resource.NukeResult{Identifier: id, Error: err})
+			}
+		}
+		r.reportResults(res, scope, vetoed, breaker, collector)
+		return err
+	}
+	r.reportResults(res, scope, vetoed, breaker, collector)
+
+	var deleted []resource.NukeResult
+	var nukeErr error
+	for attempt := 1; len(pending) > 0; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			nukeErr = err
+			break
+		}
+
+		results, err := res.Nuke(ctx, pending)
+
+		var throttled []string
+		var final []resource.NukeResult
+		for _, result := range results {
+			if result.Error != nil && r.classifier.IsThrottlingError(result.Error) && attempt < maxThrottledAttempts {
+				throttled = append(throttled, result.Identifier)
+				continue
+			}
+			final = append(final, result)
+		}
+		r.reportResults(res, scope, final, breaker, collector)
+		deleted = append(deleted, final...)
+
+		if len(throttled) == 0 {
+			limiter.Succeeded()
+			nukeErr = err
+			break
+		}
+
+		backoff := limiter.Throttled()
+		logging.Debugf("[%s] %d %s deletions were throttled, retrying in %s (attempt %d of %d)",
+			scope, len(throttled), res.ResourceName(), backoff, attempt+1, maxThrottledAttempts)
+		pending = throttled
+	}
+
+	// The deletions happened, so the post-delete hooks run even when the run is cancelled
+	runPostDeleteHooks(context.WithoutCancel(ctx), hooks.PostDelete, res.ResourceName(), scope, deleted, collector)
+	return nukeErr
+}
+
+// reportResults emits a ResourceDeleted event for the final result of each deletion, and records the results in
+// the circuit breaker and in the journal. Warnings and vetoes neither count as failures nor as successes.
+func (r *run) reportResults(res resource.NukeableResource, scope string, results []resource.NukeResult,
+	breaker *util.CircuitBreaker, collector reporting.Emitter) {
+	for _, result := range results {
+		errStr := ""
+		warning := result.Error != nil && r.classifier.IsWarningError(result.Error)
+		var veto HookVetoError
+		switch {
+		case result.Error == nil:
+			breaker.Succeeded()
+		case errors.As(result.Error, &veto):
+		case !warning:
+			breaker.Failed(r.classifier.ErrorClass(result.Error))
+		}
+		if result.Error != nil {
+			errStr = result.Error.Error()
+		}
+		collector.Emit(reporting.ResourceDeleted{
+			ResourceType: res.ResourceName(),
+			Region:       scope,
+			Identifier:   result.Identifier,
+			Success:      result.Error == nil,
+			Warning:      warning,
+			Error:        errStr,
+		})
+	}
+
+	if journalErr := r.settings.Journal.Record(scope, res.ResourceName(), results); journalErr != nil {
+		logging.Errorf("Unable to record %s deletions in the journal: %v", res.ResourceName(), journalErr)
+	}
+}
+
+func resourceName(res resource.NukeableResource) string {
+	return res.ResourceName()
+}
+
+// transitiveDependencies returns the dependencies of a resource from the transitive dependencies of its scope,
+// falling back to the ones it declares for resource types that are not registered in the scope.
+func transitiveDependencies(dependencies map[string][]string) func(res resource.NukeableResource) []string {
+	return func(res resource.NukeableResource) []string {
+		if deps, ok := dependencies[res.ResourceName()]; ok {
+			return deps
+		}
+		return res.Dependencies()
+	}
+}
@@ -0,0 +1,120 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRun() *run {
+	return &run{classifier: defaultErrorClassifier{}}
+}
+
+func TestNukeBatch_RetriesThrottledDeletions(t *testing.T) {
+	attempts := make(map[string]int)
+	res := &resource.Resource[struct{}]{
+		ResourceTypeName: "throttled",
+		Nuker: func(ctx context.Context, client struct{}, scope resource.Scope, resourceType string, ids []*string) []resource.NukeResult {
+			var results []resource.NukeResult
+			for _, id := range ids {
+				attempts[*id]++
+				var err error
+				if *id == "b" && attempts[*id] < 3 {
+					err = &smithy.GenericAPIError{Code: "ThrottlingException"}
+				}
+				results = append(results, resource.NukeResult{Identifier: *id, Error: err})
+			}
+			return results
+		},
+	}
+	res.Init(nil)
+
+	collector, renderer := resourcetest.NewCollector()
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, 10*time.Millisecond)
+
+	err := newTestRun().nukeBatch(context.Background(), res, "us-east-1", []string{"a", "b"}, limiter, nil, collector)
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"a": 1, "b": 3}, attempts)
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, deleted, 2)
+	for _, e := range deleted {
+		assert.True(t, e.Success)
+	}
+}
+
+func TestNukeBatch_GivesUpAfterMaxThrottledAttempts(t *testing.T) {
+	res := &resource.Resource[struct{}]{
+		ResourceTypeName: "throttled",
+		Nuker: func(ctx context.Context, client struct{}, scope resource.Scope, resourceType string, ids []*string) []resource.NukeResult {
+			return []resource.NukeResult{{Identifier: *ids[0], Error: &smithy.GenericAPIError{Code: "RequestLimitExceeded"}}}
+		},
+	}
+	res.Init(nil)
+
+	collector, renderer := resourcetest.NewCollector()
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, time.Millisecond)
+
+	err := newTestRun().nukeBatch(context.Background(), res, "us-east-1", []string{"a"}, limiter, nil, collector)
+
+	require.Error(t, err)
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, deleted, 1)
+	assert.False(t, deleted[0].Success)
+}
+
+func TestNukeBatch_StopsWhenCancelled(t *testing.T) {
+	res := &resource.Resource[struct{}]{
+		ResourceTypeName: "throttled",
+		Nuker: func(ctx context.Context, client struct{}, scope resource.Scope, resourceType string, ids []*string) []resource.NukeResult {
+			return []resource.NukeResult{{Identifier: *ids[0], Error: &smithy.GenericAPIError{Code: "ThrottlingException"}}}
+		},
+	}
+	res.Init(nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	limiter := util.NewAdaptiveRateLimiter(time.Minute, time.Minute)
+
+	err := newTestRun().nukeBatch(ctx, res, "us-east-1", []string{"a"}, limiter, nil, reporting.NewCollector())
+
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+// quotaClassifier treats every error as throttling, like GCP quota errors.
+type quotaClassifier struct {
+	defaultErrorClassifier
+}
+
+func (quotaClassifier) IsThrottlingError(err error) bool { return true }
+
+func TestNukeBatch_UsesProviderErrorClassifier(t *testing.T) {
+	attempts := 0
+	res := &resource.Resource[struct{}]{
+		ResourceTypeName: "quota",
+		Nuker: func(ctx context.Context, client struct{}, scope resource.Scope, resourceType string, ids []*string) []resource.NukeResult {
+			attempts++
+			if attempts == 1 {
+				return []resource.NukeResult{{Identifier: *ids[0], Error: assert.AnError}}
+			}
+			return []resource.NukeResult{{Identifier: *ids[0]}}
+		},
+	}
+	res.Init(nil)
+
+	r := &run{classifier: quotaClassifier{}}
+	limiter := util.NewAdaptiveRateLimiter(time.Millisecond, time.Millisecond)
+
+	err := r.nukeBatch(context.Background(), res, "global", []string{"bucket"}, limiter, nil, reporting.NewCollector())
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+}
@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// failureRecorder forwards events to the collector while keeping track of the outcome of each deletion,
+// so that identifiers which failed or warned can be retried in the next pass, and identifiers which were
+// deleted can be verified once the nuke is complete.
+type failureRecorder struct {
+	collector reporting.Emitter
+	pass      int
+
+	mu            sync.Mutex
+	failed        Targets
+	succeeded     Targets
+	generalErrors int
+}
+
+func newFailureRecorder(collector reporting.Emitter, pass int) *failureRecorder {
+	return &failureRecorder{
+		collector: collector,
+		pass:      pass,
+		failed:    make(Targets),
+		succeeded: make(Targets),
+	}
+}
+
+// Emit records the outcome carried by the event, then forwards it to the collector.
+// Deletion results are tagged with the pass they belong to.
+func (f *failureRecorder) Emit(event reporting.Event) {
+	f.mu.Lock()
+	switch e := event.(type) {
+	case reporting.ResourceDeleted:
+		e.Pass = f.pass
+		event = e
+		if e.Success {
+			f.succeeded.Add(e.Region, e.ResourceType, e.Identifier)
+		} else {
+			f.failed.Add(e.Region, e.ResourceType, e.Identifier)
+		}
+	case reporting.GeneralError:
+		f.generalErrors++
+	}
+	f.mu.Unlock()
+
+	f.collector.Emit(event)
+}
+
+// relistFailures lists the resource types that had failures again, and returns the failed identifiers that
+// still exist. Identifiers that could not be re-listed are retried as they are.
+func relistFailures(ctx context.Context, found *Resources, failed Targets) Targets {
+	retry := make(Targets)
+	for scope, byType := range failed {
+		for _, res := range found.ByScope[scope] {
+			resourceName := res.ResourceName()
+			identifiers, ok := byType[resourceName]
+			if !ok {
+				continue
+			}
+
+			current, err := res.GetAndSetIdentifiers(ctx, found.Config)
+			if err != nil {
+				logging.Debugf("Unable to re-list %s in %s, retrying all failed identifiers: %v", resourceName, scope, err)
+				current = identifiers
+			}
+
+			for _, id := range identifiers {
+				if collections.ListContainsElement(current, id) {
+					retry.Add(scope, resourceName, id)
+				}
+			}
+		}
+	}
+	return retry
+}
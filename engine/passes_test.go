@@ -0,0 +1,105 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/journal"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyResource returns a listed resource whose identifiers fail to delete the given number of times before
+// succeeding.
+func newFlakyResource(t *testing.T, ids []string, failures map[string]int) resource.NukeableResource {
+	fake := resourcetest.NewFake("flaky", ids...)
+	fake.DeleteErr = func(id string) error {
+		if failures[id] > 0 {
+			failures[id]--
+			return errors.New("DependencyViolation")
+		}
+		return nil
+	}
+	return fake.Listed(t, config.Config{})
+}
+
+func TestNuke_SinglePass(t *testing.T) {
+	res := newFlakyResource(t, []string{"a", "b"}, map[string]int{"b": 1})
+
+	events, err := nukeInScope(t, Settings{MaxPasses: 1}, res)
+
+	require.Error(t, err)
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](events)
+	assert.Len(t, deleted, 2)
+	for _, e := range deleted {
+		assert.Zero(t, e.Pass)
+	}
+}
+
+func TestNuke_RetriesFailuresInLaterPasses(t *testing.T) {
+	res := newFlakyResource(t, []string{"a", "b", "c"}, map[string]int{"b": 1, "c": 2})
+
+	events, err := nukeInScope(t, Settings{MaxPasses: 5}, res)
+
+	require.NoError(t, err)
+	assert.Equal(t, []reporting.NukePassStarted{{Pass: 2, Total: 2}, {Pass: 3, Total: 1}},
+		resourcetest.EventsOf[reporting.NukePassStarted](events))
+
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](events)
+	require.Len(t, deleted, 6)
+	last := deleted[len(deleted)-1]
+	assert.Equal(t, "c", last.Identifier)
+	assert.Equal(t, 3, last.Pass)
+	assert.True(t, last.Success)
+}
+
+func TestNuke_StopsAtMaxPasses(t *testing.T) {
+	res := newFlakyResource(t, []string{"a", "b", "c", "d"}, map[string]int{"b": 1, "c": 2, "d": 5})
+
+	events, err := nukeInScope(t, Settings{MaxPasses: 2}, res)
+
+	require.Error(t, err)
+	assert.Len(t, resourcetest.EventsOf[reporting.ResourceDeleted](events), 7)
+}
+
+func TestNuke_StopsWithoutProgress(t *testing.T) {
+	res := newFlakyResource(t, []string{"a"}, map[string]int{"a": 10})
+
+	events, err := nukeInScope(t, Settings{MaxPasses: 5}, res)
+
+	require.Error(t, err)
+	assert.Len(t, resourcetest.EventsOf[reporting.ResourceDeleted](events), 1)
+}
+
+func TestNuke_ResumesFromJournal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	// The first run nukes one identifier before its credentials expire
+	j, err := journal.Create(path, "fake/test", []string{"flaky"})
+	require.NoError(t, err)
+	require.NoError(t, j.Record("us-east-1", "flaky", []resource.NukeResult{{Identifier: "a"}}))
+	require.NoError(t, j.Close())
+
+	j, err = journal.Resume(path, "fake/test", []string{"flaky"})
+	require.NoError(t, err)
+	defer j.Close()
+
+	res := newFlakyResource(t, []string{"a", "b"}, nil)
+	collector, renderer := resourcetest.NewCollector()
+	p := fakeProvider{scopes: []string{"us-east-1", "us-west-2"}}
+	found := &Resources{ByScope: map[string][]resource.NukeableResource{"us-east-1": {res}}}
+
+	require.NoError(t, Nuke(t.Context(), p, found, Settings{Journal: j}, collector))
+
+	deleted := resourcetest.EventsOf[reporting.ResourceDeleted](renderer.Events())
+	require.Len(t, deleted, 1)
+	assert.Equal(t, "b", deleted[0].Identifier)
+	assert.True(t, j.Succeeded("us-east-1", "flaky", "b"))
+	assert.True(t, j.RegionComplete("us-east-1"))
+	assert.True(t, j.RegionComplete("us-west-2"))
+}
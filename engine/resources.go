@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+)
+
+// Targets holds identifiers to nuke, keyed by scope and then by resource type.
+type Targets map[string]map[string][]string
+
+// Add adds an identifier of a resource type in a scope.
+func (t Targets) Add(scope string, resourceType string, identifier string) {
+	if t[scope] == nil {
+		t[scope] = make(map[string][]string)
+	}
+	t[scope][resourceType] = append(t[scope][resourceType], identifier)
+}
+
+// Count returns the total number of identifiers across all scopes and resource types.
+func (t Targets) Count() int {
+	total := 0
+	for _, byType := range t {
+		for _, identifiers := range byType {
+			total += len(identifiers)
+		}
+	}
+	return total
+}
+
+// Resources are the resources found by a scan, along with what to nuke.
+type Resources struct {
+	// ByScope holds the resources with identifiers found in each scope.
+	ByScope map[string][]resource.NukeableResource
+	// Config is the config the resources were listed with, kept so resources can be re-listed between passes.
+	Config config.Config
+	// Targets restricts nuking to these identifiers, e.g., when nuking a plan. Nil nukes every identifier found.
+	Targets Targets
+	// IncompleteScopes holds the scopes where a resource type couldn't be listed. They are never recorded as complete
+	// in the journal, so that they are scanned again when the run is resumed.
+	IncompleteScopes map[string]bool
+}
+
+// identifiers returns the identifiers of a resource to nuke in a scope.
+func (r *Resources) identifiers(scope string, res resource.NukeableResource) []string {
+	if r.Targets != nil {
+		return r.Targets[scope][res.ResourceName()]
+	}
+	return res.ResourceIdentifiers()
+}
+
+// TotalResourceCount returns the number of identifiers to nuke across all scopes.
+func (r *Resources) TotalResourceCount() int {
+	if r.Targets != nil {
+		return r.Targets.Count()
+	}
+	total := 0
+	for _, found := range r.ByScope {
+		for _, res := range found {
+			total += len(res.ResourceIdentifiers())
+		}
+	}
+	return total
+}
+
+// TargetList returns the nukable identifiers to nuke, ordered by scope and then in the order resources were found.
+func (r *Resources) TargetList() []resource.Target {
+	scopes := make([]string, 0, len(r.ByScope))
+	for scope := range r.ByScope {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+
+	var targets []resource.Target
+	for _, scope := range scopes {
+		for _, res := range r.ByScope[scope] {
+			for _, id := range r.identifiers(scope, res) {
+				if nukable, _ := res.IsNukable(id); !nukable {
+					continue
+				}
+				targets = append(targets, resource.Target{Region: scope, ResourceType: res.ResourceName(), Identifier: id})
+			}
+		}
+	}
+	return targets
+}
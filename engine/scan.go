@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+)
+
+// Scan lists the resources of the selected resource types in every scope of the provider.
+//
+// Scopes are scanned concurrently, up to settings.ParallelScopes at a time, and the global pseudo-scope on its own
+// after the others. Scan progress is emitted as it happens, while the resources found and the errors of each scope
+// are emitted as one contiguous block per scope, in the order of the provider's scopes. Scopes the journal records
+// as complete are not scanned.
+func Scan(ctx context.Context, p Provider, settings Settings, configObj config.Config,
+	collector reporting.Emitter) (*Resources, error) {
+	found := &Resources{
+		ByScope: make(map[string][]resource.NukeableResource),
+		Config:  configObj,
+	}
+
+	ctx = context.WithValue(ctx, util.ExcludeFirstSeenTagKey, settings.ExcludeFirstSeen)
+
+	scopes := p.Scopes()
+	var mu sync.Mutex
+	var scanErr error
+	scopeResources := make(map[string][]resource.NukeableResource, len(scopes))
+	scopeEvents := make(map[string]*reporting.EventBuffer, len(scopes))
+
+	scanScope := func(scope string) {
+		if settings.Journal.RegionComplete(scope) {
+			logging.Infof("Skipping %s: all its resources were nuked by the resumed run", scope)
+			return
+		}
+
+		registered, err := p.Resources(ctx, scope)
+		if err != nil {
+			mu.Lock()
+			if scanErr == nil {
+				scanErr = err
+			}
+			mu.Unlock()
+			return
+		}
+
+		events := &reporting.EventBuffer{}
+		listed, complete := scanResources(ctx, p, scope, registered, settings, configObj, collector, events)
+
+		mu.Lock()
+		scopeResources[scope] = listed
+		scopeEvents[scope] = events
+		if !complete {
+			if found.IncompleteScopes == nil {
+				found.IncompleteScopes = make(map[string]bool)
+			}
+			found.IncompleteScopes[scope] = true
+		}
+		mu.Unlock()
+	}
+
+	regional, scanGlobal := SplitGlobalScope(scopes)
+	util.ForEachConcurrently(regional, settings.ParallelScopes, scanScope)
+	if scanGlobal && scanErr == nil {
+		scanScope(GlobalScope)
+	}
+
+	if scanErr != nil {
+		return nil, scanErr
+	}
+
+	for _, scope := range scopes {
+		if events, ok := scopeEvents[scope]; ok {
+			events.FlushTo(collector)
+		}
+		if len(scopeResources[scope]) > 0 {
+			found.ByScope[scope] = scopeResources[scope]
+		}
+	}
+	return found, nil
+}
+
+// scanResources lists the selected resource types of a single scope, and returns those with identifiers, along with
+// whether every resource type was listed. Scan progress is emitted directly to the collector, while found resources
+// and errors are emitted to events.
+func scanResources(ctx context.Context, p Provider, scope string, registered []resource.NukeableResource,
+	settings Settings, configObj config.Config, collector reporting.Emitter, events reporting.Emitter) ([]resource.NukeableResource, bool) {
+	listErrorFilter, _ := p.(ListErrorFilter)
+
+	var found []resource.NukeableResource
+	complete := true
+	for _, res := range registered {
+		// Stop scanning once the run is cancelled, keeping what was found so far
+		if ctx.Err() != nil {
+			complete = false
+			break
+		}
+		resourceName := res.ResourceName()
+		if !IsNukeable(resourceName, settings.ResourceTypes, settings.ExcludeResourceTypes) {
+			continue
+		}
+
+		res.GetAndSetResourceConfig(configObj)
+
+		// Emit scan progress event
+		collector.Emit(reporting.ScanProgress{
+			ResourceType: resourceName,
+			Region:       scope,
+		})
+
+		start := time.Now()
+		identifiers, err := res.GetAndSetIdentifiers(ctx, configObj)
+		if err != nil {
+			if listErrorFilter != nil && listErrorFilter.IgnoreListError(resourceName, err) {
+				logging.Debugf("Skipping %s in %s: %v", resourceName, scope, err)
+				continue
+			}
+			logging.Errorf("Unable to retrieve %v, %v", resourceName, err)
+			complete = false
+
+			// Reporting resource-level failures encountered during the GetIdentifiers phase
+			telemetry.TrackEvent(commonTelemetry.EventContext{
+				EventName: fmt.Sprintf("error:GetIdentifiers:%s", resourceName),
+			}, map[string]interface{}{
+				"region": scope,
+			})
+
+			events.Emit(reporting.GeneralError{
+				ResourceType: resourceName,
+				Description:  fmt.Sprintf("Unable to retrieve %s", resourceName),
+				Error:        err.Error(),
+			})
+		}
+
+		telemetry.TrackEvent(commonTelemetry.EventContext{
+			EventName: fmt.Sprintf("Done getting %s identifiers", resourceName),
+		}, map[string]interface{}{
+			"recordCount": len(identifiers),
+			"actionTime":  time.Since(start).Seconds(),
+		})
+
+		// Only keep resources with identifiers
+		if len(identifiers) > 0 {
+			logging.Infof("Found %d %s resources in %s", len(identifiers), resourceName, scope)
+			found = append(found, res)
+
+			// Emit ResourceFound events for each identifier
+			for _, id := range identifiers {
+				events.Emit(NewResourceFound(res, scope, id))
+			}
+		}
+	}
+	return found, complete
+}
+
+// NewResourceFound builds the ResourceFound event of an identifier, including the details provided by its lister.
+func NewResourceFound(res resource.NukeableResource, scope string, id string) reporting.ResourceFound {
+	details := res.Details(id)
+	event := reporting.ResourceFound{
+		ResourceType: res.ResourceName(),
+		Region:       scope,
+		Identifier:   id,
+		Nukable:      true,
+		ARN:          details.ARN,
+		Name:         details.Name,
+		CreatedAt:    details.CreatedAt,
+		Tags:         details.Tags,
+		Attributes:   details.Attributes,
+	}
+	if _, err := res.IsNukable(id); err != nil {
+		event.Nukable, event.Reason = false, err.Error()
+	}
+	return event
+}
@@ -0,0 +1,113 @@
+package engine
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// UnfilteredConfig returns configObj without any filters, so that listing returns every resource that could have
+// been targeted, e.g., to nuke the resources of a plan or to verify deletions. The hooks and the protection tags of
+// configObj are kept, so that hooks run and protected resources are never listed.
+func UnfilteredConfig(configObj config.Config, timeout *time.Duration) config.Config {
+	unfiltered := configObj.OnlyHooksAndProtection()
+	unfiltered.AddTimeout(timeout)
+	unfiltered.KMSCustomerKeys.IncludeUnaliasedKeys = true
+	return unfiltered
+}
+
+// verifyPollInterval is the time between two listings of the deleted identifiers that are still present. It is a
+// variable so that tests don't wait.
+var verifyPollInterval = 15 * time.Second
+
+// verifyNuked lists the resource types with deleted identifiers again, without any filters, until none of the
+// deleted identifiers is present or settings.VerifyGracePeriod is over. A ResourceStillPresent event is then emitted
+// for each deleted identifier that still exists. Returns the number of identifiers that are still present.
+func verifyNuked(ctx context.Context, found *Resources, settings Settings, deleted Targets,
+	collector reporting.Emitter) int {
+	logging.Infof("Verifying that %d nuked resources are gone", deleted.Count())
+
+	configObj := UnfilteredConfig(found.Config, settings.Timeout)
+	deadline := time.Now().Add(settings.VerifyGracePeriod)
+
+	stillPresent := deleted
+	for {
+		stillPresent = listStillPresent(ctx, found, configObj, stillPresent, collector)
+		if stillPresent.Count() == 0 || ctx.Err() != nil || !time.Now().Before(deadline) {
+			break
+		}
+
+		wait := min(verifyPollInterval, time.Until(deadline))
+		logging.Infof("%d nuked resources are still present, listing them again in %s", stillPresent.Count(),
+			wait.Round(time.Second))
+		select {
+		case <-ctx.Done():
+		case <-time.After(wait):
+		}
+	}
+
+	for _, scope := range sortedScopes(stillPresent) {
+		for _, res := range found.ByScope[scope] {
+			resourceName := res.ResourceName()
+			for _, id := range stillPresent[scope][resourceName] {
+				logging.Warnf("[%s] %s %s was nuked but is still present", scope, resourceName, id)
+				collector.Emit(reporting.ResourceStillPresent{
+					ResourceType: resourceName,
+					Region:       scope,
+					Identifier:   id,
+				})
+			}
+		}
+	}
+	return stillPresent.Count()
+}
+
+// listStillPresent lists the resource types with deleted identifiers again, and returns the deleted identifiers that
+// still exist. Resource types that can't be listed are reported as general errors, and left out.
+func listStillPresent(ctx context.Context, found *Resources, configObj config.Config, deleted Targets,
+	collector reporting.Emitter) Targets {
+	stillPresent := make(Targets)
+	for _, scope := range sortedScopes(deleted) {
+		byType := deleted[scope]
+		for _, res := range found.ByScope[scope] {
+			resourceName := res.ResourceName()
+			identifiers, ok := byType[resourceName]
+			if !ok || ctx.Err() != nil {
+				continue
+			}
+
+			current, err := res.GetAndSetIdentifiers(ctx, configObj)
+			if err != nil {
+				logging.Errorf("Unable to verify %s in %s: %v", resourceName, scope, err)
+				collector.Emit(reporting.GeneralError{
+					ResourceType: resourceName,
+					Description:  "Unable to verify that nuked " + resourceName + " are gone in " + scope,
+					Error:        err.Error(),
+				})
+				continue
+			}
+
+			for _, id := range identifiers {
+				if collections.ListContainsElement(current, id) {
+					stillPresent.Add(scope, resourceName, id)
+				}
+			}
+		}
+	}
+	return stillPresent
+}
+
+// sortedScopes returns the scopes of targets in alphabetical order.
+func sortedScopes(targets Targets) []string {
+	scopes := make([]string, 0, len(targets))
+	for scope := range targets {
+		scopes = append(scopes, scope)
+	}
+	sort.Strings(scopes)
+	return scopes
+}
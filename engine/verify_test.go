@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newLingeringResource returns a listed resource whose deletions all succeed, but whose lingering identifiers are
+// still listed afterwards, like an asynchronous deletion that has not completed.
+func newLingeringResource(t *testing.T, lingering map[string]bool, ids ...string) resource.NukeableResource {
+	fake := resourcetest.NewFake("lingering", ids...)
+	fake.Lingering = func(id string) bool { return lingering[id] }
+	return fake.Listed(t, config.Config{})
+}
+
+func TestNuke_VerifyReportsResourcesStillPresent(t *testing.T) {
+	res := newLingeringResource(t, map[string]bool{"b": true}, "a", "b")
+
+	events, err := nukeInScope(t, Settings{Verify: true}, res)
+
+	require.ErrorContains(t, err, "1 nuked resources are still present")
+	assert.Equal(t, []reporting.ResourceStillPresent{
+		{ResourceType: "lingering", Region: "us-east-1", Identifier: "b"},
+	}, resourcetest.EventsOf[reporting.ResourceStillPresent](events))
+
+	// Resources still present are reported before the nuke completes, so renderers include them
+	assert.IsType(t, reporting.NukeComplete{}, events[len(events)-1])
+}
+
+func TestNuke_VerifyPassesWhenResourcesAreGone(t *testing.T) {
+	res := newLingeringResource(t, nil, "a", "b")
+
+	events, err := nukeInScope(t, Settings{Verify: true}, res)
+
+	require.NoError(t, err)
+	assert.Empty(t, resourcetest.EventsOf[reporting.ResourceStillPresent](events))
+}
+
+func TestNuke_WithoutVerify(t *testing.T) {
+	res := newLingeringResource(t, map[string]bool{"b": true}, "a", "b")
+
+	events, err := nukeInScope(t, Settings{}, res)
+
+	require.NoError(t, err)
+	assert.Empty(t, resourcetest.EventsOf[reporting.ResourceStillPresent](events))
+}
+
+func TestNuke_VerifyKeepsProtectionTags(t *testing.T) {
+	fake := resourcetest.NewFake("lingering", "a", "b")
+	fake.Lingering = func(id string) bool { return true }
+	res := fake.Listed(t, config.Config{})
+
+	// b is protected while its deletion completes, and is no longer listed
+	fake.Tags = map[string]map[string]string{"b": {"do-not-delete": "yes"}}
+	p := fakeProvider{scopes: []string{"us-east-1"}}
+	found := &Resources{ByScope: map[string][]resource.NukeableResource{"us-east-1": {res}}, Config: protectedConfig()}
+	collector, renderer := resourcetest.NewCollector()
+
+	err := Nuke(t.Context(), p, found, Settings{Verify: true}, collector)
+
+	require.ErrorContains(t, err, "1 nuked resources are still present")
+	assert.Equal(t, []reporting.ResourceStillPresent{
+		{ResourceType: "lingering", Region: "us-east-1", Identifier: "a"},
+	}, resourcetest.EventsOf[reporting.ResourceStillPresent](renderer.Events()))
+}
@@ -2,11 +2,17 @@ package externalcreds
 
 import (
 	"context"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
+// AssumedRoleSessionName is the session name of the roles assumed by cloud-nuke, as shown in CloudTrail.
+const AssumedRoleSessionName = "cloud-nuke"
+
 // configProvider is an optional override for AWS config creation.
 // When non-nil, Get delegates to this function instead of using LoadDefaultConfig.
 var configProvider func(region string) (aws.Config, error)
@@ -36,3 +42,48 @@ func Get(region string) (aws.Config, error) {
 
 	return cfg, nil
 }
+
+// AssumeRoleProvider returns a config provider, to pass to SetConfigProvider, whose configs assume the role
+// roleARN with the credentials Get currently returns. This is how cloud-nuke operates on the other accounts of an
+// AWS Organization. The role is assumed once, and its credentials are shared by the configs of every region and
+// refreshed before they expire.
+func AssumeRoleProvider(roleARN string) func(region string) (aws.Config, error) {
+	base := configProvider
+	get := func(region string) (aws.Config, error) {
+		if base != nil {
+			return base(region)
+		}
+		return config.LoadDefaultConfig(context.TODO(), config.WithRegion(region))
+	}
+
+	var (
+		mu          sync.Mutex
+		credentials *aws.CredentialsCache
+	)
+	return func(region string) (aws.Config, error) {
+		cfg, err := get(region)
+		if err != nil {
+			return aws.Config{}, err
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if credentials == nil {
+			credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), roleARN,
+				func(o *stscreds.AssumeRoleOptions) { o.RoleSessionName = AssumedRoleSessionName }))
+		}
+		cfg.Credentials = credentials
+		return cfg, nil
+	}
+}
+
+// AssumeRole makes Get return configs that assume the role roleARN, until the returned function is called to
+// restore the previous config provider. Like SetConfigProvider, it must not be called concurrently with
+// cloud-nuke operations.
+func AssumeRole(roleARN string) (restore func()) {
+	previous := configProvider
+	SetConfigProvider(AssumeRoleProvider(roleARN))
+	return func() {
+		SetConfigProvider(previous)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -54,3 +55,30 @@ func TestGet_NilProviderRestoresDefault(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "ap-southeast-1", cfg.Region)
 }
+
+func TestAssumeRole(t *testing.T) {
+	var regions []string
+	SetConfigProvider(func(region string) (aws.Config, error) {
+		regions = append(regions, region)
+		return aws.Config{Region: region, Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", "")}, nil
+	})
+	t.Cleanup(func() { SetConfigProvider(nil) })
+
+	restore := AssumeRole("arn:aws:iam::111111111111:role/OrganizationAccountAccessRole")
+
+	east, err := Get("us-east-1")
+	require.NoError(t, err)
+	west, err := Get("us-west-2")
+	require.NoError(t, err)
+
+	// The configs are built from the previous provider, and share the credentials of the assumed role
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, regions)
+	assert.Equal(t, "us-west-2", west.Region)
+	assert.IsType(t, &aws.CredentialsCache{}, east.Credentials)
+	assert.Same(t, east.Credentials, west.Credentials)
+
+	restore()
+	cfg, err := Get("eu-west-1")
+	require.NoError(t, err)
+	assert.Equal(t, credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""), cfg.Credentials)
+}
@@ -2,6 +2,9 @@ package gcp
 
 import (
 	"errors"
+	"fmt"
+
+	"github.com/gruntwork-io/cloud-nuke/util"
 
 	"google.golang.org/api/googleapi"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -65,3 +68,19 @@ func isQuotaExhaustedError(err error) bool {
 
 	return false
 }
+
+// errorClass returns the class of an error for circuit breakers: the gRPC status code or the HTTP status code of
+// Google API errors, falling back to util.ErrorClass.
+func errorClass(err error) string {
+	for current := err; current != nil; current = errors.Unwrap(current) {
+		if s, ok := status.FromError(current); ok && s.Code() != codes.OK && s.Code() != codes.Unknown {
+			return s.Code().String()
+		}
+	}
+
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return fmt.Sprintf("HTTP %d", apiErr.Code)
+	}
+	return util.ErrorClass(err)
+}
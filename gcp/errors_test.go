@@ -69,3 +69,22 @@ func TestIsQuotaExhaustedError(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"gRPC status", status.New(codes.PermissionDenied, "denied on bucket-1").Err(), "PermissionDenied"},
+		{"wrapped gRPC status", fmt.Errorf("outer: %w", status.New(codes.PermissionDenied, "denied").Err()), "PermissionDenied"},
+		{"Google API error", fmt.Errorf("outer: %w", &googleapi.Error{Code: 403, Message: "forbidden"}), "HTTP 403"},
+		{"plain error", fmt.Errorf("random"), "random"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, errorClass(tc.err))
+		})
+	}
+}
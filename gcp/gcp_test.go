@@ -1,9 +1,19 @@
 package gcp
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp/resources"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 func TestIsNukeable_EmptyLists(t *testing.T) {
@@ -50,3 +60,74 @@ func TestIsNukeable_ExcludeOverridesAll(t *testing.T) {
 	assert.False(t, IsNukeable("Excluded", []string{"all"}, []string{"Excluded"}))
 	assert.True(t, IsNukeable("Included", []string{"all"}, []string{"Excluded"}))
 }
+
+func TestQueryValidate_ExpandsResourceTypes(t *testing.T) {
+	q := &Query{ResourceTypes: []string{"storage", "gcp-*"}, ExcludeRegions: []string{"europe-*"}, Regions: []string{"europe-west1", "us-central1"}}
+	require.NoError(t, q.Validate())
+	assert.Equal(t, []string{"gcp-pubsub-topic", "gcs-bucket"}, q.ResourceTypes)
+	assert.Equal(t, []string{"us-central1"}, q.Regions)
+
+	q = &Query{ExcludeResourceTypes: []string{"!gcs-bucket"}}
+	require.NoError(t, q.Validate())
+	assert.Nil(t, q.ResourceTypes)
+	assert.NotContains(t, q.ExcludeResourceTypes, "gcs-bucket")
+	assert.False(t, IsNukeable("gcp-pubsub-topic", q.ResourceTypes, q.ExcludeResourceTypes))
+	assert.True(t, IsNukeable("gcs-bucket", q.ResourceTypes, q.ExcludeResourceTypes))
+	assert.Equal(t, []string{"gcs-bucket"}, q.SelectedResourceTypes())
+
+	assert.Error(t, (&Query{ResourceTypes: []string{"unknown"}}).Validate())
+	assert.Error(t, (&Query{ResourceTypes: []string{"gcs-bucket", "!gcs-bucket"}}).Validate())
+}
+
+func TestQuery_EngineSettings(t *testing.T) {
+	q := &Query{MaxPasses: 3, Verify: true, ParallelRegions: 2}
+	require.NoError(t, q.Validate())
+
+	settings := q.engineSettings()
+	assert.Equal(t, 3, settings.MaxPasses)
+	assert.True(t, settings.Verify)
+	assert.Equal(t, 2, settings.ParallelScopes)
+
+	assert.Error(t, (&Query{MaxPasses: -1}).Validate())
+}
+
+func TestNukeAllResources_RunsHooks(t *testing.T) {
+	t.Setenv("DISABLE_TELEMETRY", "true")
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	fake := resourcetest.NewFake("widget", "kept", "nuked")
+	widget := resources.NewGcpResource(fake.Resource())
+	widget.Init(GcpConfig{ProjectID: "test-project", Region: "us-central1"})
+
+	configObj := config.Config{Custom: map[string]*config.ResourceType{
+		"widget": {Hooks: config.Hooks{PreDelete: []config.Hook{{
+			Command: []string{"sh", "-c", `cat > /dev/null; echo '{"veto": ["kept"]}'`},
+		}}}},
+	}}
+	_, err := widget.GetAndSetIdentifiers(context.Background(), configObj)
+	require.NoError(t, err)
+
+	account := &GcpProjectResources{
+		Resources: map[string]GcpResources{"us-central1": {Resources: []*GcpResource{&widget}}},
+		Config:    configObj,
+	}
+	events := &reporting.EventBuffer{}
+	_ = NukeAllResources(context.Background(), account, &Query{Regions: []string{"us-central1"}}, events)
+
+	assert.Equal(t, []string{"kept"}, fake.Existing())
+}
+
+func TestRegisteredResources_ConfigKeyIsAConfigField(t *testing.T) {
+	for _, r := range GetAllRegisteredResources() {
+		name := (*r).ResourceName()
+		section := fmt.Sprintf("Custom:\n  %s:\n    timeout: 7m\n", name)
+		if key := (*r).ResourceConfigKey(); key != "" {
+			section = fmt.Sprintf("%s:\n  timeout: 7m\n", key)
+		}
+
+		var configObj config.Config
+		require.NoError(t, yaml.Unmarshal([]byte(section), &configObj))
+		assert.Equal(t, "7m", (*r).GetAndSetResourceConfig(configObj).Timeout,
+			"%s: ConfigKey must be the YAML key of a field of the config", name)
+	}
+}
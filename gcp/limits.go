@@ -0,0 +1,35 @@
+package gcp
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+)
+
+// EnforceDeleteLimits checks the identifiers to nuke against query.MaxDelete and the max_delete of each resource
+// type in the config. When a limit is exceeded, a resource.DeleteLimitExceededError is returned, unless
+// query.TrimToMaxDelete is set, in which case only the identifiers within the limits are kept as targets and the
+// rest are skipped with a warning. See engine.EnforceDeleteLimits.
+func EnforceDeleteLimits(account *GcpProjectResources, query *Query, configObj config.Config) error {
+	found := engineResources(account, configObj)
+	if err := engine.EnforceDeleteLimits(found, query.engineSettings()); err != nil {
+		return err
+	}
+	account.Targets = found.Targets
+	return nil
+}
+
+// EnforceTotalDeleteLimit checks the identifiers to nuke across the projects of a multi-project run against
+// query.MaxDelete, once EnforceDeleteLimits was enforced in each of them. See engine.EnforceTotalDeleteLimit.
+func EnforceTotalDeleteLimit(projects []*GcpProjectResources, query *Query) error {
+	found := make([]*engine.Resources, len(projects))
+	for i, project := range projects {
+		found[i] = engineResources(project, project.Config)
+	}
+	if err := engine.EnforceTotalDeleteLimit(found, query.engineSettings()); err != nil {
+		return err
+	}
+	for i, project := range projects {
+		project.Targets = found[i].Targets
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"google.golang.org/api/cloudresourcemanager/v3"
+)
+
+// activeState is the state of the projects and folders that are not being deleted.
+const activeState = "ACTIVE"
+
+// ProjectsAPI is the part of the Resource Manager API used to enumerate the projects of folders and organizations.
+type ProjectsAPI interface {
+	// ListProjects returns the projects directly under a parent, e.g. folders/123 or organizations/456.
+	ListProjects(ctx context.Context, parent string) ([]*cloudresourcemanager.Project, error)
+	// ListFolders returns the names of the folders directly under a parent, e.g. folders/789.
+	ListFolders(ctx context.Context, parent string) ([]string, error)
+}
+
+// ProjectSelection selects the projects of a multi-project run.
+type ProjectSelection struct {
+	// ProjectIDs selects these projects.
+	ProjectIDs []string
+	// FolderIDs selects the active projects of these folders, and of their folders, recursively.
+	FolderIDs []string
+	// OrganizationID selects the active projects of the organization, including those in folders.
+	OrganizationID string
+	// Labels only selects the projects of folders and organizations that have all these labels. Projects listed
+	// in ProjectIDs are always selected.
+	Labels map[string]string
+}
+
+// IsMultiProject returns true when the selection can select more than one project.
+func (s ProjectSelection) IsMultiProject() bool {
+	return len(s.ProjectIDs) > 1 || len(s.FolderIDs) > 0 || s.OrganizationID != ""
+}
+
+// Validate ensures the selection selects projects.
+func (s ProjectSelection) Validate() error {
+	if len(s.ProjectIDs) == 0 && len(s.FolderIDs) == 0 && s.OrganizationID == "" {
+		return fmt.Errorf("no projects selected: specify a project, a folder or an organization")
+	}
+	if len(s.Labels) > 0 && len(s.FolderIDs) == 0 && s.OrganizationID == "" {
+		return fmt.Errorf("project labels only filter the projects of folders and organizations")
+	}
+	return nil
+}
+
+// Projects returns the IDs of the selected projects, sorted. client is only used to enumerate folders and
+// organizations.
+func (s ProjectSelection) Projects(ctx context.Context, client ProjectsAPI) ([]string, error) {
+	projectIDs := slices.Clone(s.ProjectIDs)
+
+	var parents []string
+	for _, folderID := range s.FolderIDs {
+		parents = append(parents, "folders/"+folderID)
+	}
+	if s.OrganizationID != "" {
+		parents = append(parents, "organizations/"+s.OrganizationID)
+	}
+
+	// Folders are walked breadth-first, and each one only once, in case a folder is also listed in FolderIDs
+	visited := make(map[string]bool)
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+		if visited[parent] {
+			continue
+		}
+		visited[parent] = true
+
+		projects, err := client.ListProjects(ctx, parent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the projects of %s: %w", parent, err)
+		}
+		for _, project := range projects {
+			if project.State == activeState && hasLabels(project.Labels, s.Labels) {
+				projectIDs = append(projectIDs, project.ProjectId)
+			}
+		}
+
+		folders, err := client.ListFolders(ctx, parent)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list the folders of %s: %w", parent, err)
+		}
+		parents = append(parents, folders...)
+	}
+
+	slices.Sort(projectIDs)
+	return slices.Compact(projectIDs), nil
+}
+
+// hasLabels returns true if labels include every label of required.
+func hasLabels(labels map[string]string, required map[string]string) bool {
+	for key, value := range required {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// resourceManagerClient enumerates projects and folders with the Resource Manager API.
+type resourceManagerClient struct {
+	service *cloudresourcemanager.Service
+}
+
+// NewProjectsClient returns a client of the Resource Manager API, with the application default credentials.
+func NewProjectsClient(ctx context.Context) (ProjectsAPI, error) {
+	service, err := cloudresourcemanager.NewService(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Resource Manager client: %w", err)
+	}
+	return resourceManagerClient{service: service}, nil
+}
+
+func (c resourceManagerClient) ListProjects(ctx context.Context, parent string) ([]*cloudresourcemanager.Project, error) {
+	var projects []*cloudresourcemanager.Project
+	err := c.service.Projects.List().Parent(parent).Pages(ctx, func(page *cloudresourcemanager.ListProjectsResponse) error {
+		projects = append(projects, page.Projects...)
+		return nil
+	})
+	return projects, err
+}
+
+func (c resourceManagerClient) ListFolders(ctx context.Context, parent string) ([]string, error) {
+	var folders []string
+	err := c.service.Folders.List().Parent(parent).Pages(ctx, func(page *cloudresourcemanager.ListFoldersResponse) error {
+		for _, folder := range page.Folders {
+			if folder.State == activeState {
+				folders = append(folders, folder.Name)
+			}
+		}
+		return nil
+	})
+	return folders, err
+}
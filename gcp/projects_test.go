@@ -0,0 +1,91 @@
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/cloudresourcemanager/v3"
+)
+
+// mockProjectsClient serves the projects and folders directly under each parent.
+type mockProjectsClient struct {
+	projects map[string][]*cloudresourcemanager.Project
+	folders  map[string][]string
+}
+
+func (m mockProjectsClient) ListProjects(ctx context.Context, parent string) ([]*cloudresourcemanager.Project, error) {
+	return m.projects[parent], nil
+}
+
+func (m mockProjectsClient) ListFolders(ctx context.Context, parent string) ([]string, error) {
+	return m.folders[parent], nil
+}
+
+func TestProjectSelection_Projects(t *testing.T) {
+	t.Parallel()
+
+	sandbox := map[string]string{"env": "sandbox"}
+	client := mockProjectsClient{
+		projects: map[string][]*cloudresourcemanager.Project{
+			"organizations/1": {
+				{ProjectId: "shared", State: "ACTIVE"},
+			},
+			"folders/10": {
+				{ProjectId: "sandbox-a", State: "ACTIVE", Labels: sandbox},
+				{ProjectId: "sandbox-deleted", State: "DELETE_REQUESTED", Labels: sandbox},
+				{ProjectId: "prod", State: "ACTIVE", Labels: map[string]string{"env": "prod"}},
+			},
+			"folders/11": {
+				{ProjectId: "sandbox-b", State: "ACTIVE", Labels: sandbox},
+			},
+		},
+		folders: map[string][]string{
+			"organizations/1": {"folders/10"},
+			"folders/10":      {"folders/11"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		selection ProjectSelection
+		expected  []string
+	}{
+		{
+			name:      "projects",
+			selection: ProjectSelection{ProjectIDs: []string{"b", "a", "b"}},
+			expected:  []string{"a", "b"},
+		},
+		{
+			name:      "folder and its sub-folders",
+			selection: ProjectSelection{FolderIDs: []string{"10", "11"}},
+			expected:  []string{"prod", "sandbox-a", "sandbox-b"},
+		},
+		{
+			name:      "organization with labels",
+			selection: ProjectSelection{OrganizationID: "1", Labels: sandbox, ProjectIDs: []string{"explicit"}},
+			expected:  []string{"explicit", "sandbox-a", "sandbox-b"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			projects, err := tc.selection.Projects(context.Background(), client)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, projects)
+		})
+	}
+}
+
+func TestProjectSelection_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ProjectSelection{ProjectIDs: []string{"a"}}.Validate())
+	require.NoError(t, ProjectSelection{FolderIDs: []string{"10"}, Labels: map[string]string{"env": "sandbox"}}.Validate())
+	assert.Error(t, ProjectSelection{}.Validate())
+	assert.Error(t, ProjectSelection{ProjectIDs: []string{"a"}, Labels: map[string]string{"env": "sandbox"}}.Validate())
+
+	assert.False(t, ProjectSelection{ProjectIDs: []string{"a"}}.IsMultiProject())
+	assert.True(t, ProjectSelection{ProjectIDs: []string{"a", "b"}}.IsMultiProject())
+	assert.True(t, ProjectSelection{OrganizationID: "1"}.IsMultiProject())
+}
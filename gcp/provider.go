@@ -0,0 +1,107 @@
+package gcp
+
+import (
+	"context"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/engine"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/util"
+	"github.com/gruntwork-io/go-commons/collections"
+)
+
+// provider lets the engine scan and nuke the regions of a GCP project targeted by a query.
+type provider struct {
+	query *Query
+}
+
+func (p provider) Name() string {
+	return "gcp"
+}
+
+func (p provider) Scopes() []string {
+	return p.query.Regions
+}
+
+// Resources returns the registered resources of a region, initialized for the project of the query.
+func (p provider) Resources(ctx context.Context, region string) ([]resource.NukeableResource, error) {
+	cfg := GcpConfig{ProjectID: p.query.ProjectID, Region: region}
+
+	var registered []resource.NukeableResource
+	for _, gcpResource := range GetAndInitRegisteredResources(cfg, region) {
+		registered = append(registered, *gcpResource)
+	}
+	return registered, nil
+}
+
+// Dependencies returns the transitive dependencies of the resource types registered in a region, see
+// engine.Provider.
+func (p provider) Dependencies(region string) map[string][]string {
+	registered := regionalResources()
+	if region == GlobalRegion {
+		registered = globalResources()
+	}
+	return resource.TransitiveDependencies(registered, GcpResource.ResourceName, GcpResource.Dependencies)
+}
+
+// IgnoreListError skips resource types whose API is disabled in the project, unless they were explicitly requested.
+func (p provider) IgnoreListError(resourceType string, err error) bool {
+	return isServiceDisabledError(err) && !collections.ListContainsElement(p.query.ResourceTypes, resourceType)
+}
+
+// IsThrottlingError treats quota errors as throttling, so the deletions are retried after backing off.
+func (p provider) IsThrottlingError(err error) bool {
+	return isQuotaExhaustedError(err)
+}
+
+func (p provider) IsWarningError(err error) bool {
+	return util.IsWarningError(err)
+}
+
+func (p provider) ErrorClass(err error) string {
+	return errorClass(err)
+}
+
+// engineSettings returns the settings of the query that the engine applies.
+func (q *Query) engineSettings() engine.Settings {
+	return engine.Settings{
+		ResourceTypes:           q.ResourceTypes,
+		ExcludeResourceTypes:    q.ExcludeResourceTypes,
+		ExcludeFirstSeen:        q.ExcludeFirstSeen,
+		Timeout:                 q.Timeout,
+		ParallelScopes:          q.ParallelRegions,
+		MaxPasses:               q.MaxPasses,
+		Journal:                 q.Journal,
+		Verify:                  q.Verify,
+		VerifyGracePeriod:       q.VerifyGracePeriod,
+		MaxDelete:               q.MaxDelete,
+		TrimToMaxDelete:         q.TrimToMaxDelete,
+		CircuitBreakerThreshold: q.CircuitBreakerThreshold,
+	}
+}
+
+// engineResources returns the resources of the project as the engine nukes them.
+func engineResources(account *GcpProjectResources, configObj config.Config) *engine.Resources {
+	found := &engine.Resources{
+		ByScope:          make(map[string][]resource.NukeableResource, len(account.Resources)),
+		Config:           configObj,
+		Targets:          account.Targets,
+		IncompleteScopes: account.IncompleteRegions,
+	}
+	for region, regionResources := range account.Resources {
+		for _, gcpResource := range regionResources.Resources {
+			found.ByScope[region] = append(found.ByScope[region], *gcpResource)
+		}
+	}
+	return found
+}
+
+// toGcpResources converts resources found by the engine back to the GCP resources they were initialized from.
+func toGcpResources(found []resource.NukeableResource) GcpResources {
+	gcpResources := GcpResources{}
+	for _, res := range found {
+		gcpResource := res.(GcpResource)
+		gcpResources.Resources = append(gcpResources.Resources, &gcpResource)
+	}
+	return gcpResources
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/gruntwork-io/cloud-nuke/journal"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 	"github.com/gruntwork-io/go-commons/collections"
 )
 
@@ -19,20 +21,75 @@ type Query struct {
 	IncludeAfter         *time.Time
 	Timeout              *time.Duration
 	ExcludeFirstSeen     bool
+	// ParallelRegions is the maximum number of regions scanned or nuked concurrently.
+	// Values of 1 or less process regions sequentially.
+	ParallelRegions int
+	// MaxPasses is the maximum number of nuke passes. Identifiers that failed or warned in a pass are
+	// retried in the next one. Values of 1 or less nuke in a single pass.
+	MaxPasses int
+	// Journal records deletion results and completed regions. When resuming from a journal, completed regions
+	// are not scanned and identifiers that were already nuked are skipped. Nil disables journaling.
+	Journal *journal.Journal
+	// Verify lists the nuked resource types again once the nuke is complete, and reports the deleted identifiers
+	// that are still present.
+	Verify bool
+	// VerifyGracePeriod is how long the deleted identifiers that are still present are listed again before being
+	// reported, for asynchronous deletions to complete. Zero lists them once.
+	VerifyGracePeriod time.Duration
+	// MaxDelete caps the number of identifiers nuked in a run. Zero means no limit. Resource types can also be
+	// capped with max_delete in the config. See EnforceDeleteLimits.
+	MaxDelete int
+	// TrimToMaxDelete nukes identifiers up to the delete limits and skips the rest, instead of refusing to nuke.
+	TrimToMaxDelete bool
+	// CircuitBreakerThreshold is the number of consecutive failures of the same class after which the remaining
+	// identifiers of a resource type in a region are skipped. Values of 0 or less disable the circuit breakers.
+	CircuitBreakerThreshold int
 }
 
 // Validate ensures the query has valid defaults.
+// ResourceTypes and ExcludeResourceTypes are expanded to resource type names, see expandResourceTypes.
 // If no regions are specified, it defaults to GlobalRegion.
-// ExcludeRegions are filtered out from the region list.
+// ExcludeRegions, which can be globs such as "europe-*", are filtered out from the region list.
 func (q *Query) Validate() error {
+	if err := ValidateResourceDependencies(); err != nil {
+		return err
+	}
+
+	if q.ParallelRegions < 0 {
+		return fmt.Errorf("invalid number of parallel regions %d: must not be negative", q.ParallelRegions)
+	}
+
+	if q.MaxPasses < 0 {
+		return fmt.Errorf("invalid number of passes %d: must not be negative", q.MaxPasses)
+	}
+
+	if q.MaxDelete < 0 {
+		return fmt.Errorf("invalid max delete %d: must not be negative", q.MaxDelete)
+	}
+
+	// An empty list of resource types selects every resource type, so patterns that select none are rejected
+	resourceTypes, err := expandResourceTypes(q.ResourceTypes)
+	if err != nil {
+		return err
+	}
+	if len(q.ResourceTypes) > 0 && len(resourceTypes) == 0 {
+		return fmt.Errorf("no resource types selected by %s", q.ResourceTypes)
+	}
+	q.ResourceTypes = resourceTypes
+
+	if q.ExcludeResourceTypes, err = expandResourceTypes(q.ExcludeResourceTypes); err != nil {
+		return err
+	}
+
 	if len(q.Regions) == 0 {
 		q.Regions = []string{GlobalRegion}
 	}
 
 	if len(q.ExcludeRegions) > 0 {
+		excluded, _ := resource.ExpandSelection(q.Regions, q.ExcludeRegions, nil)
 		var filtered []string
 		for _, region := range q.Regions {
-			if !collections.ListContainsElement(q.ExcludeRegions, region) {
+			if !collections.ListContainsElement(excluded, region) {
 				filtered = append(filtered, region)
 			}
 		}
@@ -45,3 +102,29 @@ func (q *Query) Validate() error {
 
 	return nil
 }
+
+// SelectedResourceTypes returns the resource types the query selects to nuke, once validated.
+func (q *Query) SelectedResourceTypes() []string {
+	var resourceTypes []string
+	for _, resourceType := range ListResourceTypes() {
+		if IsNukeable(resourceType, q.ResourceTypes, q.ExcludeResourceTypes) {
+			resourceTypes = append(resourceTypes, resourceType)
+		}
+	}
+	return resourceTypes
+}
+
+// expandResourceTypes returns the resource types selected by the patterns (names, globs such as "gcs-*",
+// categories such as "storage", and their "!" negations), see resource.ExpandSelection. Patterns that select
+// nothing are rejected.
+func expandResourceTypes(patterns []string) ([]string, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+
+	resourceTypes, unmatched := resource.ExpandSelection(ListResourceTypes(), patterns, ListResourceCategories())
+	if len(unmatched) > 0 {
+		return nil, fmt.Errorf("invalid resource types %s: try --list-resource-types to get a list of valid resource types", unmatched)
+	}
+	return resourceTypes, nil
+}
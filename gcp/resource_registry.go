@@ -1,12 +1,25 @@
 package gcp
 
 import (
+	"slices"
+
 	"github.com/gruntwork-io/cloud-nuke/gcp/resources"
+	"github.com/gruntwork-io/cloud-nuke/resource"
 )
 
 // GlobalRegion is the region name used for GCP resources that are not region-scoped.
 const GlobalRegion = "global"
 
+// customResources holds the resource types registered with RegisterResource.
+var customResources = &resource.Registry[GcpResource]{}
+
+// RegisterResource registers a resource type defined outside of cloud-nuke, so that it is scanned and nuked like
+// the built-in ones. factory must return a new, uninitialized instance each time it is called, and its config is
+// read from the Custom section of the config file with config.Config.CustomResourceType.
+func RegisterResource(factory func() GcpResource, registration resource.Registration) error {
+	return customResources.Register(factory, registration, append(getRegisteredGlobalResources(), getRegisteredRegionalResources()...))
+}
+
 // getRegisteredGlobalResources returns all GCP resource types that are global (not region-scoped).
 func getRegisteredGlobalResources() []GcpResource {
 	return []GcpResource{
@@ -26,7 +39,7 @@ func getRegisteredRegionalResources() []GcpResource {
 // GetAllRegisteredResources returns pointers to all registered GCP resources
 // (both global and regional), without initializing them.
 func GetAllRegisteredResources() []*GcpResource {
-	all := append(getRegisteredGlobalResources(), getRegisteredRegionalResources()...)
+	all := append(globalResources(), regionalResources()...)
 	result := make([]*GcpResource, len(all))
 	for i := range all {
 		result[i] = &all[i]
@@ -39,9 +52,9 @@ func GetAllRegisteredResources() []*GcpResource {
 func GetAndInitRegisteredResources(cfg resources.GcpConfig, region string) []*GcpResource {
 	var raw []GcpResource
 	if region == GlobalRegion {
-		raw = getRegisteredGlobalResources()
+		raw = globalResources()
 	} else {
-		raw = getRegisteredRegionalResources()
+		raw = regionalResources()
 	}
 
 	result := make([]*GcpResource, len(raw))
@@ -51,3 +64,62 @@ func GetAndInitRegisteredResources(cfg resources.GcpConfig, region string) []*Gc
 	}
 	return result
 }
+
+// ValidateResourceDependencies checks that the dependencies declared by registered resources refer to
+// resource types registered in the same scope (global or regional) and do not form a cycle.
+func ValidateResourceDependencies() error {
+	for _, global := range []bool{true, false} {
+		registered := globalResources()
+		if !global {
+			registered = regionalResources()
+		}
+		var names []string
+		for _, r := range registered {
+			names = append(names, r.ResourceName())
+		}
+
+		if err := customResources.Validate(global, names); err != nil {
+			return err
+		}
+		err := resource.ValidateDependencies(registered, GcpResource.ResourceName, GcpResource.Dependencies)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// globalResources returns the built-in global resources followed by the global resources registered with
+// RegisterResource.
+func globalResources() []GcpResource {
+	return withCustomResources(getRegisteredGlobalResources(), true)
+}
+
+// regionalResources returns the built-in regional resources followed by the regional resources registered with
+// RegisterResource.
+func regionalResources() []GcpResource {
+	return withCustomResources(getRegisteredRegionalResources(), false)
+}
+
+// withCustomResources appends the resources registered with RegisterResource to the built-in resources of the
+// same scope, and adds the dependencies required by their registrations.
+func withCustomResources(builtIn []GcpResource, global bool) []GcpResource {
+	all := append(builtIn, customResources.Resources(global)...)
+	dependencies := customResources.Dependencies(global)
+	for i, r := range all {
+		if dependsOn := dependencies[r.ResourceName()]; len(dependsOn) > 0 {
+			all[i] = orderedResource{GcpResource: r, dependsOn: dependsOn}
+		}
+	}
+	return all
+}
+
+// orderedResource is a resource whose deletion order is further constrained by registered resource types.
+type orderedResource struct {
+	GcpResource
+	dependsOn []string
+}
+
+func (r orderedResource) Dependencies() []string {
+	return slices.Concat(r.GcpResource.Dependencies(), r.dependsOn)
+}
@@ -31,6 +31,7 @@ var gcrManagedRepos = map[string]struct{}{
 func NewArtifactRegistryRepositories() GcpResource {
 	return NewGcpResource(&resource.Resource[*artifactregistry.Client]{
 		ResourceTypeName: "artifact-registry",
+		Categories:       []string{resource.CategoryCompute},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*artifactregistry.Client], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
@@ -41,19 +42,17 @@ func NewArtifactRegistryRepositories() GcpResource {
 			}
 			r.Client = client
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.ArtifactRegistry
-		},
-		Lister: listArtifactRegistryRepositories,
-		Nuker:  resource.SequentialDeleter(deleteArtifactRegistryRepository),
+		ConfigKey:      "ArtifactRegistry",
+		DetailedLister: listArtifactRegistryRepositories,
+		Nuker:          resource.SequentialDeleter(deleteArtifactRegistryRepository),
 	})
 }
 
 // listArtifactRegistryRepositories retrieves all Artifact Registry repositories in the project that match the config filters.
 // Unlike Cloud Functions, the Artifact Registry API does not support the wildcard "locations/-",
 // so we first list all available locations and then query repositories in each one.
-func listArtifactRegistryRepositories(ctx context.Context, client *artifactregistry.Client, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listArtifactRegistryRepositories(ctx context.Context, client *artifactregistry.Client, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	// First, list all available locations for this project
 	locReq := &locationpb.ListLocationsRequest{
@@ -122,7 +121,7 @@ func listArtifactRegistryRepositories(ctx context.Context, client *artifactregis
 			}
 
 			if cfg.ShouldInclude(resourceValue) {
-				result = append(result, &fullName)
+				result = append(result, resource.NewResourceDetails(&fullName, resourceValue))
 			}
 		}
 	}
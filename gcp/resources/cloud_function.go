@@ -20,6 +20,8 @@ import (
 func NewCloudFunctions() GcpResource {
 	return NewGcpResource(&resource.Resource[*functions.FunctionClient]{
 		ResourceTypeName: "cloud-function",
+		Categories:       []string{resource.CategoryCompute},
+		NoTags:           true,
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*functions.FunctionClient], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
@@ -29,18 +31,16 @@ func NewCloudFunctions() GcpResource {
 			}
 			r.Client = client
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.CloudFunction
-		},
-		Lister: listCloudFunctions,
-		Nuker:  resource.SequentialDeleter(deleteCloudFunction),
+		ConfigKey:      "CloudFunction",
+		DetailedLister: listCloudFunctions,
+		Nuker:          resource.SequentialDeleter(deleteCloudFunction),
 	})
 }
 
 // listCloudFunctions retrieves all Cloud Functions (Gen2) in the project that match the config filters.
 // It queries across all locations using the wildcard parent: projects/{projectID}/locations/-
-func listCloudFunctions(ctx context.Context, client *functions.FunctionClient, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listCloudFunctions(ctx context.Context, client *functions.FunctionClient, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	// Use the wildcard "-" for location to list functions across ALL regions
 	parent := fmt.Sprintf("projects/%s/locations/-", scope.ProjectID)
@@ -74,7 +74,7 @@ func listCloudFunctions(ctx context.Context, client *functions.FunctionClient, s
 		}
 
 		if cfg.ShouldInclude(resourceValue) {
-			result = append(result, &name)
+			result = append(result, resource.NewResourceDetails(&name, resourceValue))
 		}
 	}
 
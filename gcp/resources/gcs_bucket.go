@@ -18,6 +18,7 @@ import (
 func NewGCSBuckets() GcpResource {
 	return NewGcpResource(&resource.Resource[*storage.Client]{
 		ResourceTypeName: "gcs-bucket",
+		Categories:       []string{resource.CategoryStorage},
 		BatchSize:        DefaultBatchSize,
 		InitClient: WrapGcpInitClient(func(r *resource.Resource[*storage.Client], cfg GcpConfig) {
 			r.Scope.ProjectID = cfg.ProjectID
@@ -29,17 +30,15 @@ func NewGCSBuckets() GcpResource {
 			}
 			r.Client = client
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.GCSBucket
-		},
-		Lister: listGCSBuckets,
-		Nuker:  resource.SequentialDeleter(deleteGCSBucket),
+		ConfigKey:      "GCSBucket",
+		DetailedLister: listGCSBuckets,
+		Nuker:          resource.SequentialDeleter(deleteGCSBucket),
 	})
 }
 
 // listGCSBuckets retrieves all GCS buckets in the project that match the config filters.
-func listGCSBuckets(ctx context.Context, client *storage.Client, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
-	var result []*string
+func listGCSBuckets(ctx context.Context, client *storage.Client, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
+	var result []resource.ResourceDetails
 
 	it := client.Buckets(ctx, scope.ProjectID)
 	for {
@@ -57,8 +56,13 @@ func listGCSBuckets(ctx context.Context, client *storage.Client, scope resource.
 		}
 
 		if cfg.ShouldInclude(resourceValue) {
-			name := bucket.Name
-			result = append(result, &name)
+			details := resource.NewResourceDetails(&bucket.Name, resourceValue)
+			details.Tags = bucket.Labels
+			details.Attributes = map[string]string{
+				"location":      bucket.Location,
+				"storage_class": bucket.StorageClass,
+			}
+			result = append(result, details)
 		}
 	}
 
@@ -39,11 +39,9 @@ func NewPubSubTopics() GcpResource {
 			}
 			r.Client = client
 		}),
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return c.GcpPubSubTopic
-		},
-		Lister: listPubSubTopics,
-		Nuker:  resource.SequentialDeleter(deletePubSubTopic),
+		ConfigKey:      "GcpPubSubTopic",
+		DetailedLister: listPubSubTopics,
+		Nuker:          resource.SequentialDeleter(deletePubSubTopic),
 	})
 }
 
@@ -55,13 +53,13 @@ func NewPubSubTopics() GcpResource {
 //     current Unix epoch timestamp as the value.
 //   - On subsequent runs, the stored timestamp is used for --older-than / --newer-than filtering.
 //   - Topics are never deleted on the first run when time-based filters are active.
-func listPubSubTopics(ctx context.Context, client *pubsub.PublisherClient, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+func listPubSubTopics(ctx context.Context, client *pubsub.PublisherClient, scope resource.Scope, cfg config.ResourceType) ([]resource.ResourceDetails, error) {
 	excludeFirstSeen, err := util.GetBoolFromContext(ctx, util.ExcludeFirstSeenTagKey)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read exclude-first-seen-tag from context: %w", err)
 	}
 
-	var result []*string
+	var result []resource.ResourceDetails
 
 	req := &pubsubpb.ListTopicsRequest{
 		Project: fmt.Sprintf("projects/%s", scope.ProjectID),
@@ -103,7 +101,7 @@ func listPubSubTopics(ctx context.Context, client *pubsub.PublisherClient, scope
 
 		if cfg.ShouldInclude(resourceValue) {
 			name := topic.Name
-			result = append(result, &name)
+			result = append(result, resource.NewResourceDetails(&name, resourceValue))
 		}
 	}
 
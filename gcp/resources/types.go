@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/resource"
 )
 
@@ -19,6 +20,17 @@ type GcpResources struct {
 // GcpProjectResources is a struct that represents the resources found in a single GCP project
 type GcpProjectResources struct {
 	Resources map[string]GcpResources
+
+	// Targets restricts nuking to these identifiers, keyed by region and then by resource type.
+	// Nil nukes every identifier found.
+	Targets map[string]map[string][]string
+
+	// Config is the config the resources were found with, kept so its hooks run when they are nuked.
+	Config config.Config
+
+	// IncompleteRegions holds the regions where a resource type couldn't be listed. They are never recorded as
+	// complete in the journal, so that they are scanned again when the run is resumed.
+	IncompleteRegions map[string]bool
 }
 
 func (g *GcpProjectResources) GetRegion(region string) GcpResources {
@@ -31,6 +43,14 @@ func (g *GcpProjectResources) GetRegion(region string) GcpResources {
 // TotalResourceCount returns the number of resources found, that are eligible for nuking
 func (g *GcpProjectResources) TotalResourceCount() int {
 	total := 0
+	if g.Targets != nil {
+		for _, byType := range g.Targets {
+			for _, identifiers := range byType {
+				total += len(identifiers)
+			}
+		}
+		return total
+	}
 	for _, regionResource := range g.Resources {
 		for _, resource := range regionResource.Resources {
 			total += len((*resource).ResourceIdentifiers())
@@ -0,0 +1,41 @@
+package journal
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type InvalidJournalError struct {
+	Path       string
+	Underlying error
+}
+
+func (err InvalidJournalError) Error() string {
+	return fmt.Sprintf("Unable to read the journal %s. Original error: %v", err.Path, err.Underlying)
+}
+
+type UnsupportedJournalVersionError struct {
+	Path    string
+	Version int
+}
+
+func (err UnsupportedJournalVersionError) Error() string {
+	return fmt.Sprintf("Unsupported version %d of the journal %s: expected version %d", err.Version, err.Path, Version)
+}
+
+type JournalScopeMismatchError struct {
+	Path                 string
+	JournalScope         string
+	Scope                string
+	JournalResourceTypes []string
+	ResourceTypes        []string
+}
+
+func (err JournalScopeMismatchError) Error() string {
+	if err.JournalScope == err.Scope && !slices.Equal(err.JournalResourceTypes, err.ResourceTypes) {
+		return fmt.Sprintf("The journal %s was recorded for the resource types [%s] and can not be used to resume a run for the resource types [%s]",
+			err.Path, strings.Join(err.JournalResourceTypes, ", "), strings.Join(err.ResourceTypes, ", "))
+	}
+	return fmt.Sprintf("The journal %s was recorded for %s and can not be used to resume a run for %s", err.Path, err.JournalScope, err.Scope)
+}
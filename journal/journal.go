@@ -0,0 +1,267 @@
+// Package journal records the progress of nuke runs in a local file, so that interrupted runs can be resumed.
+//
+// A journal is a file of JSON lines. The first line identifies the scope of the run (e.g., the cloud and project)
+// and the resource types it selected, and every following line records either the result of one deletion, or that every resource of a region has been
+// nuked. Lines are appended as the run progresses, so the journal is usable even if the process dies midway.
+package journal
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/go-commons/errors"
+)
+
+// Version is the version of the journal format.
+const Version = 1
+
+// header is the first line of a journal.
+type header struct {
+	Version       int      `json:"version"`
+	Scope         string   `json:"scope"`
+	ResourceTypes []string `json:"resource_types"`
+}
+
+// entry is a line of a journal, after the header.
+type entry struct {
+	Time           time.Time `json:"time"`
+	Region         string    `json:"region"`
+	ResourceType   string    `json:"resource_type,omitempty"`
+	Identifier     string    `json:"identifier,omitempty"`
+	Success        bool      `json:"success,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	RegionComplete bool      `json:"region_complete,omitempty"`
+}
+
+// Journal records deletion results and completed regions, and answers what a previous run already did.
+// All methods are safe for concurrent use, and are no-ops on a nil *Journal so callers don't need to check
+// whether journaling is enabled.
+type Journal struct {
+	mu        sync.Mutex
+	file      *os.File
+	encoder   *json.Encoder
+	succeeded map[string]bool
+	completed map[string]bool
+}
+
+// Create starts a new journal at path for a run with the given scope and selection of resource types, replacing
+// any existing file.
+func Create(path string, scope string, resourceTypes []string) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	j := newJournal(file)
+	if err := j.encoder.Encode(header{Version: Version, Scope: scope, ResourceTypes: sortedCopy(resourceTypes)}); err != nil {
+		_ = file.Close()
+		return nil, errors.WithStackTrace(err)
+	}
+	return j, nil
+}
+
+// Resume loads the journal at path, which must have been created for the same scope and selection of resource
+// types, and continues recording to it. A region completed for other resource types must not be skipped, so a
+// journal is never resumed with another selection.
+func Resume(path string, scope string, resourceTypes []string) (*Journal, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, errors.WithStackTrace(err)
+	}
+
+	j := newJournal(file)
+	if err := j.load(path, scope, resourceTypes); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	logging.Infof("Resuming from journal %s: %d resources already nuked, %d regions complete",
+		path, len(j.succeeded), len(j.completed))
+	return j, nil
+}
+
+func newJournal(file *os.File) *Journal {
+	return &Journal{
+		file:      file,
+		encoder:   json.NewEncoder(file),
+		succeeded: make(map[string]bool),
+		completed: make(map[string]bool),
+	}
+}
+
+// load replays the entries of the journal file.
+func (j *Journal) load(path string, scope string, resourceTypes []string) error {
+	scanner := bufio.NewScanner(j.file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	if !scanner.Scan() {
+		return errors.WithStackTrace(InvalidJournalError{Path: path, Underlying: scanner.Err()})
+	}
+	var h header
+	if err := json.Unmarshal(scanner.Bytes(), &h); err != nil {
+		return errors.WithStackTrace(InvalidJournalError{Path: path, Underlying: err})
+	}
+	if h.Version != Version {
+		return errors.WithStackTrace(UnsupportedJournalVersionError{Path: path, Version: h.Version})
+	}
+	resourceTypes = sortedCopy(resourceTypes)
+	if h.Scope != scope || !slices.Equal(h.ResourceTypes, resourceTypes) {
+		return errors.WithStackTrace(JournalScopeMismatchError{
+			Path:                 path,
+			JournalScope:         h.Scope,
+			Scope:                scope,
+			JournalResourceTypes: h.ResourceTypes,
+			ResourceTypes:        resourceTypes,
+		})
+	}
+
+	for scanner.Scan() {
+		var e entry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			// The last line may be incomplete if the run died while writing it
+			logging.Debugf("Ignoring unreadable journal entry in %s: %v", path, err)
+			continue
+		}
+		j.apply(e)
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.WithStackTrace(InvalidJournalError{Path: path, Underlying: err})
+	}
+	return nil
+}
+
+func (j *Journal) apply(e entry) {
+	if e.RegionComplete {
+		j.completed[e.Region] = true
+		return
+	}
+	// The latest result of an identifier wins, so a failure after a success means it needs nuking again
+	key := resultKey(e.Region, e.ResourceType, e.Identifier)
+	if e.Success {
+		j.succeeded[key] = true
+	} else {
+		delete(j.succeeded, key)
+	}
+}
+
+// sortedCopy returns the resource types in a stable order, so that selections can be compared.
+func sortedCopy(resourceTypes []string) []string {
+	sorted := slices.Clone(resourceTypes)
+	slices.Sort(sorted)
+	return slices.Compact(sorted)
+}
+
+func resultKey(region string, resourceType string, identifier string) string {
+	return region + "/" + resourceType + "/" + identifier
+}
+
+// Record appends the results of a nuke batch of a resource type in a region.
+func (j *Journal) Record(region string, resourceType string, results []resource.NukeResult) error {
+	if j == nil || len(results) == 0 {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	for _, result := range results {
+		e := entry{
+			Time:         time.Now().UTC(),
+			Region:       region,
+			ResourceType: resourceType,
+			Identifier:   result.Identifier,
+			Success:      result.Error == nil,
+		}
+		if result.Error != nil {
+			e.Error = result.Error.Error()
+		}
+		if err := j.write(e); err != nil {
+			return err
+		}
+	}
+	return j.sync()
+}
+
+// CompleteRegion records that every resource of a region was nuked, so the region is skipped when resuming.
+func (j *Journal) CompleteRegion(region string) error {
+	if j == nil {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if err := j.write(entry{Time: time.Now().UTC(), Region: region, RegionComplete: true}); err != nil {
+		return err
+	}
+	return j.sync()
+}
+
+func (j *Journal) write(e entry) error {
+	if err := j.encoder.Encode(e); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	j.apply(e)
+	return nil
+}
+
+func (j *Journal) sync() error {
+	if err := j.file.Sync(); err != nil {
+		return errors.WithStackTrace(err)
+	}
+	return nil
+}
+
+// Succeeded returns true if the identifier was nuked by a previous run.
+func (j *Journal) Succeeded(region string, resourceType string, identifier string) bool {
+	if j == nil {
+		return false
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.succeeded[resultKey(region, resourceType, identifier)]
+}
+
+// RegionComplete returns true if every resource of the region was nuked by a previous run.
+func (j *Journal) RegionComplete(region string) bool {
+	if j == nil {
+		return false
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.completed[region]
+}
+
+// Pending returns the identifiers that were not nuked by a previous run.
+func (j *Journal) Pending(region string, resourceType string, identifiers []string) []string {
+	if j == nil {
+		return identifiers
+	}
+
+	var pending []string
+	for _, id := range identifiers {
+		if !j.Succeeded(region, resourceType, id) {
+			pending = append(pending, id)
+		}
+	}
+	return pending
+}
+
+// Close closes the journal file.
+func (j *Journal) Close() error {
+	if j == nil {
+		return nil
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return errors.WithStackTrace(j.file.Close())
+}
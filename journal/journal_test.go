@@ -0,0 +1,113 @@
+package journal
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJournal_ResumeReplaysResults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	j, err := Create(path, "aws/123456789012", []string{"s3"})
+	require.NoError(t, err)
+	require.NoError(t, j.Record("us-east-1", "s3", []resource.NukeResult{
+		{Identifier: "bucket-a"},
+		{Identifier: "bucket-b", Error: errors.New("expired credentials")},
+	}))
+	require.NoError(t, j.CompleteRegion("eu-west-1"))
+	require.NoError(t, j.Close())
+
+	resumed, err := Resume(path, "aws/123456789012", []string{"s3"})
+	require.NoError(t, err)
+	defer resumed.Close()
+
+	assert.True(t, resumed.Succeeded("us-east-1", "s3", "bucket-a"))
+	assert.False(t, resumed.Succeeded("us-east-1", "s3", "bucket-b"))
+	assert.False(t, resumed.Succeeded("us-west-2", "s3", "bucket-a"))
+	assert.True(t, resumed.RegionComplete("eu-west-1"))
+	assert.False(t, resumed.RegionComplete("us-east-1"))
+	assert.Equal(t, []string{"bucket-b", "bucket-c"}, resumed.Pending("us-east-1", "s3", []string{"bucket-a", "bucket-b", "bucket-c"}))
+
+	// A resumed journal keeps recording, and the latest result of an identifier wins
+	require.NoError(t, resumed.Record("us-east-1", "s3", []resource.NukeResult{{Identifier: "bucket-b"}}))
+	assert.True(t, resumed.Succeeded("us-east-1", "s3", "bucket-b"))
+}
+
+func TestJournal_ResumeIgnoresIncompleteLastLine(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	j, err := Create(path, "gcp/my-project", []string{"gcs-bucket"})
+	require.NoError(t, err)
+	require.NoError(t, j.Record("global", "gcs-bucket", []resource.NukeResult{{Identifier: "bucket-a"}}))
+	require.NoError(t, j.Close())
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"region":"glo`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	resumed, err := Resume(path, "gcp/my-project", []string{"gcs-bucket"})
+	require.NoError(t, err)
+	defer resumed.Close()
+	assert.True(t, resumed.Succeeded("global", "gcs-bucket", "bucket-a"))
+}
+
+func TestJournal_ResumeRejectsOtherScope(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	j, err := Create(path, "aws/123456789012", []string{"s3"})
+	require.NoError(t, err)
+	require.NoError(t, j.Close())
+
+	_, err = Resume(path, "aws/210987654321", []string{"s3"})
+	var mismatch JournalScopeMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, "aws/123456789012", mismatch.JournalScope)
+}
+
+func TestJournal_ResumeRejectsOtherResourceTypes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+
+	j, err := Create(path, "aws/123456789012", []string{"s3", "ec2"})
+	require.NoError(t, err)
+	require.NoError(t, j.CompleteRegion("us-east-1"))
+	require.NoError(t, j.Close())
+
+	// The order of the selection doesn't matter
+	resumed, err := Resume(path, "aws/123456789012", []string{"ec2", "s3"})
+	require.NoError(t, err)
+	require.NoError(t, resumed.Close())
+
+	// The region was only completed for s3 and ec2, so it can't be skipped for other resource types
+	_, err = Resume(path, "aws/123456789012", []string{"s3", "ec2", "lambda"})
+	var mismatch JournalScopeMismatchError
+	require.ErrorAs(t, err, &mismatch)
+	assert.Equal(t, []string{"ec2", "s3"}, mismatch.JournalResourceTypes)
+	assert.Contains(t, mismatch.Error(), "resource types [ec2, s3]")
+}
+
+func TestJournal_ResumeRejectsInvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "journal.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("not a journal\n"), 0o600))
+
+	_, err := Resume(path, "aws/123456789012", []string{"s3"})
+	require.ErrorAs(t, err, &InvalidJournalError{})
+}
+
+func TestJournal_NilIsNoOp(t *testing.T) {
+	var j *Journal
+
+	require.NoError(t, j.Record("us-east-1", "s3", []resource.NukeResult{{Identifier: "bucket-a"}}))
+	require.NoError(t, j.CompleteRegion("us-east-1"))
+	assert.False(t, j.Succeeded("us-east-1", "s3", "bucket-a"))
+	assert.False(t, j.RegionComplete("us-east-1"))
+	assert.Equal(t, []string{"bucket-a"}, j.Pending("us-east-1", "s3", []string{"bucket-a"}))
+	require.NoError(t, j.Close())
+}
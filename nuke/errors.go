@@ -0,0 +1,28 @@
+package nuke
+
+import (
+	"fmt"
+)
+
+// InvalidOptionsError is returned by Run when the options don't select exactly one of AWS and GCP, in which case
+// nothing was scanned.
+type InvalidOptionsError struct {
+	Reason string
+}
+
+func (e InvalidOptionsError) Error() string {
+	return fmt.Sprintf("Invalid nuke options: %s", e.Reason)
+}
+
+// ScanError is returned by Run when the resources could not be scanned, in which case nothing was nuked.
+type ScanError struct {
+	Underlying error
+}
+
+func (e ScanError) Error() string {
+	return e.Underlying.Error()
+}
+
+func (e ScanError) Unwrap() error {
+	return e.Underlying
+}
@@ -0,0 +1,155 @@
+// Package nuke runs cloud-nuke from Go code, the way the aws and gcp commands do: it scans an AWS account or a GCP
+// project, asks for confirmation and nukes the resources that were found, reporting the run to renderers.
+package nuke
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/logging"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	commonTelemetry "github.com/gruntwork-io/go-commons/telemetry"
+)
+
+// ConfirmFunc decides whether the resources found by a run are nuked. It is called once the scan is done, even when
+// nothing was found, with the ResourceFound events of the scan. Returning false ends the run without nuking anything.
+type ConfirmFunc func(ctx context.Context, found []reporting.ResourceFound) (bool, error)
+
+// Options configures a run. Exactly one of AWS and GCP must be set.
+type Options struct {
+	// AWS is the query of a run against the AWS account of the current credentials. It must have been validated.
+	AWS *aws.Query
+	// GCP is the query of a run against a GCP project. It must have been validated.
+	GCP *gcp.Query
+	// Config filters the resources, like the config file of the CLI.
+	Config config.Config
+	// Renderers receive the events of the run. They are completed when the run ends, so that they flush their output.
+	Renderers []reporting.Renderer
+	// Emitter, if set, also receives the events of the run. Unlike renderers it isn't completed when the run ends,
+	// so several runs can be reported to the same collector.
+	Emitter reporting.Emitter
+	// Confirm is asked whether to nuke the resources found. Nil nukes them without asking.
+	Confirm ConfirmFunc
+	// DryRun only scans the resources, without asking for confirmation or nuking them.
+	DryRun bool
+}
+
+// Run scans the resources selected by the options, asks for confirmation and nukes them. The returned result holds
+// what the run found and deleted, including when an error is returned after nuking started.
+func Run(ctx context.Context, opts Options) (*Result, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	collector := reporting.NewCollector()
+	for _, renderer := range opts.Renderers {
+		collector.AddRenderer(renderer)
+	}
+	if opts.Emitter != nil {
+		collector.AddRenderer(emitterRenderer{emitter: opts.Emitter})
+	}
+	collector.AddRenderer(resultRecorder{result: result})
+	defer collector.Complete()
+
+	if opts.AWS != nil {
+		return result, runAws(ctx, opts, result, collector)
+	}
+	return result, runGcp(ctx, opts, result, collector)
+}
+
+// runAws scans and nukes the AWS account of the current credentials.
+func runAws(ctx context.Context, opts Options, result *Result, collector *reporting.Collector) error {
+	collector.Emit(aws.NewScanStarted(opts.AWS))
+
+	account, err := aws.GetAllResources(ctx, opts.AWS, opts.Config, collector)
+	if err != nil {
+		return scanError(aws.ResourceInspectionError{Underlying: err})
+	}
+	collector.Emit(reporting.ScanComplete{})
+
+	// Refuse to nuke more than the delete limits allow, before asking for confirmation
+	if err := aws.EnforceDeleteLimits(account, opts.AWS); err != nil {
+		return err
+	}
+
+	proceed, err := opts.confirm(ctx, result, account.TotalResourceCount())
+	if err != nil || !proceed {
+		return err
+	}
+	return aws.NukeAllResources(ctx, account, opts.AWS, collector)
+}
+
+// runGcp scans and nukes the GCP project of the query.
+func runGcp(ctx context.Context, opts Options, result *Result, collector *reporting.Collector) error {
+	project, err := gcp.GetAllResources(ctx, opts.GCP, opts.Config, collector)
+	if err != nil {
+		return scanError(err)
+	}
+	collector.Emit(reporting.ScanComplete{})
+
+	// Refuse to nuke more than the delete limits allow, before asking for confirmation
+	if err := gcp.EnforceDeleteLimits(project, opts.GCP, opts.Config); err != nil {
+		return err
+	}
+
+	proceed, err := opts.confirm(ctx, result, project.TotalResourceCount())
+	if err != nil || !proceed {
+		return err
+	}
+	return gcp.NukeAllResources(ctx, project, opts.GCP, collector)
+}
+
+// validate ensures the options select a single cloud.
+func (opts Options) validate() error {
+	if opts.AWS == nil && opts.GCP == nil {
+		return InvalidOptionsError{Reason: "one of AWS and GCP must be set"}
+	}
+	if opts.AWS != nil && opts.GCP != nil {
+		return InvalidOptionsError{Reason: "only one of AWS and GCP can be set"}
+	}
+	return nil
+}
+
+// confirm returns true if the total resources found by the scan should be nuked.
+func (opts Options) confirm(ctx context.Context, result *Result, total int) (bool, error) {
+	if opts.DryRun {
+		logging.Info("Not taking any action as dry-run is set.")
+		return false, nil
+	}
+
+	proceed := true
+	if opts.Confirm != nil {
+		var err error
+		proceed, err = opts.Confirm(ctx, slices.Clone(result.Found))
+		if err != nil {
+			return false, err
+		}
+	}
+	return proceed && total > 0, nil
+}
+
+// scanError reports the failure of a scan to telemetry, and wraps it in a ScanError.
+func scanError(err error) error {
+	telemetry.TrackEvent(commonTelemetry.EventContext{
+		EventName: "Error getting resources",
+	}, map[string]interface{}{})
+	return ScanError{Underlying: err}
+}
+
+// emitterRenderer forwards the events of a run to an emitter, except Complete, which only concerns the run's own
+// renderers.
+type emitterRenderer struct {
+	emitter reporting.Emitter
+}
+
+func (r emitterRenderer) OnEvent(event reporting.Event) {
+	if _, ok := event.(reporting.Complete); ok {
+		return
+	}
+	r.emitter.Emit(event)
+}
@@ -0,0 +1,158 @@
+package nuke
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/aws"
+	"github.com/gruntwork-io/cloud-nuke/gcp"
+	"github.com/gruntwork-io/cloud-nuke/gcp/resources"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/gruntwork-io/cloud-nuke/resource/resourcetest"
+	"github.com/gruntwork-io/cloud-nuke/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testResourceType = "nuke-test-resource"
+
+// testResources are the identifiers listed by the test resource type, and testFake holds them. It is replaced by
+// every test, so that deletions don't leak between tests.
+var (
+	testResources = []string{"resource-1", "resource-2"}
+	registerOnce  sync.Once
+	testFake      *resourcetest.Fake
+)
+
+// registerTestResource registers a GCP resource type that lists testResources, and resets their deletions.
+func registerTestResource(t *testing.T) {
+	testFake = resourcetest.NewFake(testResourceType, testResources...)
+
+	registerOnce.Do(func() {
+		require.NoError(t, gcp.RegisterResource(func() gcp.GcpResource {
+			return resources.NewGcpResource(testFake.Resource())
+		}, resource.Registration{Global: true}))
+	})
+}
+
+// testQuery returns a query of the test resource type. The clients of the built-in resource types are created
+// before the resource types are filtered, so they are given credentials that are never used.
+func testQuery(t *testing.T) *gcp.Query {
+	credentials := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, os.WriteFile(credentials,
+		[]byte(`{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`), 0600))
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", credentials)
+	t.Setenv("DISABLE_TELEMETRY", "true")
+	telemetry.InitTelemetry("cloud-nuke", "")
+
+	query := &gcp.Query{ProjectID: "test-project", ResourceTypes: []string{testResourceType}}
+	require.NoError(t, query.Validate())
+	return query
+}
+
+func TestRun(t *testing.T) {
+	registerTestResource(t)
+
+	renderer := &resourcetest.Renderer{}
+	var confirmed []reporting.ResourceFound
+	result, err := Run(context.Background(), Options{
+		GCP:       testQuery(t),
+		Renderers: []reporting.Renderer{renderer},
+		Confirm: func(ctx context.Context, found []reporting.ResourceFound) (bool, error) {
+			confirmed = found
+			return true, nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, confirmed, 2)
+	assert.Len(t, result.Found, 2)
+	assert.True(t, result.Nuked)
+	assert.Equal(t, 2, result.Succeeded())
+	assert.Empty(t, result.Failed())
+	assert.Empty(t, testFake.Existing())
+
+	// Renderers are completed when the run ends
+	events := renderer.Events()
+	require.NotEmpty(t, events)
+	assert.Equal(t, reporting.Complete{}, events[len(events)-1])
+}
+
+func TestRun_NotConfirmed(t *testing.T) {
+	registerTestResource(t)
+
+	result, err := Run(context.Background(), Options{
+		GCP: testQuery(t),
+		Confirm: func(ctx context.Context, found []reporting.ResourceFound) (bool, error) {
+			return false, nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, result.Found, 2)
+	assert.False(t, result.Nuked)
+	assert.Equal(t, testResources, testFake.Existing())
+}
+
+func TestRun_DryRun(t *testing.T) {
+	registerTestResource(t)
+
+	result, err := Run(context.Background(), Options{
+		GCP:    testQuery(t),
+		DryRun: true,
+		Confirm: func(ctx context.Context, found []reporting.ResourceFound) (bool, error) {
+			t.Fatal("dry runs must not ask for confirmation")
+			return true, nil
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Len(t, result.Found, 2)
+	assert.False(t, result.Nuked)
+	assert.Equal(t, testResources, testFake.Existing())
+}
+
+func TestRun_Emitter(t *testing.T) {
+	registerTestResource(t)
+
+	// The emitter receives the events of the run, but isn't completed
+	buffer := &reporting.EventBuffer{}
+	_, err := Run(context.Background(), Options{GCP: testQuery(t), Emitter: buffer, DryRun: true})
+	require.NoError(t, err)
+
+	collector, renderer := resourcetest.NewCollector()
+	buffer.FlushTo(collector)
+	assert.Contains(t, renderer.Events(), reporting.Event(reporting.ScanComplete{}))
+	assert.NotContains(t, renderer.Events(), reporting.Event(reporting.Complete{}))
+}
+
+func TestRun_InvalidOptions(t *testing.T) {
+	var invalid InvalidOptionsError
+
+	_, err := Run(context.Background(), Options{})
+	require.ErrorAs(t, err, &invalid)
+
+	_, err = Run(context.Background(), Options{AWS: &aws.Query{}, GCP: &gcp.Query{}})
+	require.ErrorAs(t, err, &invalid)
+}
+
+func TestResult_Outcomes(t *testing.T) {
+	result := &Result{Deleted: []reporting.ResourceDeleted{
+		{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Warning: true, Pass: 1},
+		{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-2", Success: true, Pass: 1},
+		{ResourceType: "vpc", Region: "us-west-2", Identifier: "vpc-1", Error: "denied", Pass: 1},
+		{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Success: true, Pass: 2},
+	}}
+
+	outcomes := result.Outcomes()
+	require.Len(t, outcomes, 3)
+	assert.Equal(t, reporting.ResourceDeleted{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Success: true, Pass: 2}, outcomes[0])
+	assert.Equal(t, 2, result.Succeeded())
+	assert.Equal(t, []reporting.ResourceDeleted{
+		{ResourceType: "vpc", Region: "us-west-2", Identifier: "vpc-1", Error: "denied", Pass: 1},
+	}, result.Failed())
+}
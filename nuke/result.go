@@ -0,0 +1,87 @@
+package nuke
+
+import (
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+)
+
+// Result holds the events of a run, for callers that act on its outcome rather than render it.
+type Result struct {
+	// Found lists the resources found by the scan, including those that are not nukable.
+	Found []reporting.ResourceFound
+	// Nuked is true if the resources found were nuked, i.e. the run was confirmed and found resources to nuke.
+	Nuked bool
+	// Deleted lists every deletion attempt. Resources retried in several passes have an attempt per pass, see Outcomes.
+	Deleted []reporting.ResourceDeleted
+	// StillPresent lists the deleted resources that the verification still found.
+	StillPresent []reporting.ResourceStillPresent
+	// CircuitBreakers lists the resource types and regions whose deletions were stopped after repeated failures.
+	CircuitBreakers []reporting.CircuitBreakerTripped
+	// Errors lists the errors that are not about a single resource, such as a region that could not be scanned.
+	Errors []reporting.GeneralError
+}
+
+// resourceKey identifies a resource across the deletion attempts of a run.
+type resourceKey struct {
+	account, project, resourceType, region, identifier string
+}
+
+// Outcomes returns the last deletion attempt of each resource, in the order the resources were first attempted.
+func (r *Result) Outcomes() []reporting.ResourceDeleted {
+	var outcomes []reporting.ResourceDeleted
+	positions := make(map[resourceKey]int)
+	for _, attempt := range r.Deleted {
+		key := resourceKey{attempt.Account, attempt.Project, attempt.ResourceType, attempt.Region, attempt.Identifier}
+		if i, ok := positions[key]; ok {
+			outcomes[i] = attempt
+			continue
+		}
+		positions[key] = len(outcomes)
+		outcomes = append(outcomes, attempt)
+	}
+	return outcomes
+}
+
+// Succeeded returns the number of resources that were deleted.
+func (r *Result) Succeeded() int {
+	succeeded := 0
+	for _, outcome := range r.Outcomes() {
+		if outcome.Success {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+// Failed returns the last deletion attempt of the resources that could not be deleted.
+func (r *Result) Failed() []reporting.ResourceDeleted {
+	var failed []reporting.ResourceDeleted
+	for _, outcome := range r.Outcomes() {
+		if !outcome.Success {
+			failed = append(failed, outcome)
+		}
+	}
+	return failed
+}
+
+// resultRecorder records the events of a run in its result. The collector serializes the events, and the result is
+// only read once the scan or the run is over.
+type resultRecorder struct {
+	result *Result
+}
+
+func (r resultRecorder) OnEvent(event reporting.Event) {
+	switch e := event.(type) {
+	case reporting.ResourceFound:
+		r.result.Found = append(r.result.Found, e)
+	case reporting.NukeStarted:
+		r.result.Nuked = true
+	case reporting.ResourceDeleted:
+		r.result.Deleted = append(r.result.Deleted, e)
+	case reporting.ResourceStillPresent:
+		r.result.StillPresent = append(r.result.StillPresent, e)
+	case reporting.CircuitBreakerTripped:
+		r.result.CircuitBreakers = append(r.result.CircuitBreakers, e)
+	case reporting.GeneralError:
+		r.result.Errors = append(r.result.Errors, e)
+	}
+}
@@ -1,6 +1,7 @@
 package renderers
 
 import (
+	"cmp"
 	"fmt"
 	"io"
 	"os"
@@ -31,8 +32,13 @@ type CLIRenderer struct {
 	progressBar *pterm.ProgressbarPrinter
 	found       []reporting.ResourceFound
 	deleted     []reporting.ResourceDeleted
+	present     []reporting.ResourceStillPresent
+	tripped     []reporting.CircuitBreakerTripped
 	errors      []reporting.GeneralError
 	nukeMode    bool // true if NukeStarted was received, determines if ScanComplete is terminal
+	multiPass   bool // true if deletions are tagged with their nuke pass
+	interrupted bool // true if Interrupted was received, so results are partial
+	finished    bool // true once the final output has been printed
 }
 
 // NewCLIRenderer creates a CLI renderer with an active spinner.
@@ -61,24 +67,55 @@ func (r *CLIRenderer) OnEvent(event reporting.Event) {
 	case reporting.ScanStarted:
 		r.handleScanStarted(e)
 	case reporting.ScanProgress:
-		r.updateSpinner(fmt.Sprintf("Scanning %s in %s", e.ResourceType, e.Region))
+		r.updateSpinner(fmt.Sprintf("Scanning %s in %s", e.ResourceType, inOwner(e.Region, e.Account, e.Project)))
 	case reporting.ResourceFound:
 		r.found = append(r.found, e)
 	case reporting.ScanComplete:
 		r.handleScanComplete()
 	case reporting.ResourceDeleted:
 		r.handleResourceDeleted(e)
+	case reporting.ResourceStillPresent:
+		r.present = append(r.present, e)
+	case reporting.CircuitBreakerTripped:
+		r.tripped = append(r.tripped, e)
 	case reporting.GeneralError:
 		r.errors = append(r.errors, e)
 	case reporting.NukeStarted:
 		r.handleNukeStarted(e)
+	case reporting.NukePassStarted:
+		r.handleNukePassStarted(e)
 	case reporting.NukeProgress:
-		r.updateProgressBar(fmt.Sprintf("Nuking batch of %d %s in %s", e.BatchSize, e.ResourceType, e.Region))
+		r.updateProgressBar(fmt.Sprintf("Nuking batch of %d %s in %s", e.BatchSize, e.ResourceType, inOwner(e.Region, e.Account, e.Project)))
 	case reporting.NukeComplete:
 		r.handleNukeComplete()
+	case reporting.Interrupted:
+		r.handleInterrupted(e)
 	}
 }
 
+// inOwner qualifies a region with the account or project it belongs to in multi-account and multi-project runs.
+func inOwner(region string, account string, project string) string {
+	switch {
+	case account != "":
+		return region + " of account " + account
+	case project != "":
+		return region + " of project " + project
+	}
+	return region
+}
+
+// ownerHeader returns the header of the column listing the AWS accounts or GCP projects of the rows in
+// multi-account and multi-project runs, or an empty string when a row has neither.
+func ownerHeader(account string, project string) string {
+	switch {
+	case account != "":
+		return "Account"
+	case project != "":
+		return "Project"
+	}
+	return ""
+}
+
 // updateSpinner safely updates spinner text if spinner is active.
 func (r *CLIRenderer) updateSpinner(text string) {
 	if r.spinner != nil {
@@ -107,12 +144,17 @@ func (r *CLIRenderer) handleScanComplete() {
 		if len(r.errors) == 0 && len(r.found) == 0 {
 			pterm.Info.WithWriter(r.writer).Println("No resources found.")
 		}
+		r.printInterruptedNotice()
+		r.finished = true
 	}
 }
 
 // handleResourceDeleted records deletion result and updates progress bar.
 func (r *CLIRenderer) handleResourceDeleted(e reporting.ResourceDeleted) {
 	r.deleted = append(r.deleted, e)
+	if e.Pass > 0 {
+		r.multiPass = true
+	}
 	if r.progressBar != nil {
 		r.progressBar.Add(1)
 	}
@@ -128,6 +170,21 @@ func (r *CLIRenderer) handleNukeStarted(e reporting.NukeStarted) {
 	r.progressBar = progressBar
 }
 
+// handleNukePassStarted announces a retry pass and restarts the progress bar for the resources it retries.
+func (r *CLIRenderer) handleNukePassStarted(e reporting.NukePassStarted) {
+	if r.progressBar != nil {
+		_, _ = r.progressBar.Stop()
+		r.progressBar = nil
+	}
+	pterm.DefaultSection.WithTopPadding(1).WithBottomPadding(0).
+		Printfln("Nuke Pass %d: retrying %d resources", e.Pass, e.Total)
+	progressBar, err := pterm.DefaultProgressbar.WithTotal(e.Total).Start()
+	if err != nil {
+		_, _ = fmt.Fprintf(r.writer, "Warning: failed to start progress bar: %v\n", err)
+	}
+	r.progressBar = progressBar
+}
+
 // handleNukeComplete stops progress bar and displays final results.
 func (r *CLIRenderer) handleNukeComplete() {
 	if r.progressBar != nil {
@@ -136,6 +193,36 @@ func (r *CLIRenderer) handleNukeComplete() {
 	}
 	r.printErrorsTable()
 	r.printDeletedTable()
+	r.printStillPresentTable()
+	r.printCircuitBreakerNotices()
+	r.printInterruptedNotice()
+	r.finished = true
+}
+
+// handleInterrupted reports that the run is stopping. While a spinner or progress bar is active it shows
+// the status there, otherwise the interruption is printed right away.
+func (r *CLIRenderer) handleInterrupted(e reporting.Interrupted) {
+	if r.interrupted {
+		return
+	}
+	r.interrupted = true
+
+	status := "Interrupted, waiting for in-flight operations to finish"
+	switch {
+	case r.progressBar != nil:
+		r.updateProgressBar(status)
+	case r.spinner != nil:
+		r.updateSpinner(status)
+	case !r.finished:
+		pterm.Warning.WithWriter(r.writer).Println(e.Reason)
+	}
+}
+
+// printInterruptedNotice warns that the printed results are partial if the run was interrupted.
+func (r *CLIRenderer) printInterruptedNotice() {
+	if r.interrupted {
+		pterm.Warning.WithWriter(r.writer).Println("The run was interrupted, results are partial.")
+	}
 }
 
 func (r *CLIRenderer) printErrorsTable() {
@@ -146,11 +233,21 @@ func (r *CLIRenderer) printErrorsTable() {
 	// Workaround for pterm progressbar cleanup
 	_, _ = r.writer.Write([]byte("\r"))
 
-	tableData := pterm.TableData{
-		{"Resource Type", "Description", "Error"},
+	ownerColumn := ""
+	for _, e := range r.errors {
+		ownerColumn = cmp.Or(ownerColumn, ownerHeader(e.Account, e.Project))
 	}
+	header := []string{"Resource Type", "Description", "Error"}
+	if ownerColumn != "" {
+		header = append([]string{ownerColumn}, header...)
+	}
+	tableData := pterm.TableData{header}
 	for _, e := range r.errors {
-		tableData = append(tableData, []string{e.ResourceType, e.Description, util.Truncate(util.RemoveNewlines(e.Error), 120)})
+		row := []string{e.ResourceType, e.Description, util.Truncate(util.RemoveNewlines(e.Error), 120)}
+		if ownerColumn != "" {
+			row = append([]string{e.Account + e.Project}, row...)
+		}
+		tableData = append(tableData, row)
 	}
 
 	_ = pterm.DefaultTable.
@@ -175,16 +272,47 @@ func (r *CLIRenderer) printFoundTable() {
 		return
 	}
 
-	tableData := pterm.TableData{
-		{"Resource Type", "Region", "Identifier", "Nukable"},
+	// Accounts and projects are only shown in multi-account and multi-project runs, and name and creation time
+	// when listers provided them
+	ownerColumn, showName, showCreated := "", false, false
+	for _, e := range r.found {
+		ownerColumn = cmp.Or(ownerColumn, ownerHeader(e.Account, e.Project))
+		showName = showName || e.Name != ""
+		showCreated = showCreated || e.CreatedAt != nil
+	}
+
+	header := []string{"Resource Type", "Region", "Identifier"}
+	if ownerColumn != "" {
+		header = append([]string{ownerColumn}, header...)
+	}
+	if showName {
+		header = append(header, "Name")
 	}
+	if showCreated {
+		header = append(header, "Created")
+	}
+	tableData := pterm.TableData{append(header, "Nukable")}
 
 	for _, e := range r.found {
 		nukable := SuccessEmoji
 		if !e.Nukable {
 			nukable = e.Reason
 		}
-		tableData = append(tableData, []string{e.ResourceType, e.Region, e.Identifier, nukable})
+		row := []string{e.ResourceType, e.Region, e.Identifier}
+		if ownerColumn != "" {
+			row = append([]string{e.Account + e.Project}, row...)
+		}
+		if showName {
+			row = append(row, e.Name)
+		}
+		if showCreated {
+			created := ""
+			if e.CreatedAt != nil {
+				created = e.CreatedAt.UTC().Format("2006-01-02 15:04:05")
+			}
+			row = append(row, created)
+		}
+		tableData = append(tableData, append(row, nukable))
 	}
 
 	_ = pterm.DefaultTable.
@@ -200,6 +328,7 @@ func (r *CLIRenderer) printFoundTable() {
 // and region instead of listing every individual resource.
 func (r *CLIRenderer) printFoundSummaryTable() {
 	type key struct {
+		Owner        string
 		ResourceType string
 		Region       string
 	}
@@ -211,9 +340,11 @@ func (r *CLIRenderer) printFoundSummaryTable() {
 
 	summary := make(map[key]*counts)
 	var order []key
+	ownerColumn := ""
 
 	for _, e := range r.found {
-		k := key{e.ResourceType, e.Region}
+		k := key{e.Account + e.Project, e.ResourceType, e.Region}
+		ownerColumn = cmp.Or(ownerColumn, ownerHeader(e.Account, e.Project))
 		c, exists := summary[k]
 		if !exists {
 			c = &counts{}
@@ -228,18 +359,24 @@ func (r *CLIRenderer) printFoundSummaryTable() {
 		}
 	}
 
-	tableData := pterm.TableData{
-		{"Resource Type", "Region", "Count", "Nukable", "Not Nukable"},
+	header := []string{"Resource Type", "Region", "Count", "Nukable", "Not Nukable"}
+	if ownerColumn != "" {
+		header = append([]string{ownerColumn}, header...)
 	}
+	tableData := pterm.TableData{header}
 	for _, k := range order {
 		c := summary[k]
-		tableData = append(tableData, []string{
+		row := []string{
 			k.ResourceType,
 			k.Region,
 			fmt.Sprintf("%d", c.total),
 			fmt.Sprintf("%d", c.nukable),
 			fmt.Sprintf("%d", c.nonNukable),
-		})
+		}
+		if ownerColumn != "" {
+			row = append([]string{k.Owner}, row...)
+		}
+		tableData = append(tableData, row)
 	}
 
 	pterm.Info.WithWriter(r.writer).Printfln(
@@ -269,6 +406,20 @@ func (r *CLIRenderer) handleScanStarted(e reporting.ScanStarted) {
 		{"Query Parameter", "Value"},
 	}
 
+	// Listing accounts if there are <= 5 accounts, otherwise the table format breaks
+	if len(e.Accounts) > 5 {
+		tableData = append(tableData, []string{"Target Accounts", fmt.Sprintf("%d accounts (too many to list all)", len(e.Accounts))})
+	} else if len(e.Accounts) > 0 {
+		tableData = append(tableData, []string{"Target Accounts", strings.Join(e.Accounts, ", ")})
+	}
+
+	// Listing projects if there are <= 5 projects, otherwise the table format breaks
+	if len(e.Projects) > 5 {
+		tableData = append(tableData, []string{"Target Projects", fmt.Sprintf("%d projects (too many to list all)", len(e.Projects))})
+	} else if len(e.Projects) > 0 {
+		tableData = append(tableData, []string{"Target Projects", strings.Join(e.Projects, ", ")})
+	}
+
 	// Listing regions if there are <= 5 regions, otherwise the table format breaks
 	if len(e.Regions) > 5 {
 		tableData = append(tableData, []string{"Target Regions", fmt.Sprintf("%d regions (too many to list all)", len(e.Regions))})
@@ -289,7 +440,10 @@ func (r *CLIRenderer) handleScanStarted(e reporting.ScanStarted) {
 	if e.IncludeAfter != "" {
 		tableData = append(tableData, []string{"Include After Filter", e.IncludeAfter})
 	}
-	tableData = append(tableData, []string{"List Unaliased KMS Keys", fmt.Sprintf("%t", e.ListUnaliasedKMSKeys)})
+	// KMS keys are AWS resources
+	if len(e.Projects) == 0 {
+		tableData = append(tableData, []string{"List Unaliased KMS Keys", fmt.Sprintf("%t", e.ListUnaliasedKMSKeys)})
+	}
 
 	_ = pterm.DefaultTable.WithBoxed(true).
 		WithData(tableData).
@@ -319,9 +473,18 @@ func (r *CLIRenderer) printDeletedTable() {
 		return
 	}
 
-	tableData := pterm.TableData{
-		{"Identifier", "Resource Type", "Deleted Successfully"},
+	ownerColumn := ""
+	for _, e := range r.deleted {
+		ownerColumn = cmp.Or(ownerColumn, ownerHeader(e.Account, e.Project))
+	}
+	header := []string{"Identifier", "Resource Type", "Deleted Successfully"}
+	if ownerColumn != "" {
+		header = append(header, ownerColumn)
 	}
+	if r.multiPass {
+		header = append(header, "Pass")
+	}
+	tableData := pterm.TableData{header}
 
 	for _, e := range r.deleted {
 		var status string
@@ -332,7 +495,55 @@ func (r *CLIRenderer) printDeletedTable() {
 		} else {
 			status = fmt.Sprintf("%s %s", FailureEmoji, util.Truncate(util.RemoveNewlines(e.Error), 40))
 		}
-		tableData = append(tableData, []string{e.Identifier, e.ResourceType, status})
+		row := []string{e.Identifier, e.ResourceType, status}
+		if ownerColumn != "" {
+			row = append(row, e.Account+e.Project)
+		}
+		if r.multiPass {
+			row = append(row, fmt.Sprintf("%d", e.Pass))
+		}
+		tableData = append(tableData, row)
+	}
+
+	_ = pterm.DefaultTable.
+		WithHasHeader().
+		WithBoxed(true).
+		WithRowSeparator("-").
+		WithLeftAlignment().
+		WithData(tableData).
+		WithWriter(r.writer).
+		Render()
+
+	_, _ = r.writer.Write([]byte("\r"))
+}
+
+// printCircuitBreakerNotices warns about each resource type or region that was skipped after repeated failures.
+func (r *CLIRenderer) printCircuitBreakerNotices() {
+	for _, e := range r.tripped {
+		target := e.ResourceType + " in " + inOwner(e.Region, e.Account, e.Project)
+		if e.ResourceType == "" {
+			target = inOwner(e.Region, e.Account, e.Project)
+		}
+		pterm.Warning.WithWriter(r.writer).Printfln(
+			"Stopped nuking %s after repeated %s failures, %d resources were skipped.", target, e.ErrorClass, e.Skipped)
+	}
+}
+
+// printStillPresentTable lists the resources that were deleted successfully but were still present
+// when the nuke was verified.
+func (r *CLIRenderer) printStillPresentTable() {
+	if len(r.present) == 0 {
+		return
+	}
+
+	pterm.Warning.WithWriter(r.writer).Printfln(
+		"%d nuked resources are still present after verification.", len(r.present))
+
+	tableData := pterm.TableData{
+		{"Identifier", "Resource Type", "Region"},
+	}
+	for _, e := range r.present {
+		tableData = append(tableData, []string{e.Identifier, e.ResourceType, inOwner(e.Region, e.Account, e.Project)})
 	}
 
 	_ = pterm.DefaultTable.
@@ -351,6 +562,7 @@ func (r *CLIRenderer) printDeletedTable() {
 // grouped by resource type and region.
 func (r *CLIRenderer) printDeletedSummaryTable() {
 	type key struct {
+		Owner        string
 		ResourceType string
 		Region       string
 	}
@@ -362,9 +574,11 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 
 	summary := make(map[key]*counts)
 	var order []key
+	ownerColumn := ""
 
 	for _, e := range r.deleted {
-		k := key{e.ResourceType, e.Region}
+		k := key{e.Account + e.Project, e.ResourceType, e.Region}
+		ownerColumn = cmp.Or(ownerColumn, ownerHeader(e.Account, e.Project))
 		c, exists := summary[k]
 		if !exists {
 			c = &counts{}
@@ -380,18 +594,24 @@ func (r *CLIRenderer) printDeletedSummaryTable() {
 		}
 	}
 
-	tableData := pterm.TableData{
-		{"Resource Type", "Region", "Successful", "Failed", "Warned"},
+	header := []string{"Resource Type", "Region", "Successful", "Failed", "Warned"}
+	if ownerColumn != "" {
+		header = append([]string{ownerColumn}, header...)
 	}
+	tableData := pterm.TableData{header}
 	for _, k := range order {
 		c := summary[k]
-		tableData = append(tableData, []string{
+		row := []string{
 			k.ResourceType,
 			k.Region,
 			fmt.Sprintf("%d", c.success),
 			fmt.Sprintf("%d", c.failure),
 			fmt.Sprintf("%d", c.warned),
-		})
+		}
+		if ownerColumn != "" {
+			row = append([]string{k.Owner}, row...)
+		}
+		tableData = append(tableData, row)
 	}
 
 	pterm.Info.WithWriter(r.writer).Printfln(
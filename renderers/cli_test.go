@@ -175,3 +175,75 @@ func TestCLIRenderer_LargeDeletedDatasetSummary(t *testing.T) {
 	assert.Contains(t, output, "--output json")
 	assert.NotContains(t, output, "topic-0")
 }
+
+func TestCLIRenderer_Interrupted(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCLIRenderer(&buf)
+
+	r.OnEvent(reporting.Interrupted{Reason: "interrupted"})
+	r.OnEvent(reporting.Interrupted{Reason: "interrupted"})
+	r.OnEvent(reporting.ScanComplete{})
+
+	assert.True(t, r.interrupted)
+	assert.Contains(t, buf.String(), "results are partial")
+}
+
+func TestCLIRenderer_StillPresent(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCLIRenderer(&buf)
+
+	r.OnEvent(reporting.NukeStarted{Total: 1})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "rds", Region: "us-east-1", Identifier: "db-1", Success: true})
+	r.OnEvent(reporting.ResourceStillPresent{ResourceType: "rds", Region: "us-east-1", Identifier: "db-1"})
+	r.OnEvent(reporting.NukeComplete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "1 nuked resources are still present after verification")
+	assert.Contains(t, output, "db-1")
+}
+
+func TestCLIRenderer_CircuitBreakerTripped(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCLIRenderer(&buf)
+
+	r.OnEvent(reporting.NukeStarted{Total: 12})
+	r.OnEvent(reporting.CircuitBreakerTripped{ResourceType: "s3", Region: "us-east-1", ErrorClass: "AccessDenied", Skipped: 10})
+	r.OnEvent(reporting.CircuitBreakerTripped{Region: "eu-west-1", ErrorClass: "AccessDenied", Skipped: 4})
+	r.OnEvent(reporting.NukeComplete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "Stopped nuking s3 in us-east-1 after repeated AccessDenied failures, 10 resources were skipped")
+	assert.Contains(t, output, "Stopped nuking eu-west-1 after repeated AccessDenied failures, 4 resources were skipped")
+}
+
+func TestCLIRenderer_MultiAccount(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCLIRenderer(&buf)
+
+	r.OnEvent(reporting.ResourceFound{Account: "111111111111", ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.ScanComplete{})
+	r.OnEvent(reporting.NukeStarted{Total: 1})
+	r.OnEvent(reporting.ResourceDeleted{Account: "111111111111", ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.CircuitBreakerTripped{Account: "111111111111", Region: "eu-west-1", ErrorClass: "AccessDenied", Skipped: 4})
+	r.OnEvent(reporting.NukeComplete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "Account")
+	assert.Contains(t, output, "111111111111")
+	assert.Contains(t, output, "Stopped nuking eu-west-1 of account 111111111111 after repeated AccessDenied failures")
+}
+
+func TestCLIRenderer_MultiProject(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewCLIRenderer(&buf)
+
+	r.OnEvent(reporting.ResourceFound{Project: "sandbox-1", ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket-1", Nukable: true})
+	r.OnEvent(reporting.GeneralError{Project: "sandbox-2", Description: "Unable to scan project", Error: "permission denied"})
+	r.OnEvent(reporting.ScanComplete{})
+
+	output := buf.String()
+	assert.Contains(t, output, "Project")
+	assert.Contains(t, output, "sandbox-1")
+	assert.Contains(t, output, "sandbox-2")
+	assert.NotContains(t, output, "Account")
+}
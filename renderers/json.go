@@ -33,10 +33,16 @@ type JSONRenderer struct {
 	command  string
 	query    *QueryParams
 	regions  []string
+	accounts []string
+	projects []string
 	found    []reporting.ResourceFound
 	deleted  []reporting.ResourceDeleted
+	present  []reporting.ResourceStillPresent
+	tripped  []reporting.CircuitBreakerTripped
 	errors   []reporting.GeneralError
 	nukeMode bool // true if NukeStarted was received, determines output format
+	// interrupted is true if Interrupted was received, marking the output as partial
+	interrupted bool
 }
 
 // NewJSONRenderer creates a JSON renderer.
@@ -45,13 +51,15 @@ func NewJSONRenderer(writer io.Writer, cfg JSONRendererConfig) *JSONRenderer {
 		writer = os.Stdout
 	}
 	return &JSONRenderer{
-		writer:  writer,
-		command: cfg.Command,
-		query:   cfg.Query,
-		regions: cfg.Regions,
-		found:   make([]reporting.ResourceFound, 0),
-		deleted: make([]reporting.ResourceDeleted, 0),
-		errors:  make([]reporting.GeneralError, 0),
+		writer:   writer,
+		command:  cfg.Command,
+		query:    cfg.Query,
+		regions:  cfg.Regions,
+		accounts: cfg.Accounts,
+		projects: cfg.Projects,
+		found:    make([]reporting.ResourceFound, 0),
+		deleted:  make([]reporting.ResourceDeleted, 0),
+		errors:   make([]reporting.GeneralError, 0),
 	}
 }
 
@@ -62,10 +70,16 @@ func (r *JSONRenderer) OnEvent(event reporting.Event) {
 		r.found = append(r.found, e)
 	case reporting.ResourceDeleted:
 		r.deleted = append(r.deleted, e)
+	case reporting.ResourceStillPresent:
+		r.present = append(r.present, e)
+	case reporting.CircuitBreakerTripped:
+		r.tripped = append(r.tripped, e)
 	case reporting.GeneralError:
 		r.errors = append(r.errors, e)
 	case reporting.NukeStarted:
 		r.nukeMode = true
+	case reporting.Interrupted:
+		r.interrupted = true
 	case reporting.Complete:
 		var err error
 		if r.nukeMode {
@@ -82,20 +96,27 @@ func (r *JSONRenderer) OnEvent(event reporting.Event) {
 func (r *JSONRenderer) renderInspectOutput() error {
 	byType := make(map[string]int)
 	byRegion := make(map[string]int)
+	var byAccount, byProject map[string]int
 	nukableCount := 0
 	nonNukableCount := 0
 
 	resources := make([]ResourceInfo, 0, len(r.found))
 	for _, e := range r.found {
-		resources = append(resources, ResourceInfo{
-			ResourceType: e.ResourceType,
-			Region:       e.Region,
-			Identifier:   e.Identifier,
-			Nukable:      e.Nukable,
-			Reason:       e.Reason,
-		})
+		resources = append(resources, newResourceInfo(e))
 		byType[e.ResourceType]++
 		byRegion[e.Region]++
+		if e.Account != "" {
+			if byAccount == nil {
+				byAccount = make(map[string]int)
+			}
+			byAccount[e.Account]++
+		}
+		if e.Project != "" {
+			if byProject == nil {
+				byProject = make(map[string]int)
+			}
+			byProject[e.Project]++
+		}
 		if e.Nukable {
 			nukableCount++
 		} else {
@@ -106,6 +127,8 @@ func (r *JSONRenderer) renderInspectOutput() error {
 	errors := make([]GeneralError, 0, len(r.errors))
 	for _, e := range r.errors {
 		errors = append(errors, GeneralError{
+			Account:      e.Account,
+			Project:      e.Project,
 			ResourceType: e.ResourceType,
 			Description:  e.Description,
 			Error:        e.Error,
@@ -130,7 +153,10 @@ func (r *JSONRenderer) renderInspectOutput() error {
 			GeneralErrors:  len(r.errors),
 			ByType:         byType,
 			ByRegion:       byRegion,
+			ByAccount:      byAccount,
+			ByProject:      byProject,
 		},
+		Interrupted: r.interrupted,
 	}
 
 	return r.encode(output)
@@ -140,72 +166,170 @@ func (r *JSONRenderer) renderNukeOutput() error {
 	// Build found resources list
 	found := make([]ResourceInfo, 0, len(r.found))
 	for _, e := range r.found {
-		found = append(found, ResourceInfo{
-			ResourceType: e.ResourceType,
-			Region:       e.Region,
-			Identifier:   e.Identifier,
-			Nukable:      e.Nukable,
-			Reason:       e.Reason,
-		})
+		found = append(found, newResourceInfo(e))
 	}
 
-	// Build deleted resources list
+	// Build deleted resources list. Every attempt is listed, while the summary only counts
+	// the last attempt on each resource, so retried resources are not counted twice.
 	resources := make([]NukeResourceInfo, 0, len(r.deleted))
-	deletedCount := 0
-	failedCount := 0
-	warnedCount := 0
+	finalStatus := make(map[string]string)
+	var statusOrder []string
+	passes := make([]NukePassSummary, 0)
 
 	for _, e := range r.deleted {
-		status := "deleted"
-		if !e.Success {
-			if e.Warning {
-				status = "warned"
-				warnedCount++
-			} else {
-				status = "failed"
-				failedCount++
-			}
-		} else {
-			deletedCount++
-		}
+		status := deletionStatus(e)
 		resources = append(resources, NukeResourceInfo{
+			Account:      e.Account,
+			Project:      e.Project,
 			ResourceType: e.ResourceType,
 			Region:       e.Region,
 			Identifier:   e.Identifier,
 			Status:       status,
 			Error:        e.Error,
+			Pass:         e.Pass,
 		})
+
+		key := e.Account + e.Project + "/" + e.Region + "/" + e.ResourceType + "/" + e.Identifier
+		if _, seen := finalStatus[key]; !seen {
+			statusOrder = append(statusOrder, key)
+		}
+		finalStatus[key] = status
+
+		if e.Pass > 0 {
+			for len(passes) < e.Pass {
+				passes = append(passes, NukePassSummary{Pass: len(passes) + 1})
+			}
+			passes[e.Pass-1].add(status)
+		}
+	}
+
+	// Deletions that succeeded but were still present when verified are not counted as deleted
+	stillPresent := make([]NukeResourceInfo, 0, len(r.present))
+	for _, e := range r.present {
+		stillPresent = append(stillPresent, NukeResourceInfo{
+			Account:      e.Account,
+			Project:      e.Project,
+			ResourceType: e.ResourceType,
+			Region:       e.Region,
+			Identifier:   e.Identifier,
+			Status:       "still_present",
+		})
+		key := e.Account + e.Project + "/" + e.Region + "/" + e.ResourceType + "/" + e.Identifier
+		if finalStatus[key] == "deleted" {
+			finalStatus[key] = "still_present"
+		}
+	}
+
+	deletedCount := 0
+	failedCount := 0
+	stillPresentCount := 0
+	warnedCount := 0
+	for _, key := range statusOrder {
+		switch finalStatus[key] {
+		case "deleted":
+			deletedCount++
+		case "warned":
+			warnedCount++
+		case "still_present":
+			stillPresentCount++
+		default:
+			failedCount++
+		}
 	}
 
 	errors := make([]GeneralError, 0, len(r.errors))
 	for _, e := range r.errors {
 		errors = append(errors, GeneralError{
+			Account:      e.Account,
+			Project:      e.Project,
 			ResourceType: e.ResourceType,
 			Description:  e.Description,
 			Error:        e.Error,
 		})
 	}
 
+	circuitBreakers := make([]CircuitBreakerInfo, 0, len(r.tripped))
+	for _, e := range r.tripped {
+		circuitBreakers = append(circuitBreakers, CircuitBreakerInfo{
+			Account:      e.Account,
+			Project:      e.Project,
+			ResourceType: e.ResourceType,
+			Region:       e.Region,
+			ErrorClass:   e.ErrorClass,
+			Skipped:      e.Skipped,
+		})
+	}
+
 	output := NukeOutput{
 		Timestamp: time.Now(),
 		Command:   r.command,
 		Regions:   r.regions,
+		Accounts:  r.accounts,
+		Projects:  r.projects,
 		Found:     found,
 		Resources: resources,
 		Errors:    errors,
+		Passes:    passes,
 		Summary: NukeSummary{
-			Found:         len(r.found),
-			Total:         len(r.deleted),
-			Deleted:       deletedCount,
-			Failed:        failedCount,
-			Warned:        warnedCount,
-			GeneralErrors: len(r.errors),
+			Found:                  len(r.found),
+			Total:                  len(statusOrder),
+			Deleted:                deletedCount,
+			Failed:                 failedCount,
+			Warned:                 warnedCount,
+			StillPresent:           stillPresentCount,
+			GeneralErrors:          len(r.errors),
+			CircuitBreakersTripped: len(r.tripped),
 		},
+		CircuitBreakers: circuitBreakers,
+		StillPresent:    stillPresent,
+		Interrupted:     r.interrupted,
 	}
 
 	return r.encode(output)
 }
 
+// newResourceInfo converts a ResourceFound event to its JSON representation.
+func newResourceInfo(e reporting.ResourceFound) ResourceInfo {
+	return ResourceInfo{
+		Account:      e.Account,
+		Project:      e.Project,
+		ResourceType: e.ResourceType,
+		Region:       e.Region,
+		Identifier:   e.Identifier,
+		Nukable:      e.Nukable,
+		Reason:       e.Reason,
+		ARN:          e.ARN,
+		Name:         e.Name,
+		CreatedAt:    e.CreatedAt,
+		Tags:         e.Tags,
+		Attributes:   e.Attributes,
+	}
+}
+
+// deletionStatus returns "deleted", "failed", or "warned" for a deletion attempt.
+func deletionStatus(e reporting.ResourceDeleted) string {
+	if e.Success {
+		return "deleted"
+	}
+	if e.Warning {
+		return "warned"
+	}
+	return "failed"
+}
+
+// add counts a deletion attempt with the given status in the pass summary.
+func (p *NukePassSummary) add(status string) {
+	p.Total++
+	switch status {
+	case "deleted":
+		p.Deleted++
+	case "warned":
+		p.Warned++
+	default:
+		p.Failed++
+	}
+}
+
 func (r *JSONRenderer) encode(v any) error {
 	encoder := json.NewEncoder(r.writer)
 	encoder.SetIndent("", "  ")
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/reporting"
 	"github.com/stretchr/testify/assert"
@@ -54,6 +55,7 @@ func TestJSONRenderer_InspectOutput(t *testing.T) {
 	assert.Equal(t, 1, output.Summary.Nukable)
 	assert.Equal(t, 1, output.Summary.NonNukable)
 	assert.Equal(t, 1, output.Summary.GeneralErrors)
+	assert.Nil(t, output.Summary.ByAccount, "single-account runs are not broken down by account")
 }
 
 func TestJSONRenderer_NukeOutput(t *testing.T) {
@@ -122,6 +124,102 @@ func TestJSONRenderer_NukeOutput(t *testing.T) {
 	assert.Equal(t, 1, output.Summary.Failed)
 }
 
+func TestJSONRenderer_MultiPassNukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "vpc", Region: "us-east-1", Identifier: "vpc-1", Success: true, Pass: 1})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2-subnet", Region: "us-east-1", Identifier: "subnet-1", Warning: true, Error: "DependencyViolation", Pass: 1})
+	r.OnEvent(reporting.NukePassStarted{Pass: 2, Total: 1})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2-subnet", Region: "us-east-1", Identifier: "subnet-1", Success: true, Pass: 2})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+
+	assert.Len(t, output.Resources, 3)
+	assert.Equal(t, 2, output.Resources[2].Pass)
+	assert.Equal(t, []NukePassSummary{
+		{Pass: 1, Total: 2, Deleted: 1, Warned: 1},
+		{Pass: 2, Total: 1, Deleted: 1},
+	}, output.Passes)
+	assert.Equal(t, 2, output.Summary.Total)
+	assert.Equal(t, 2, output.Summary.Deleted)
+	assert.Zero(t, output.Summary.Warned)
+}
+
+func TestJSONRenderer_VerifiedNukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "rds", Region: "us-east-1", Identifier: "db-1", Success: true})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "rds", Region: "us-east-1", Identifier: "db-2", Success: true})
+	r.OnEvent(reporting.ResourceStillPresent{ResourceType: "rds", Region: "us-east-1", Identifier: "db-2"})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+
+	assert.Equal(t, []NukeResourceInfo{
+		{ResourceType: "rds", Region: "us-east-1", Identifier: "db-2", Status: "still_present"},
+	}, output.StillPresent)
+	assert.Equal(t, 2, output.Summary.Total)
+	assert.Equal(t, 1, output.Summary.Deleted)
+	assert.Equal(t, 1, output.Summary.StillPresent)
+}
+
+func TestJSONRenderer_MultiAccountNukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws", Accounts: []string{"111111111111", "222222222222"}})
+
+	// The same identifier in two accounts is two resources
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{Account: "111111111111", ResourceType: "iam-role", Region: "global", Identifier: "sandbox", Success: true})
+	r.OnEvent(reporting.ResourceDeleted{Account: "222222222222", ResourceType: "iam-role", Region: "global", Identifier: "sandbox", Error: "AccessDenied"})
+	r.OnEvent(reporting.GeneralError{Account: "222222222222", ResourceType: "s3", Description: "Unable to list"})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+
+	assert.Equal(t, []string{"111111111111", "222222222222"}, output.Accounts)
+	require.Len(t, output.Resources, 2)
+	assert.Equal(t, "111111111111", output.Resources[0].Account)
+	assert.Equal(t, "222222222222", output.Resources[1].Account)
+	assert.Equal(t, "222222222222", output.Errors[0].Account)
+	assert.Equal(t, 2, output.Summary.Total)
+	assert.Equal(t, 1, output.Summary.Deleted)
+	assert.Equal(t, 1, output.Summary.Failed)
+}
+
+func TestJSONRenderer_CircuitBreakerNukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 12})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "s3", Region: "us-east-1", Identifier: "bucket-1", Error: "AccessDenied"})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "s3", Region: "us-east-1", Identifier: "bucket-2", Error: "AccessDenied"})
+	r.OnEvent(reporting.GeneralError{ResourceType: "s3", Description: "Circuit breaker tripped", Error: "Stopped nuking s3"})
+	r.OnEvent(reporting.CircuitBreakerTripped{ResourceType: "s3", Region: "us-east-1", ErrorClass: "AccessDenied", Skipped: 10})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+
+	assert.Equal(t, []CircuitBreakerInfo{
+		{ResourceType: "s3", Region: "us-east-1", ErrorClass: "AccessDenied", Skipped: 10},
+	}, output.CircuitBreakers)
+	assert.Equal(t, 1, output.Summary.CircuitBreakersTripped)
+	assert.Equal(t, 1, output.Summary.GeneralErrors)
+	assert.Equal(t, 2, output.Summary.Failed)
+}
+
 func TestJSONRenderer_EmptyOutput(t *testing.T) {
 	var buf bytes.Buffer
 	r := NewJSONRenderer(&buf, JSONRendererConfig{
@@ -185,3 +283,82 @@ func TestJSONRenderer_NukeDoesNotOutputOnScanComplete(t *testing.T) {
 	require.NoError(t, err, "output should be valid JSON (single document)")
 	assert.Equal(t, 1, output.Summary.Deleted)
 }
+
+func TestJSONRenderer_InterruptedNukeOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "aws"})
+
+	r.OnEvent(reporting.NukeStarted{Total: 2})
+	r.OnEvent(reporting.ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Success: true})
+	r.OnEvent(reporting.Interrupted{Reason: "interrupted"})
+	r.OnEvent(reporting.NukeComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output NukeOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	assert.True(t, output.Interrupted)
+	assert.Equal(t, 1, output.Summary.Deleted)
+}
+
+func TestJSONRenderer_NotInterruptedOmitsFlag(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "inspect-aws"})
+
+	r.OnEvent(reporting.ScanComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	assert.NotContains(t, buf.String(), "interrupted")
+}
+
+func TestJSONRenderer_ResourceDetails(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "inspect-aws"})
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	r.OnEvent(reporting.ResourceFound{
+		ResourceType: "lambda",
+		Region:       "us-east-1",
+		Identifier:   "my-function",
+		Nukable:      true,
+		ARN:          "arn:aws:lambda:us-east-1:123456789012:function:my-function",
+		Name:         "my-function",
+		CreatedAt:    &created,
+		Tags:         map[string]string{"team": "platform"},
+		Attributes:   map[string]string{"runtime": "go1.x"},
+	})
+	r.OnEvent(reporting.ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-123", Nukable: true})
+	r.OnEvent(reporting.Complete{})
+
+	var output InspectOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+	require.Len(t, output.Resources, 2)
+
+	detailed := output.Resources[0]
+	assert.Equal(t, "arn:aws:lambda:us-east-1:123456789012:function:my-function", detailed.ARN)
+	assert.Equal(t, "my-function", detailed.Name)
+	assert.True(t, created.Equal(*detailed.CreatedAt))
+	assert.Equal(t, map[string]string{"team": "platform"}, detailed.Tags)
+	assert.Equal(t, map[string]string{"runtime": "go1.x"}, detailed.Attributes)
+
+	// Resources listed without details keep the compact output
+	assert.NotContains(t, buf.String(), `"arn": ""`)
+	assert.Nil(t, output.Resources[1].CreatedAt)
+}
+
+func TestJSONRenderer_MultiProjectInspectOutput(t *testing.T) {
+	var buf bytes.Buffer
+	r := NewJSONRenderer(&buf, JSONRendererConfig{Command: "gcp", Projects: []string{"sandbox-1", "sandbox-2"}})
+
+	r.OnEvent(reporting.ResourceFound{Project: "sandbox-1", ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket-1", Nukable: true})
+	r.OnEvent(reporting.ResourceFound{Project: "sandbox-2", ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket-2", Nukable: true})
+	r.OnEvent(reporting.ResourceFound{Project: "sandbox-2", ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket-3", Nukable: true})
+	r.OnEvent(reporting.ScanComplete{})
+	r.OnEvent(reporting.Complete{})
+
+	var output InspectOutput
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &output))
+
+	assert.Equal(t, "sandbox-1", output.Resources[0].Project)
+	assert.Equal(t, map[string]int{"sandbox-1": 1, "sandbox-2": 2}, output.Summary.ByProject)
+	assert.Nil(t, output.Summary.ByAccount)
+}
@@ -14,6 +14,8 @@ type InspectOutput struct {
 	Resources []ResourceInfo `json:"resources"`
 	Errors    []GeneralError `json:"general_errors,omitempty"`
 	Summary   InspectSummary `json:"summary"`
+	// Interrupted is true when the run was stopped by a signal, so the results are partial
+	Interrupted bool `json:"interrupted,omitempty"`
 }
 
 // QueryParams represents the query parameters used for resource inspection.
@@ -23,15 +25,24 @@ type QueryParams struct {
 	ExcludeAfter         *time.Time `json:"exclude_after,omitempty"`
 	IncludeAfter         *time.Time `json:"include_after,omitempty"`
 	ListUnaliasedKMSKeys bool       `json:"list_unaliased_kms_keys"`
+	Accounts             []string   `json:"accounts,omitempty"`
+	Projects             []string   `json:"projects,omitempty"`
 }
 
 // ResourceInfo represents information about a single cloud resource.
 type ResourceInfo struct {
-	ResourceType string `json:"resource_type"`
-	Region       string `json:"region"`
-	Identifier   string `json:"identifier"`
-	Nukable      bool   `json:"nukable"`
-	Reason       string `json:"reason,omitempty"`
+	Account      string            `json:"account,omitempty"` // Only set for multi-account runs
+	Project      string            `json:"project,omitempty"` // Only set for multi-project runs
+	ResourceType string            `json:"resource_type"`
+	Region       string            `json:"region"`
+	Identifier   string            `json:"identifier"`
+	Nukable      bool              `json:"nukable"`
+	Reason       string            `json:"reason,omitempty"`
+	ARN          string            `json:"arn,omitempty"`
+	Name         string            `json:"name,omitempty"`
+	CreatedAt    *time.Time        `json:"created_at,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
 }
 
 // InspectSummary provides summary statistics for inspection results.
@@ -42,6 +53,8 @@ type InspectSummary struct {
 	GeneralErrors  int            `json:"general_errors"`
 	ByType         map[string]int `json:"by_type"`
 	ByRegion       map[string]int `json:"by_region"`
+	ByAccount      map[string]int `json:"by_account,omitempty"`
+	ByProject      map[string]int `json:"by_project,omitempty"`
 }
 
 // NukeOutput represents the JSON output structure for nuke commands.
@@ -49,36 +62,75 @@ type NukeOutput struct {
 	Timestamp time.Time          `json:"timestamp"`
 	Command   string             `json:"command"`
 	Regions   []string           `json:"regions,omitempty"`
+	Accounts  []string           `json:"accounts,omitempty"`
+	Projects  []string           `json:"projects,omitempty"`
 	Found     []ResourceInfo     `json:"found"`
 	Resources []NukeResourceInfo `json:"resources"`
 	Errors    []GeneralError     `json:"general_errors,omitempty"`
+	Passes    []NukePassSummary  `json:"passes,omitempty"`
 	Summary   NukeSummary        `json:"summary"`
+	// CircuitBreakers lists the resource types and regions that were skipped after repeated failures
+	CircuitBreakers []CircuitBreakerInfo `json:"circuit_breakers,omitempty"`
+	// StillPresent lists the deleted resources that were still present when the nuke was verified
+	StillPresent []NukeResourceInfo `json:"still_present,omitempty"`
+	// Interrupted is true when the run was stopped by a signal, so the results are partial
+	Interrupted bool `json:"interrupted,omitempty"`
 }
 
 // NukeResourceInfo represents information about a resource deletion attempt.
 type NukeResourceInfo struct {
+	Account      string `json:"account,omitempty"` // Only set for multi-account runs
+	Project      string `json:"project,omitempty"` // Only set for multi-project runs
 	ResourceType string `json:"resource_type"`
 	Region       string `json:"region"`
 	Identifier   string `json:"identifier"`
-	Status       string `json:"status"` // "deleted", "failed", or "warned"
+	Status       string `json:"status"` // "deleted", "failed", "warned", or "still_present"
 	Error        string `json:"error,omitempty"`
+	Pass         int    `json:"pass,omitempty"` // Only set for multi-pass nukes
+}
+
+// CircuitBreakerInfo represents a circuit breaker that tripped, skipping the remaining resources of a resource
+// type in a region, or of a whole region when ResourceType is empty.
+type CircuitBreakerInfo struct {
+	Account      string `json:"account,omitempty"`
+	Project      string `json:"project,omitempty"`
+	ResourceType string `json:"resource_type,omitempty"`
+	Region       string `json:"region"`
+	ErrorClass   string `json:"error_class"`
+	Skipped      int    `json:"skipped"`
 }
 
 // GeneralError represents a general error in JSON output.
 type GeneralError struct {
+	Account      string `json:"account,omitempty"`
+	Project      string `json:"project,omitempty"`
 	ResourceType string `json:"resource_type"`
 	Description  string `json:"description"`
 	Error        string `json:"error"`
 }
 
+// NukePassSummary provides statistics for a single pass of a multi-pass nuke.
+type NukePassSummary struct {
+	Pass    int `json:"pass"`
+	Total   int `json:"total"`
+	Deleted int `json:"deleted"`
+	Failed  int `json:"failed"`
+	Warned  int `json:"warned"`
+}
+
 // NukeSummary provides summary statistics for nuke operation results.
+// For multi-pass nukes, the counts reflect the outcome of the last attempt on each resource.
+// Deleted resources that were still present when the nuke was verified are counted as StillPresent.
 type NukeSummary struct {
 	Found         int `json:"found"`
 	Total         int `json:"total"`
 	Deleted       int `json:"deleted"`
 	Failed        int `json:"failed"`
 	Warned        int `json:"warned"`
+	StillPresent  int `json:"still_present,omitempty"`
 	GeneralErrors int `json:"general_errors"`
+	// Number of resource types and regions skipped after repeated failures
+	CircuitBreakersTripped int `json:"circuit_breakers_tripped,omitempty"`
 }
 
 // JSONRendererConfig holds configuration for the JSON renderer.
@@ -86,4 +138,8 @@ type JSONRendererConfig struct {
 	Command string
 	Query   *QueryParams
 	Regions []string
+	// Accounts lists the AWS accounts of a multi-account run
+	Accounts []string
+	// Projects lists the GCP projects of a multi-project run
+	Projects []string
 }
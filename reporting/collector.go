@@ -69,3 +69,95 @@ func (c *Collector) Complete() {
 
 	c.closed = true
 }
+
+// Emitter is implemented by anything that accepts events, such as Collector and EventBuffer.
+type Emitter interface {
+	Emit(event Event)
+}
+
+// EventBuffer holds events in memory so they can be emitted later as one contiguous block.
+// Used to keep the event stream ordered by region when regions are scanned concurrently.
+// Thread-safe for concurrent event emission.
+type EventBuffer struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// Emit appends an event to the buffer.
+func (b *EventBuffer) Emit(event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.events = append(b.events, event)
+}
+
+// FlushTo emits all buffered events, in the order they were received, and empties the buffer.
+func (b *EventBuffer) FlushTo(emitter Emitter) {
+	b.mu.Lock()
+	events := b.events
+	b.events = nil
+	b.mu.Unlock()
+
+	for _, event := range events {
+		emitter.Emit(event)
+	}
+}
+
+// AccountEmitter tags the events of one account of a multi-account run with the account ID, and forwards them.
+// NukeStarted and NukeComplete are dropped: the run emits them once for all accounts, so renderers show a
+// single progress bar and a single results table.
+type AccountEmitter struct {
+	Account string
+	Emitter Emitter
+}
+
+// Emit sets the account of the event, and forwards it.
+func (a AccountEmitter) Emit(event Event) {
+	if event, ok := tagEvent(event, a.Account, ""); ok {
+		a.Emitter.Emit(event)
+	}
+}
+
+// ProjectEmitter tags the events of one project of a multi-project run with the project ID, and forwards them.
+// Like AccountEmitter, it drops NukeStarted and NukeComplete, which the run emits once for all projects.
+type ProjectEmitter struct {
+	Project string
+	Emitter Emitter
+}
+
+// Emit sets the project of the event, and forwards it.
+func (p ProjectEmitter) Emit(event Event) {
+	if event, ok := tagEvent(event, "", p.Project); ok {
+		p.Emitter.Emit(event)
+	}
+}
+
+// tagEvent sets the account and project of the events that belong to one, and returns false for the events
+// that are emitted once for a whole multi-account or multi-project run.
+func tagEvent(event Event, account string, project string) (Event, bool) {
+	switch e := event.(type) {
+	case ScanProgress:
+		e.Account, e.Project = account, project
+		return e, true
+	case ResourceFound:
+		e.Account, e.Project = account, project
+		return e, true
+	case ResourceDeleted:
+		e.Account, e.Project = account, project
+		return e, true
+	case ResourceStillPresent:
+		e.Account, e.Project = account, project
+		return e, true
+	case CircuitBreakerTripped:
+		e.Account, e.Project = account, project
+		return e, true
+	case GeneralError:
+		e.Account, e.Project = account, project
+		return e, true
+	case NukeProgress:
+		e.Account, e.Project = account, project
+		return e, true
+	case NukeStarted, NukeComplete:
+		return nil, false
+	}
+	return event, true
+}
@@ -105,3 +105,58 @@ func TestCollector_ConcurrentAccess(t *testing.T) {
 
 	assert.Len(t, r.events, 100)
 }
+
+func TestEventBuffer_FlushTo(t *testing.T) {
+	c := NewCollector()
+	r := &mockRenderer{}
+	c.AddRenderer(r)
+
+	buffer := &EventBuffer{}
+	buffer.Emit(ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"})
+	buffer.Emit(GeneralError{ResourceType: "s3", Description: "Unable to list"})
+	assert.Empty(t, r.events, "buffered events must not reach renderers before flush")
+
+	buffer.FlushTo(c)
+	assert.Len(t, r.events, 2)
+	assert.IsType(t, ResourceFound{}, r.events[0])
+	assert.IsType(t, GeneralError{}, r.events[1])
+
+	// Flushing again is a no-op since the buffer was emptied
+	buffer.FlushTo(c)
+	assert.Len(t, r.events, 2)
+}
+
+func TestAccountEmitter(t *testing.T) {
+	c := NewCollector()
+	r := &mockRenderer{}
+	c.AddRenderer(r)
+
+	emitter := AccountEmitter{Account: "111111111111", Emitter: c}
+	emitter.Emit(NukeStarted{Total: 1})
+	emitter.Emit(ResourceFound{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"})
+	emitter.Emit(ResourceDeleted{ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Success: true})
+	emitter.Emit(GeneralError{ResourceType: "s3", Description: "Unable to list"})
+	emitter.Emit(NukeComplete{})
+
+	assert.Equal(t, []Event{
+		ResourceFound{Account: "111111111111", ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1"},
+		ResourceDeleted{Account: "111111111111", ResourceType: "ec2", Region: "us-east-1", Identifier: "i-1", Success: true},
+		GeneralError{Account: "111111111111", ResourceType: "s3", Description: "Unable to list"},
+	}, r.events)
+}
+
+func TestProjectEmitter(t *testing.T) {
+	c := NewCollector()
+	r := &mockRenderer{}
+	c.AddRenderer(r)
+
+	emitter := ProjectEmitter{Project: "sandbox-1", Emitter: c}
+	emitter.Emit(NukeStarted{Total: 1})
+	emitter.Emit(ResourceDeleted{ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket", Success: true})
+	emitter.Emit(ScanComplete{})
+
+	assert.Equal(t, []Event{
+		ResourceDeleted{Project: "sandbox-1", ResourceType: "gcs-bucket", Region: "global", Identifier: "bucket", Success: true},
+		ScanComplete{},
+	}, r.events)
+}
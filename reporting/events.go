@@ -1,5 +1,7 @@
 package reporting
 
+import "time"
+
 // Event is the interface that all reporting events implement.
 type Event interface {
 	EventType() string
@@ -8,13 +10,15 @@ type Event interface {
 // ScanProgress is emitted during resource discovery to show scanning status.
 // Used by CLI renderer to update spinner text.
 type ScanProgress struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
 	ResourceType string
 	Region       string
 }
 
 func (ScanProgress) EventType() string { return "scan_progress" }
 
-// ScanStarted is emitted at the beginning of AWS resource scanning.
+// ScanStarted is emitted at the beginning of AWS resource scanning, and of GCP multi-project scanning.
 // Used by CLI renderer to display query parameters.
 // Note: GCP does not emit this event as it has no interesting query parameters to display.
 type ScanStarted struct {
@@ -23,6 +27,8 @@ type ScanStarted struct {
 	ExcludeAfter         string // formatted time string, empty if not set
 	IncludeAfter         string // formatted time string, empty if not set
 	ListUnaliasedKMSKeys bool
+	Accounts             []string // AWS account IDs of a multi-account run
+	Projects             []string // GCP project IDs of a multi-project run
 }
 
 func (ScanStarted) EventType() string { return "scan_started" }
@@ -36,11 +42,20 @@ func (ScanComplete) EventType() string { return "scan_complete" }
 // ResourceFound is emitted when a resource is discovered during scanning.
 // Used to build the "found resources" table for inspect or pre-nuke display.
 type ResourceFound struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
 	ResourceType string
 	Region       string
 	Identifier   string
 	Nukable      bool
 	Reason       string // Why not nukable (e.g., "protected by config")
+
+	// Optional details, set when the resource's lister provides them
+	ARN        string
+	Name       string
+	CreatedAt  *time.Time
+	Tags       map[string]string
+	Attributes map[string]string
 }
 
 func (ResourceFound) EventType() string { return "resource_found" }
@@ -48,19 +63,50 @@ func (ResourceFound) EventType() string { return "resource_found" }
 // ResourceDeleted is emitted after a deletion attempt.
 // Used to build the final nuke results table.
 type ResourceDeleted struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
 	ResourceType string
 	Region       string
 	Identifier   string
 	Success      bool
 	Warning      bool   // True if failure is transient/expected (e.g., DependencyViolation)
 	Error        string // Empty if success
+	Pass         int    // Nuke pass of the attempt; 0 when the nuke is not multi-pass
 }
 
 func (ResourceDeleted) EventType() string { return "resource_deleted" }
 
+// ResourceStillPresent is emitted by the verification after a nuke, for a resource that was deleted
+// successfully but is still listed (e.g., an asynchronous deletion that is still running or failed later).
+type ResourceStillPresent struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
+	ResourceType string
+	Region       string
+	Identifier   string
+}
+
+func (ResourceStillPresent) EventType() string { return "resource_still_present" }
+
+// CircuitBreakerTripped is emitted when nuking a resource type in a region, or a whole region, is stopped
+// after repeated failures of the same class (e.g., missing permissions). It follows the GeneralError that
+// describes the trip, and is used by renderers to report trips in the summary.
+type CircuitBreakerTripped struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
+	ResourceType string // Empty when the rest of the region is skipped
+	Region       string
+	ErrorClass   string // e.g., the AWS error code of the failures
+	Skipped      int    // Number of identifiers that were not attempted
+}
+
+func (CircuitBreakerTripped) EventType() string { return "circuit_breaker_tripped" }
+
 // GeneralError is emitted for non-resource-specific errors during execution.
 // Examples: failed to list resources in a region, API errors, etc.
 type GeneralError struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
 	ResourceType string
 	Description  string
 	Error        string
@@ -76,9 +122,20 @@ type NukeStarted struct {
 
 func (NukeStarted) EventType() string { return "nuke_started" }
 
+// NukePassStarted is emitted at the start of each retry pass of a multi-pass nuke (the first pass
+// is announced by NukeStarted). Used by renderers to report each pass as a distinct phase.
+type NukePassStarted struct {
+	Pass  int // 1-based pass number
+	Total int // Total resources to retry in this pass
+}
+
+func (NukePassStarted) EventType() string { return "nuke_pass_started" }
+
 // NukeProgress is emitted when processing a batch of resources.
 // Used by CLI renderer to update progress bar title.
 type NukeProgress struct {
+	Account      string // AWS account ID in multi-account runs, empty otherwise
+	Project      string // GCP project ID in multi-project runs, empty otherwise
 	ResourceType string
 	Region       string
 	BatchSize    int
@@ -92,6 +149,14 @@ type NukeComplete struct{}
 
 func (NukeComplete) EventType() string { return "nuke_complete" }
 
+// Interrupted is emitted when the run is interrupted by a signal. Renderers mark their output as partial.
+// It can arrive at any point before Complete, including after ScanComplete or NukeComplete.
+type Interrupted struct {
+	Reason string
+}
+
+func (Interrupted) EventType() string { return "interrupted" }
+
 // Complete is emitted by collector.Complete(), signaling the end of the operation.
 // JSON renderer outputs on this event (using nukeMode to decide format).
 type Complete struct{}
@@ -221,7 +221,7 @@ func TestSimpleBatchDeleter_Concurrency(t *testing.T) {
 
 func TestNukerFuncs_Empty(t *testing.T) {
 	nukers := map[string]NukerFunc[*mockClient]{
-		"SimpleBatch":              SimpleBatchDeleter(noopDelete),
+		"SimpleBatch":             SimpleBatchDeleter(noopDelete),
 		"Sequential":              SequentialDeleter(noopDelete),
 		"MultiStep":               MultiStepDeleter(noopDelete),
 		"Bulk":                    BulkDeleter(func(_ context.Context, _ *mockClient, _ []string) error { return nil }),
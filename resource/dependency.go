@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DependencyCycleError is returned when resource dependencies form a cycle,
+// which makes it impossible to compute a deletion order.
+type DependencyCycleError struct {
+	ResourceTypes []string
+}
+
+func (err DependencyCycleError) Error() string {
+	return fmt.Sprintf("dependency cycle detected between resource types: %s", strings.Join(err.ResourceTypes, ", "))
+}
+
+// UnknownDependencyError is returned when a resource declares a dependency on a
+// resource type that is not registered alongside it.
+type UnknownDependencyError struct {
+	ResourceType string
+	Dependency   string
+}
+
+func (err UnknownDependencyError) Error() string {
+	return fmt.Sprintf("resource type %s depends on unknown resource type %s", err.ResourceType, err.Dependency)
+}
+
+// SortByDependencies returns items ordered so that every item comes after the items it depends on.
+// The sort is stable: among items whose dependencies are satisfied, the one that appears first in
+// the input is emitted first, so the input order acts as a tie-breaker.
+//
+// Dependencies on names that are not present in items are ignored, which allows sorting a subset
+// of resources (e.g., when only some resource types are selected). Sorting a subset by its direct
+// dependencies loses the order imposed through the items left out of it, so pass the dependencies
+// computed by TransitiveDependencies over the full registry instead. Use ValidateDependencies to
+// check a full registry for dangling references.
+func SortByDependencies[T any](items []T, name func(T) string, dependsOn func(T) []string) ([]T, error) {
+	present := make(map[string]bool, len(items))
+	for _, item := range items {
+		present[name(item)] = true
+	}
+
+	sorted := make([]T, 0, len(items))
+	done := make(map[string]bool, len(items))
+	remaining := append([]T(nil), items...)
+
+	for len(remaining) > 0 {
+		progressed := false
+		for i, item := range remaining {
+			if !dependenciesSatisfied(dependsOn(item), present, done) {
+				continue
+			}
+			sorted = append(sorted, item)
+			done[name(item)] = true
+			remaining = append(remaining[:i], remaining[i+1:]...)
+			progressed = true
+			break
+		}
+
+		if !progressed {
+			var names []string
+			for _, item := range remaining {
+				names = append(names, name(item))
+			}
+			return nil, DependencyCycleError{ResourceTypes: names}
+		}
+	}
+
+	return sorted, nil
+}
+
+// TransitiveDependencies returns the names of the items each item depends on, directly or through other items,
+// keyed by the name of the item. Sorting a subset of the items by their transitive dependencies keeps the order the
+// full set of items imposes on them: e.g., when "vpc" depends on "ec2-subnet", which depends on "security-group",
+// "vpc" still comes after "security-group" when "ec2-subnet" is not part of the subset.
+//
+// Dependencies on names that are not present in items are kept as is. Cycles don't prevent the computation, and
+// are reported when sorting.
+func TransitiveDependencies[T any](items []T, name func(T) string, dependsOn func(T) []string) map[string][]string {
+	direct := make(map[string][]string, len(items))
+	for _, item := range items {
+		direct[name(item)] = dependsOn(item)
+	}
+
+	transitive := make(map[string][]string, len(items))
+	var visit func(n string, seen map[string]bool, deps *[]string)
+	visit = func(n string, seen map[string]bool, deps *[]string) {
+		for _, dep := range direct[n] {
+			if seen[dep] {
+				continue
+			}
+			seen[dep] = true
+			*deps = append(*deps, dep)
+			visit(dep, seen, deps)
+		}
+	}
+	for _, item := range items {
+		n := name(item)
+		var deps []string
+		visit(n, map[string]bool{}, &deps)
+		transitive[n] = deps
+	}
+	return transitive
+}
+
+// ValidateDependencies checks that every declared dependency refers to one of the given items and
+// that the dependency graph has no cycles.
+func ValidateDependencies[T any](items []T, name func(T) string, dependsOn func(T) []string) error {
+	present := make(map[string]bool, len(items))
+	for _, item := range items {
+		present[name(item)] = true
+	}
+
+	for _, item := range items {
+		for _, dep := range dependsOn(item) {
+			if !present[dep] {
+				return UnknownDependencyError{ResourceType: name(item), Dependency: dep}
+			}
+		}
+	}
+
+	_, err := SortByDependencies(items, name, dependsOn)
+	return err
+}
+
+func dependenciesSatisfied(deps []string, present map[string]bool, done map[string]bool) bool {
+	for _, dep := range deps {
+		if present[dep] && !done[dep] {
+			return false
+		}
+	}
+	return true
+}
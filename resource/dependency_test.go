@@ -0,0 +1,117 @@
+package resource
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testNode struct {
+	name string
+	deps []string
+}
+
+func nodeName(n testNode) string   { return n.name }
+func nodeDeps(n testNode) []string { return n.deps }
+func nodeNames(nodes []testNode) []string {
+	names := make([]string, len(nodes))
+	for i, n := range nodes {
+		names[i] = n.name
+	}
+	return names
+}
+
+func TestSortByDependencies(t *testing.T) {
+	t.Run("keeps input order when there are no dependencies", func(t *testing.T) {
+		nodes := []testNode{{name: "a"}, {name: "b"}, {name: "c"}}
+		sorted, err := SortByDependencies(nodes, nodeName, nodeDeps)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, nodeNames(sorted))
+	})
+
+	t.Run("moves dependents after their dependencies", func(t *testing.T) {
+		nodes := []testNode{
+			{name: "vpc", deps: []string{"ec2-subnet", "internet-gateway"}},
+			{name: "ec2-subnet", deps: []string{"network-interface"}},
+			{name: "internet-gateway"},
+			{name: "network-interface"},
+		}
+		sorted, err := SortByDependencies(nodes, nodeName, nodeDeps)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"internet-gateway", "network-interface", "ec2-subnet", "vpc"}, nodeNames(sorted))
+	})
+
+	t.Run("ignores dependencies that are not present", func(t *testing.T) {
+		nodes := []testNode{{name: "vpc", deps: []string{"ec2-subnet"}}, {name: "ec2"}}
+		sorted, err := SortByDependencies(nodes, nodeName, nodeDeps)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vpc", "ec2"}, nodeNames(sorted))
+	})
+
+	t.Run("detects cycles", func(t *testing.T) {
+		nodes := []testNode{
+			{name: "a", deps: []string{"b"}},
+			{name: "b", deps: []string{"a"}},
+			{name: "c"},
+		}
+		_, err := SortByDependencies(nodes, nodeName, nodeDeps)
+		var cycleErr DependencyCycleError
+		require.ErrorAs(t, err, &cycleErr)
+		assert.ElementsMatch(t, []string{"a", "b"}, cycleErr.ResourceTypes)
+	})
+}
+
+func TestTransitiveDependencies(t *testing.T) {
+	nodes := []testNode{
+		{name: "vpc", deps: []string{"ec2-subnet", "internet-gateway"}},
+		{name: "ec2-subnet", deps: []string{"security-group"}},
+		{name: "security-group", deps: []string{"ec2", "ec2"}},
+		{name: "internet-gateway"},
+		{name: "ec2"},
+	}
+	transitive := TransitiveDependencies(nodes, nodeName, nodeDeps)
+	assert.Equal(t, []string{"ec2-subnet", "security-group", "ec2", "internet-gateway"}, transitive["vpc"])
+	assert.Equal(t, []string{"ec2"}, transitive["security-group"])
+	assert.Empty(t, transitive["ec2"])
+
+	t.Run("keeps the order imposed through items left out", func(t *testing.T) {
+		selected := []testNode{{name: "vpc"}, {name: "ec2"}}
+		dependencies := func(n testNode) []string { return transitive[n.name] }
+
+		sorted, err := SortByDependencies(selected, nodeName, dependencies)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ec2", "vpc"}, nodeNames(sorted))
+
+		// Direct dependencies alone don't order them
+		sorted, err = SortByDependencies(selected, nodeName, func(n testNode) []string {
+			return nodeDeps(nodes[slices.IndexFunc(nodes, func(m testNode) bool { return m.name == n.name })])
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vpc", "ec2"}, nodeNames(sorted))
+	})
+
+	t.Run("tolerates cycles, which sorting reports", func(t *testing.T) {
+		cycle := []testNode{{name: "a", deps: []string{"b"}}, {name: "b", deps: []string{"a"}}}
+		transitive := TransitiveDependencies(cycle, nodeName, nodeDeps)
+		assert.Equal(t, []string{"b", "a"}, transitive["a"])
+
+		_, err := SortByDependencies(cycle, nodeName, func(n testNode) []string { return transitive[n.name] })
+		require.ErrorAs(t, err, &DependencyCycleError{})
+	})
+}
+
+func TestValidateDependencies(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		nodes := []testNode{{name: "a", deps: []string{"b"}}, {name: "b"}}
+		assert.NoError(t, ValidateDependencies(nodes, nodeName, nodeDeps))
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		nodes := []testNode{{name: "a", deps: []string{"missing"}}}
+		var unknownErr UnknownDependencyError
+		require.ErrorAs(t, ValidateDependencies(nodes, nodeName, nodeDeps), &unknownErr)
+		assert.Equal(t, "missing", unknownErr.Dependency)
+	})
+}
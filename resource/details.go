@@ -0,0 +1,59 @@
+package resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+// ResourceDetails describes a listed resource. Only ID is required. The other fields are optional, and are
+// carried through to ResourceFound events and the inspection output when a DetailedLister provides them.
+type ResourceDetails struct {
+	ID        string
+	ARN       string
+	Name      string
+	CreatedAt *time.Time
+	Tags      map[string]string
+	// Attributes holds extra resource-specific attributes, such as size or state
+	Attributes map[string]string
+}
+
+// NewResourceDetails builds the details of a resource from the value its lister filters on. The attributes of the
+// value are formatted as strings.
+func NewResourceDetails(id *string, value config.ResourceValue) ResourceDetails {
+	details := ResourceDetails{
+		CreatedAt: value.Time,
+		Tags:      value.Tags,
+	}
+	if id != nil {
+		details.ID = *id
+	}
+	if value.Name != nil {
+		details.Name = *value.Name
+	}
+	if len(value.Attributes) > 0 {
+		details.Attributes = make(map[string]string, len(value.Attributes))
+		for key, attribute := range value.Attributes {
+			details.Attributes[key] = fmt.Sprint(attribute)
+		}
+	}
+	return details
+}
+
+// WithARN returns a copy of the details with ARN set to arn, when it is known.
+func (d ResourceDetails) WithARN(arn *string) ResourceDetails {
+	if arn != nil {
+		d.ARN = *arn
+	}
+	return d
+}
+
+// IDs returns the identifiers of the given resources.
+func IDs(details []ResourceDetails) []string {
+	ids := make([]string, 0, len(details))
+	for _, d := range details {
+		ids = append(ids, d.ID)
+	}
+	return ids
+}
@@ -0,0 +1,74 @@
+package resource
+
+import "fmt"
+
+// Target is an identifier to nuke.
+type Target struct {
+	Region       string
+	ResourceType string
+	Identifier   string
+}
+
+// DeleteLimits caps the number of identifiers a run may nuke, so that a misconfigured filter can't delete far
+// more than intended.
+type DeleteLimits struct {
+	// MaxDelete caps the total number of identifiers. Zero means no limit.
+	MaxDelete int
+	// MaxDeleteByType caps the number of identifiers of each resource type, across all regions.
+	// Resource types without an entry are not limited.
+	MaxDeleteByType map[string]int
+}
+
+// DeleteLimitExceededError is returned when the identifiers to nuke exceed a delete limit.
+type DeleteLimitExceededError struct {
+	// ResourceType is the resource type whose limit is exceeded, or empty when the total limit is exceeded.
+	ResourceType string
+	Count        int
+	Limit        int
+}
+
+func (err DeleteLimitExceededError) Error() string {
+	if err.ResourceType == "" {
+		return fmt.Sprintf("Refusing to nuke %d resources, which exceeds the limit of %d resources", err.Count, err.Limit)
+	}
+	return fmt.Sprintf("Refusing to nuke %d %s, which exceeds the max_delete limit of %d", err.Count, err.ResourceType, err.Limit)
+}
+
+// Check returns a DeleteLimitExceededError if the targets exceed a limit. Per resource type limits are checked
+// first, in the order the resource types appear in targets, then the total limit.
+func (l DeleteLimits) Check(targets []Target) error {
+	counts := make(map[string]int)
+	var resourceTypes []string
+	for _, target := range targets {
+		if _, seen := counts[target.ResourceType]; !seen {
+			resourceTypes = append(resourceTypes, target.ResourceType)
+		}
+		counts[target.ResourceType]++
+	}
+
+	for _, resourceType := range resourceTypes {
+		if limit, ok := l.MaxDeleteByType[resourceType]; ok && counts[resourceType] > limit {
+			return DeleteLimitExceededError{ResourceType: resourceType, Count: counts[resourceType], Limit: limit}
+		}
+	}
+	if l.MaxDelete > 0 && len(targets) > l.MaxDelete {
+		return DeleteLimitExceededError{Count: len(targets), Limit: l.MaxDelete}
+	}
+	return nil
+}
+
+// Trim returns the targets that fit within the limits, keeping the first ones in order, along with the targets
+// that were trimmed.
+func (l DeleteLimits) Trim(targets []Target) (kept []Target, trimmed []Target) {
+	counts := make(map[string]int)
+	for _, target := range targets {
+		limit, limited := l.MaxDeleteByType[target.ResourceType]
+		if (limited && counts[target.ResourceType] >= limit) || (l.MaxDelete > 0 && len(kept) >= l.MaxDelete) {
+			trimmed = append(trimmed, target)
+			continue
+		}
+		counts[target.ResourceType]++
+		kept = append(kept, target)
+	}
+	return kept, trimmed
+}
@@ -0,0 +1,68 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testTargets() []Target {
+	return []Target{
+		{Region: "us-east-1", ResourceType: "ec2", Identifier: "i-1"},
+		{Region: "us-east-1", ResourceType: "s3", Identifier: "bucket-1"},
+		{Region: "us-west-2", ResourceType: "ec2", Identifier: "i-2"},
+		{Region: "us-west-2", ResourceType: "ec2", Identifier: "i-3"},
+	}
+}
+
+func TestDeleteLimitsCheck(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		assert.NoError(t, DeleteLimits{}.Check(testTargets()))
+	})
+
+	t.Run("within limits", func(t *testing.T) {
+		limits := DeleteLimits{MaxDelete: 4, MaxDeleteByType: map[string]int{"ec2": 3}}
+		assert.NoError(t, limits.Check(testTargets()))
+	})
+
+	t.Run("total limit exceeded", func(t *testing.T) {
+		var limitErr DeleteLimitExceededError
+		require.ErrorAs(t, DeleteLimits{MaxDelete: 3}.Check(testTargets()), &limitErr)
+		assert.Equal(t, DeleteLimitExceededError{Count: 4, Limit: 3}, limitErr)
+	})
+
+	t.Run("resource type limit exceeded", func(t *testing.T) {
+		var limitErr DeleteLimitExceededError
+		limits := DeleteLimits{MaxDelete: 3, MaxDeleteByType: map[string]int{"ec2": 2}}
+		require.ErrorAs(t, limits.Check(testTargets()), &limitErr)
+		assert.Equal(t, DeleteLimitExceededError{ResourceType: "ec2", Count: 3, Limit: 2}, limitErr)
+	})
+
+	t.Run("zero resource type limit", func(t *testing.T) {
+		limits := DeleteLimits{MaxDeleteByType: map[string]int{"s3": 0}}
+		assert.Error(t, limits.Check(testTargets()))
+	})
+}
+
+func TestDeleteLimitsTrim(t *testing.T) {
+	t.Run("no limits", func(t *testing.T) {
+		kept, trimmed := DeleteLimits{}.Trim(testTargets())
+		assert.Equal(t, testTargets(), kept)
+		assert.Empty(t, trimmed)
+	})
+
+	t.Run("keeps the first targets", func(t *testing.T) {
+		targets := testTargets()
+		kept, trimmed := DeleteLimits{MaxDelete: 3, MaxDeleteByType: map[string]int{"ec2": 1}}.Trim(targets)
+		assert.Equal(t, targets[:2], kept)
+		assert.Equal(t, targets[2:], trimmed)
+	})
+
+	t.Run("total limit", func(t *testing.T) {
+		targets := testTargets()
+		kept, trimmed := DeleteLimits{MaxDelete: 3}.Trim(targets)
+		assert.Equal(t, targets[:3], kept)
+		assert.Equal(t, targets[3:], trimmed)
+	})
+}
@@ -0,0 +1,127 @@
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// Registration places a resource type registered by code importing cloud-nuke among the other resource types.
+type Registration struct {
+	// Global registers the resource type in the global pseudo-region, rather than in every region.
+	Global bool
+	// After lists the resource types to nuke before this one.
+	After []string
+	// Before lists the resource types to nuke after this one.
+	Before []string
+}
+
+// ResourceTypeAlreadyRegisteredError is returned when registering a resource type whose name is already taken.
+type ResourceTypeAlreadyRegisteredError struct {
+	ResourceType string
+}
+
+func (err ResourceTypeAlreadyRegisteredError) Error() string {
+	return fmt.Sprintf("resource type %s is already registered", err.ResourceType)
+}
+
+// InvalidRegistrationError is returned when registering a resource type that can't be registered as is.
+type InvalidRegistrationError struct {
+	Reason string
+}
+
+func (err InvalidRegistrationError) Error() string {
+	return fmt.Sprintf("invalid resource registration: %s", err.Reason)
+}
+
+// Registry holds the resource types registered by code importing cloud-nuke, on top of the built-in ones.
+// T is the provider-specific resource interface (e.g., AwsResource). Thread-safe for concurrent use.
+type Registry[T NukeableResource] struct {
+	mu      sync.Mutex
+	entries []registryEntry[T]
+}
+
+type registryEntry[T NukeableResource] struct {
+	name         string
+	factory      func() T
+	registration Registration
+}
+
+// Register adds a resource type. factory is called every time the resource types of a region are created, and
+// must return a new, uninitialized instance. builtIn lists the built-in resource types, whose names can't be
+// registered again.
+func (r *Registry[T]) Register(factory func() T, registration Registration, builtIn []T) error {
+	if factory == nil {
+		return InvalidRegistrationError{Reason: "factory is nil"}
+	}
+	name := factory().ResourceName()
+	if name == "" {
+		return InvalidRegistrationError{Reason: "resource type name is empty"}
+	}
+	if slices.Contains(registration.After, name) || slices.Contains(registration.Before, name) {
+		return InvalidRegistrationError{Reason: fmt.Sprintf("resource type %s is ordered relative to itself", name)}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	isBuiltIn := slices.ContainsFunc(builtIn, func(b T) bool { return b.ResourceName() == name })
+	if isBuiltIn || slices.ContainsFunc(r.entries, func(e registryEntry[T]) bool { return e.name == name }) {
+		return ResourceTypeAlreadyRegisteredError{ResourceType: name}
+	}
+	r.entries = append(r.entries, registryEntry[T]{name: name, factory: factory, registration: registration})
+	return nil
+}
+
+// Resources returns new instances of the registered resource types of the global pseudo-region, or of the
+// regular regions, in registration order.
+func (r *Registry[T]) Resources(global bool) []T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var res []T
+	for _, entry := range r.entries {
+		if entry.registration.Global == global {
+			res = append(res, entry.factory())
+		}
+	}
+	return res
+}
+
+// Dependencies returns the resource types each resource type must be nuked after, as required by the After and
+// Before of the registered resource types of the global pseudo-region, or of the regular regions.
+func (r *Registry[T]) Dependencies(global bool) map[string][]string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	dependencies := make(map[string][]string)
+	for _, entry := range r.entries {
+		if entry.registration.Global != global {
+			continue
+		}
+		dependencies[entry.name] = append(dependencies[entry.name], entry.registration.After...)
+		for _, before := range entry.registration.Before {
+			dependencies[before] = append(dependencies[before], entry.name)
+		}
+	}
+	return dependencies
+}
+
+// Validate checks that the Before of the registered resource types of the global pseudo-region, or of the regular
+// regions, only refers to the given resource types of the same scope.
+func (r *Registry[T]) Validate(global bool, resourceTypes []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range r.entries {
+		if entry.registration.Global != global {
+			continue
+		}
+		for _, before := range entry.registration.Before {
+			if !slices.Contains(resourceTypes, before) {
+				return UnknownDependencyError{ResourceType: entry.name, Dependency: before}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRegisteredResource(name string) func() NukeableResource {
+	return func() NukeableResource {
+		return &Resource[struct{}]{ResourceTypeName: name}
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := &Registry[NukeableResource]{}
+	builtIn := []NukeableResource{newRegisteredResource("ec2")(), newRegisteredResource("vpc")()}
+
+	require.NoError(t, registry.Register(newRegisteredResource("custom"), Registration{After: []string{"ec2"}}, builtIn))
+
+	var alreadyRegistered ResourceTypeAlreadyRegisteredError
+	require.ErrorAs(t, registry.Register(newRegisteredResource("custom"), Registration{}, builtIn), &alreadyRegistered)
+	require.ErrorAs(t, registry.Register(newRegisteredResource("vpc"), Registration{}, builtIn), &alreadyRegistered)
+	assert.Equal(t, "vpc", alreadyRegistered.ResourceType)
+
+	var invalid InvalidRegistrationError
+	require.ErrorAs(t, registry.Register(nil, Registration{}, builtIn), &invalid)
+	require.ErrorAs(t, registry.Register(newRegisteredResource(""), Registration{}, builtIn), &invalid)
+	require.ErrorAs(t, registry.Register(newRegisteredResource("loop"), Registration{Before: []string{"loop"}}, builtIn), &invalid)
+}
+
+func TestRegistryResourcesAndDependencies(t *testing.T) {
+	registry := &Registry[NukeableResource]{}
+	require.NoError(t, registry.Register(newRegisteredResource("regional"), Registration{After: []string{"ec2"}, Before: []string{"vpc"}}, nil))
+	require.NoError(t, registry.Register(newRegisteredResource("global"), Registration{Global: true}, nil))
+
+	regional := registry.Resources(false)
+	require.Len(t, regional, 1)
+	assert.Equal(t, "regional", regional[0].ResourceName())
+	assert.NotSame(t, regional[0], registry.Resources(false)[0], "every call returns new instances")
+
+	assert.Equal(t, map[string][]string{"regional": {"ec2"}, "vpc": {"regional"}}, registry.Dependencies(false))
+	assert.Equal(t, map[string][]string{"global": nil}, registry.Dependencies(true))
+}
+
+func TestRegistryValidate(t *testing.T) {
+	registry := &Registry[NukeableResource]{}
+	require.NoError(t, registry.Register(newRegisteredResource("custom"), Registration{Before: []string{"vpc"}}, nil))
+
+	assert.NoError(t, registry.Validate(false, []string{"vpc", "custom"}))
+	assert.NoError(t, registry.Validate(true, nil), "global resource types are validated on their own")
+
+	var unknown UnknownDependencyError
+	require.ErrorAs(t, registry.Validate(false, []string{"custom"}), &unknown)
+	assert.Equal(t, UnknownDependencyError{ResourceType: "custom", Dependency: "vpc"}, unknown)
+}
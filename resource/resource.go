@@ -3,6 +3,10 @@ package resource
 import (
 	"context"
 	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
 
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/logging"
@@ -43,7 +47,13 @@ type NukeableResource interface {
 	Nuke(ctx context.Context, identifiers []string) ([]NukeResult, error)
 	GetAndSetIdentifiers(ctx context.Context, configObj config.Config) ([]string, error)
 	IsNukable(string) (bool, error)
+	Details(string) ResourceDetails
 	GetAndSetResourceConfig(config.Config) config.ResourceType
+	ResourceConfigKey() string
+	Dependencies() []string
+	ResourceCategories() []string
+	SupportsTags() bool
+	ServiceName() string
 }
 
 // Resource is the universal struct for all nukeable resources.
@@ -71,19 +81,40 @@ type Resource[C any] struct {
 	// Set r.Client and r.Scope directly in this function.
 	InitClient func(r *Resource[C], cfg any)
 
-	// ConfigGetter retrieves the resource-specific config section
-	ConfigGetter func(c config.Config) config.ResourceType
+	// ConfigKey is the YAML key of the field of config.Config the resource-specific config is read from
+	// (e.g., "EC2KeyPairs"). When empty, the config is read from the Custom section, by ResourceTypeName.
+	ConfigKey string
+
+	// OnConfig, when set, is called with the whole config whenever the resource-specific config is read, for
+	// resource types that have settings beyond config.ResourceType (e.g., DefaultOnly).
+	OnConfig func(c config.Config)
 
 	// Lister retrieves all resource identifiers to nuke.
-	// Receives the resource-specific config (extracted via ConfigGetter).
+	// Receives the resource-specific config (read from the field of config.Config keyed by ConfigKey).
 	Lister func(ctx context.Context, client C, scope Scope, resourceCfg config.ResourceType) ([]*string, error)
 
+	// DetailedLister retrieves all resources to nuke along with their details (name, creation time, tags...).
+	// Takes precedence over Lister, which only returns identifiers. Set one of them.
+	DetailedLister func(ctx context.Context, client C, scope Scope, resourceCfg config.ResourceType) ([]ResourceDetails, error)
+
 	// Nuker deletes the resources. Use SimpleBatchDeleter, SequentialDeleter, or MultiStepDeleter.
 	Nuker NukerFunc[C]
 
 	// PermissionVerifier performs optional dry-run permission checks (nil = skip verification)
 	PermissionVerifier func(ctx context.Context, client C, id *string) error
 
+	// DependsOn lists the resource type names that must be nuked before this one
+	// (e.g., "vpc" depends on "ec2-subnet"). Used to compute the deletion order.
+	DependsOn []string
+
+	// Categories lists the categories of the resource type (e.g., CategoryNetwork), used to select resource types
+	// together.
+	Categories []string
+
+	// NoTags marks resource types whose resources are listed without their tags, so that the tag filters of their
+	// config can't match them.
+	NoTags bool
+
 	// === Runtime state (set during execution) ===
 
 	// Client is the typed cloud service client
@@ -100,6 +131,13 @@ type Resource[C any] struct {
 
 	// nukables tracks which resources can be nuked (nil value = nukable)
 	nukables map[string]error
+
+	// details holds the details of the discovered resources, when listed by DetailedLister
+	details map[string]ResourceDetails
+
+	// timeout bounds each list call and each nuke batch (0 = no timeout).
+	// Resolved from the resource-specific config in GetAndSetIdentifiers.
+	timeout time.Duration
 }
 
 // Init initializes the resource with cloud-specific configuration.
@@ -131,23 +169,69 @@ func (r *Resource[C]) MaxBatchSize() int {
 	return DefaultBatchSize
 }
 
+// Dependencies returns the resource types that must be nuked before this one (implements AwsResource/GcpResource interface)
+func (r *Resource[C]) Dependencies() []string {
+	return r.DependsOn
+}
+
+// ResourceCategories returns the categories of the resource type (implements AwsResource/GcpResource interface)
+func (r *Resource[C]) ResourceCategories() []string {
+	return r.Categories
+}
+
+// ResourceConfigKey returns the YAML key of the field of config.Config configuring the resource type (implements
+// AwsResource/GcpResource interface)
+func (r *Resource[C]) ResourceConfigKey() string {
+	return r.ConfigKey
+}
+
+// SupportsTags returns whether resources are listed with their tags (implements AwsResource/GcpResource interface)
+func (r *Resource[C]) SupportsTags() bool {
+	return !r.NoTags
+}
+
+// ServiceName returns the cloud service behind the resource's client, derived from the client's package
+// (e.g., "ec2" for *ec2.Client). Falls back to the resource type name for clients that are not SDK service
+// clients. Used to share rate limits between resource types of the same service.
+func (r *Resource[C]) ServiceName() string {
+	clientType := reflect.TypeOf(r.Client)
+	for clientType != nil && clientType.Kind() == reflect.Pointer {
+		clientType = clientType.Elem()
+	}
+	if clientType != nil && strings.Contains(clientType.PkgPath(), "/service/") {
+		return path.Base(clientType.PkgPath())
+	}
+	return r.ResourceTypeName
+}
+
 // GetAndSetResourceConfig retrieves the resource-specific configuration (implements AwsResource/GcpResource interface)
 func (r *Resource[C]) GetAndSetResourceConfig(configObj config.Config) config.ResourceType {
-	if r.ConfigGetter == nil {
-		return config.ResourceType{}
+	resourceCfg, _ := r.resourceConfig(configObj)
+	return resourceCfg
+}
+
+// resourceConfig reads the resource-specific config from the field of config.Config keyed by ConfigKey, or from the
+// Custom section when ConfigKey is empty. Returns false if config.Config has no field keyed by ConfigKey.
+func (r *Resource[C]) resourceConfig(configObj config.Config) (config.ResourceType, bool) {
+	if r.OnConfig != nil {
+		r.OnConfig(configObj)
 	}
-	return r.ConfigGetter(configObj)
+	if r.ConfigKey == "" {
+		return configObj.CustomResourceType(r.ResourceTypeName), true
+	}
+	return configObj.ResourceTypeByKey(r.ConfigKey)
 }
 
 // GetAndSetIdentifiers discovers resources and stores their identifiers (implements AwsResource/GcpResource interface)
 func (r *Resource[C]) GetAndSetIdentifiers(ctx context.Context, configObj config.Config) ([]string, error) {
-	if r.Lister == nil {
+	if r.Lister == nil && r.DetailedLister == nil {
 		return nil, fmt.Errorf("%s: Lister function not configured", r.ResourceTypeName)
 	}
 
 	// Extract resource-specific config and pass to Lister
-	if r.ConfigGetter == nil {
-		return nil, fmt.Errorf("%s: ConfigGetter function not configured", r.ResourceTypeName)
+	resourceCfg, ok := r.resourceConfig(configObj)
+	if !ok {
+		return nil, fmt.Errorf("%s: ConfigKey %q is not a field of the config", r.ResourceTypeName, r.ConfigKey)
 	}
 
 	// If an error occurred during initialization (e.g. API disabled), return it here
@@ -155,9 +239,20 @@ func (r *Resource[C]) GetAndSetIdentifiers(ctx context.Context, configObj config
 		return nil, r.InitializationError
 	}
 
-	resourceCfg := r.ConfigGetter(configObj)
-	identifiers, err := r.Lister(ctx, r.Client, r.Scope, resourceCfg)
+	timeout, err := resourceCfg.GetTimeout()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", r.ResourceTypeName, err)
+	}
+	r.timeout = timeout
+
+	listCtx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
+	identifiers, err := r.list(listCtx, resourceCfg)
 	if err != nil {
+		if r.timedOut(ctx, listCtx) {
+			err = util.ResourceExecutionTimeout{Timeout: r.timeout}
+		}
 		return nil, fmt.Errorf("%s: failed to list resources in %s: %w", r.ResourceTypeName, r.Scope, err)
 	}
 
@@ -172,6 +267,38 @@ func (r *Resource[C]) GetAndSetIdentifiers(ctx context.Context, configObj config
 	return r.identifiers, nil
 }
 
+// list retrieves the resource identifiers with DetailedLister, recording the details of each resource,
+// or with Lister when no DetailedLister is set.
+func (r *Resource[C]) list(ctx context.Context, resourceCfg config.ResourceType) ([]*string, error) {
+	r.details = nil
+	if r.DetailedLister == nil {
+		return r.Lister(ctx, r.Client, r.Scope, resourceCfg)
+	}
+
+	listed, err := r.DetailedLister(ctx, r.Client, r.Scope, resourceCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	r.details = make(map[string]ResourceDetails, len(listed))
+	identifiers := make([]*string, 0, len(listed))
+	for _, details := range listed {
+		id := details.ID
+		r.details[id] = details
+		identifiers = append(identifiers, &id)
+	}
+	return identifiers, nil
+}
+
+// Details returns the details of a discovered resource (implements AwsResource/GcpResource interface).
+// Resources listed without details only have their ID set.
+func (r *Resource[C]) Details(id string) ResourceDetails {
+	if details, ok := r.details[id]; ok {
+		return details
+	}
+	return ResourceDetails{ID: id}
+}
+
 // Nuke deletes the resources with the given identifiers (implements AwsResource/GcpResource interface)
 // Returns the results of each deletion attempt. The caller is responsible for reporting.
 func (r *Resource[C]) Nuke(ctx context.Context, identifiers []string) ([]NukeResult, error) {
@@ -187,14 +314,28 @@ func (r *Resource[C]) Nuke(ctx context.Context, identifiers []string) ([]NukeRes
 		return nil, fmt.Errorf("%s: Nuker function not configured", r.ResourceTypeName)
 	}
 
+	nukeCtx, cancel := r.withTimeout(ctx)
+	defer cancel()
+
 	ptrIdentifiers := util.ToStringPtrSlice(identifiers)
-	results := r.Nuker(ctx, r.Client, r.Scope, r.ResourceTypeName, ptrIdentifiers)
+	results := r.Nuker(nukeCtx, r.Client, r.Scope, r.ResourceTypeName, ptrIdentifiers)
 
-	// Aggregate errors and log results (logging stays here, it's not reporting)
+	// Deletions that failed because the batch ran out of time are reported as timeouts, rather than as the
+	// context error surfaced by the cloud SDK. The other failures of the batch are reported as they are.
 	var allErrs *multierror.Error
-	for _, result := range results {
+	timedOut := r.timedOut(ctx, nukeCtx)
+	timeoutErr := util.ResourceExecutionTimeout{Timeout: r.timeout}
+	if timedOut {
+		logging.Errorf("[Timeout] %s in %s: %s", r.ResourceTypeName, r.Scope, timeoutErr)
+		allErrs = multierror.Append(allErrs, timeoutErr)
+	}
+
+	// Aggregate errors and log results (logging stays here, it's not reporting)
+	for i, result := range results {
 		if result.Error != nil {
-			if util.IsWarningError(result.Error) {
+			if timedOut && util.IsContextError(result.Error) {
+				results[i].Error = timeoutErr
+			} else if util.IsWarningError(result.Error) {
 				logging.Warnf("[Warning] %s %s: %s (non-fatal, will retry next run)",
 					r.ResourceTypeName, result.Identifier, result.Error)
 			} else {
@@ -209,6 +350,20 @@ func (r *Resource[C]) Nuke(ctx context.Context, identifiers []string) ([]NukeRes
 	return results, errors.WithStackTrace(allErrs.ErrorOrNil())
 }
 
+// withTimeout derives a context bounded by the configured timeout, if any.
+func (r *Resource[C]) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if r.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, r.timeout)
+}
+
+// timedOut reports whether ctx expired because of the resource timeout, as opposed to
+// the parent context being cancelled or reaching its own deadline.
+func (r *Resource[C]) timedOut(parent context.Context, ctx context.Context) bool {
+	return r.timeout > 0 && parent.Err() == nil && ctx.Err() == context.DeadlineExceeded
+}
+
 // IsNukable checks if a resource can be nuked (implements AwsResource/GcpResource interface).
 // Returns (true, nil) if nukable, (false, error) if not.
 // If the identifier was never verified, returns (true, nil) - assuming nukable by default.
@@ -3,8 +3,11 @@ package resource
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/smithy-go"
 	"github.com/gruntwork-io/cloud-nuke/config"
 	"github.com/gruntwork-io/cloud-nuke/util"
 	"github.com/stretchr/testify/assert"
@@ -49,9 +52,6 @@ func TestResource_GetAndSetIdentifiers(t *testing.T) {
 			id1, id2 := "id-1", "id-2"
 			return []*string{&id1, &id2}, nil
 		},
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return config.ResourceType{}
-		},
 	}
 	r.Init(nil)
 
@@ -62,6 +62,58 @@ func TestResource_GetAndSetIdentifiers(t *testing.T) {
 	assert.Equal(t, []string{"id-1", "id-2"}, r.ResourceIdentifiers())
 }
 
+func TestResource_GetAndSetIdentifiers_DetailedLister(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		DetailedLister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]ResourceDetails, error) {
+			return []ResourceDetails{
+				{ID: "id-1", ARN: "arn:test:id-1", Name: "first", CreatedAt: &created, Tags: map[string]string{"team": "a"}},
+				{ID: "id-2", Attributes: map[string]string{"size": "8"}},
+			}, nil
+		},
+	}
+	r.Init(nil)
+
+	ids, err := r.GetAndSetIdentifiers(context.Background(), config.Config{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"id-1", "id-2"}, ids)
+
+	details := r.Details("id-1")
+	assert.Equal(t, "arn:test:id-1", details.ARN)
+	assert.Equal(t, "first", details.Name)
+	assert.Equal(t, &created, details.CreatedAt)
+	assert.Equal(t, map[string]string{"team": "a"}, details.Tags)
+	assert.Equal(t, map[string]string{"size": "8"}, r.Details("id-2").Attributes)
+	assert.Equal(t, ResourceDetails{ID: "unknown"}, r.Details("unknown"))
+}
+
+func TestNewResourceDetails(t *testing.T) {
+	id, name := "id-1", "first"
+	created := time.Now()
+
+	details := NewResourceDetails(&id, config.ResourceValue{
+		Name:       &name,
+		Time:       &created,
+		Tags:       map[string]string{"team": "a"},
+		Attributes: map[string]interface{}{"size_gib": int64(8), "state": "available"},
+	})
+
+	assert.Equal(t, ResourceDetails{
+		ID:         id,
+		Name:       name,
+		CreatedAt:  &created,
+		Tags:       map[string]string{"team": "a"},
+		Attributes: map[string]string{"size_gib": "8", "state": "available"},
+	}, details)
+	assert.Equal(t, []string{"id-1"}, IDs([]ResourceDetails{details}))
+
+	arn := "arn:test:id-1"
+	assert.Equal(t, arn, details.WithARN(&arn).ARN)
+	assert.Empty(t, details.ARN)
+	assert.Empty(t, details.WithARN(nil).ARN)
+}
+
 func TestResource_GetAndSetIdentifiers_MissingConfig(t *testing.T) {
 	r := &Resource[*mockClient]{ResourceTypeName: "test"}
 
@@ -71,6 +123,41 @@ func TestResource_GetAndSetIdentifiers_MissingConfig(t *testing.T) {
 	assert.Contains(t, err.Error(), "not configured")
 }
 
+func TestResource_GetAndSetIdentifiers_UnknownConfigKey(t *testing.T) {
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		ConfigKey:        "NotAField",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			return nil, nil
+		},
+	}
+	r.Init(nil)
+
+	_, err := r.GetAndSetIdentifiers(context.Background(), config.Config{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `ConfigKey "NotAField"`)
+}
+
+func TestResource_GetAndSetResourceConfig(t *testing.T) {
+	var defaultOnly bool
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "vpc",
+		ConfigKey:        "VPC",
+		OnConfig:         func(c config.Config) { defaultOnly = c.VPC.DefaultOnly },
+	}
+	configObj := config.Config{}
+	configObj.VPC.Timeout = "5m"
+	configObj.VPC.DefaultOnly = true
+
+	assert.Equal(t, "5m", r.GetAndSetResourceConfig(configObj).Timeout)
+	assert.True(t, defaultOnly)
+
+	// Resource types without a ConfigKey read their config from the Custom section
+	custom := &Resource[*mockClient]{ResourceTypeName: "widget"}
+	assert.Equal(t, "7m", custom.GetAndSetResourceConfig(timeoutConfig("widget", "7m")).Timeout)
+}
+
 func TestResource_Nuke(t *testing.T) {
 	nuked := []string{}
 	r := &Resource[*mockClient]{
@@ -125,6 +212,119 @@ func TestResource_Nuke_PropagatesError(t *testing.T) {
 	assert.Contains(t, err.Error(), "delete failed")
 }
 
+// timeoutConfig returns a config setting the timeout of the resource type name, read from the Custom section.
+func timeoutConfig(name string, timeout string) config.Config {
+	return config.Config{Custom: map[string]*config.ResourceType{name: {Timeout: timeout}}}
+}
+
+func TestResource_GetAndSetIdentifiers_Timeout(t *testing.T) {
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+	}
+	r.Init(nil)
+
+	_, err := r.GetAndSetIdentifiers(context.Background(), timeoutConfig("test", "10ms"))
+
+	var timeoutErr util.ResourceExecutionTimeout
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 10*time.Millisecond, timeoutErr.Timeout)
+}
+
+func TestResource_GetAndSetIdentifiers_InvalidTimeout(t *testing.T) {
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			return nil, nil
+		},
+	}
+	r.Init(nil)
+
+	_, err := r.GetAndSetIdentifiers(context.Background(), timeoutConfig("test", "soon"))
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid timeout")
+}
+
+func TestResource_Nuke_Timeout(t *testing.T) {
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			id := "id-1"
+			return []*string{&id}, nil
+		},
+		Nuker: func(ctx context.Context, client *mockClient, scope Scope, resourceType string, ids []*string) []NukeResult {
+			<-ctx.Done()
+			return []NukeResult{{Identifier: *ids[0], Error: ctx.Err()}}
+		},
+	}
+	r.Init(nil)
+
+	_, err := r.GetAndSetIdentifiers(context.Background(), timeoutConfig("test", "10ms"))
+	require.NoError(t, err)
+
+	results, err := r.Nuke(context.Background(), []string{"id-1"})
+
+	require.True(t, util.IsResourceExecutionTimeout(err))
+	require.Len(t, results, 1)
+	assert.Equal(t, util.ResourceExecutionTimeout{Timeout: 10 * time.Millisecond}, results[0].Error)
+}
+
+func TestResource_Nuke_TimeoutKeepsOtherFailures(t *testing.T) {
+	accessDenied := errors.New("AccessDenied")
+	dependencyViolation := &smithy.GenericAPIError{Code: "DependencyViolation"}
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Lister: func(ctx context.Context, client *mockClient, scope Scope, resourceCfg config.ResourceType) ([]*string, error) {
+			return nil, nil
+		},
+		Nuker: func(ctx context.Context, client *mockClient, scope Scope, resourceType string, ids []*string) []NukeResult {
+			<-ctx.Done()
+			return []NukeResult{
+				{Identifier: "denied", Error: accessDenied},
+				{Identifier: "dependent", Error: dependencyViolation},
+				{Identifier: "deleted"},
+				{Identifier: "interrupted", Error: fmt.Errorf("waiting for deletion: %w", ctx.Err())},
+			}
+		},
+	}
+	r.Init(nil)
+
+	_, err := r.GetAndSetIdentifiers(context.Background(), timeoutConfig("test", "10ms"))
+	require.NoError(t, err)
+
+	results, err := r.Nuke(context.Background(), []string{"denied", "dependent", "deleted", "interrupted"})
+
+	require.True(t, util.IsResourceExecutionTimeout(err))
+	assert.ErrorIs(t, err, accessDenied)
+	require.Len(t, results, 4)
+	assert.Equal(t, accessDenied, results[0].Error)
+	assert.Equal(t, dependencyViolation, results[1].Error)
+	assert.NoError(t, results[2].Error)
+	assert.Equal(t, util.ResourceExecutionTimeout{Timeout: 10 * time.Millisecond}, results[3].Error)
+}
+
+func TestResource_Nuke_ParentCancellationIsNotTimeout(t *testing.T) {
+	r := &Resource[*mockClient]{
+		ResourceTypeName: "test",
+		Nuker: func(ctx context.Context, client *mockClient, scope Scope, resourceType string, ids []*string) []NukeResult {
+			return []NukeResult{{Identifier: *ids[0], Error: ctx.Err()}}
+		},
+	}
+	r.Init(nil)
+	r.timeout = time.Minute
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := r.Nuke(ctx, []string{"id-1"})
+
+	require.Error(t, err)
+	assert.False(t, util.IsResourceExecutionTimeout(err))
+}
+
 func TestResource_PermissionVerification(t *testing.T) {
 	r := &Resource[*mockClient]{
 		ResourceTypeName: "test",
@@ -132,9 +332,6 @@ func TestResource_PermissionVerification(t *testing.T) {
 			allowed, denied := "allowed", "denied"
 			return []*string{&allowed, &denied}, nil
 		},
-		ConfigGetter: func(c config.Config) config.ResourceType {
-			return config.ResourceType{}
-		},
 		PermissionVerifier: func(ctx context.Context, client *mockClient, id *string) error {
 			if *id == "denied" {
 				return errors.New("access denied")
@@ -0,0 +1,157 @@
+// Package resourcetest provides fakes to test the code built on top of resource.NukeableResource, e.g., the engine
+// and the providers, without calling any cloud API.
+package resourcetest
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/gruntwork-io/cloud-nuke/reporting"
+	"github.com/gruntwork-io/cloud-nuke/resource"
+	"github.com/stretchr/testify/require"
+)
+
+// Fake is a resource type whose identifiers are held in memory. Listing returns the identifiers that exist and are
+// included by the config of the resource type, and deleting them removes them.
+type Fake struct {
+	// Name is the resource type name.
+	Name string
+	// BatchSize is the maximum number of identifiers per batch of deletions, resource.DefaultBatchSize when 0.
+	BatchSize int
+	// ConfigKey is the YAML key of the field of the config the resource type is configured by. Empty reads its
+	// config from the Custom section, like the resource types registered by code importing cloud-nuke.
+	ConfigKey string
+	// Tags holds the tags of the identifiers, matched against the protection tags and tag filters of the config.
+	Tags map[string]map[string]string
+	// ListErr fails every listing when it is set.
+	ListErr error
+	// DeleteErr returns the error deleting an identifier. Nil deletes every identifier successfully.
+	DeleteErr func(id string) error
+	// Lingering returns whether a deleted identifier is still listed, like an asynchronous deletion that has not
+	// completed yet. It is called every time the identifier is listed.
+	Lingering func(id string) bool
+
+	mu      sync.Mutex
+	ids     []string
+	deleted map[string]bool
+}
+
+// NewFake returns a fake resource type named name, with the given identifiers.
+func NewFake(name string, ids ...string) *Fake {
+	return &Fake{Name: name, ids: ids, deleted: make(map[string]bool)}
+}
+
+// Resource returns a new, initialized resource of the fake resource type, which has not been listed yet.
+func (f *Fake) Resource() *resource.Resource[struct{}] {
+	res := &resource.Resource[struct{}]{
+		ResourceTypeName: f.Name,
+		BatchSize:        f.BatchSize,
+		ConfigKey:        f.ConfigKey,
+		Lister:           f.list,
+		Nuker:            f.nuke,
+	}
+	res.Init(nil)
+	return res
+}
+
+// Listed returns a new resource of the fake resource type, listed with configObj.
+func (f *Fake) Listed(t testing.TB, configObj config.Config) *resource.Resource[struct{}] {
+	res := f.Resource()
+	_, err := res.GetAndSetIdentifiers(context.Background(), configObj)
+	require.NoError(t, err)
+	return res
+}
+
+// Existing returns the identifiers that have not been deleted.
+func (f *Fake) Existing() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var existing []string
+	for _, id := range f.ids {
+		if !f.deleted[id] {
+			existing = append(existing, id)
+		}
+	}
+	return existing
+}
+
+func (f *Fake) list(ctx context.Context, client struct{}, scope resource.Scope, cfg config.ResourceType) ([]*string, error) {
+	if f.ListErr != nil {
+		return nil, f.ListErr
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var listed []*string
+	for _, id := range f.ids {
+		if f.deleted[id] && (f.Lingering == nil || !f.Lingering(id)) {
+			continue
+		}
+		if cfg.ShouldInclude(config.ResourceValue{Name: &id, Tags: f.Tags[id]}) {
+			listed = append(listed, &id)
+		}
+	}
+	return listed, nil
+}
+
+func (f *Fake) nuke(ctx context.Context, client struct{}, scope resource.Scope, resourceType string, ids []*string) []resource.NukeResult {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var results []resource.NukeResult
+	for _, id := range ids {
+		var err error
+		if f.DeleteErr != nil {
+			err = f.DeleteErr(*id)
+		}
+		if err == nil && slices.Contains(f.ids, *id) {
+			f.deleted[*id] = true
+		}
+		results = append(results, resource.NukeResult{Identifier: *id, Error: err})
+	}
+	return results
+}
+
+// Renderer records the events it renders.
+type Renderer struct {
+	mu     sync.Mutex
+	events []reporting.Event
+}
+
+// OnEvent implements reporting.Renderer.
+func (r *Renderer) OnEvent(event reporting.Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+// Events returns the events rendered so far, in order.
+func (r *Renderer) Events() []reporting.Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.events)
+}
+
+// NewCollector returns a collector rendering its events with a new Renderer.
+func NewCollector() (*reporting.Collector, *Renderer) {
+	renderer := &Renderer{}
+	collector := reporting.NewCollector()
+	collector.AddRenderer(renderer)
+	return collector, renderer
+}
+
+// EventsOf returns the events of type T, in order, e.g., EventsOf[reporting.ResourceDeleted](renderer.Events()).
+func EventsOf[T reporting.Event](events []reporting.Event) []T {
+	var matching []T
+	for _, event := range events {
+		if e, ok := event.(T); ok {
+			matching = append(matching, e)
+		}
+	}
+	return matching
+}
@@ -0,0 +1,129 @@
+package resource
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+)
+
+// Categories group resource types, so that they can be selected together, e.g., with --resource-type network.
+const (
+	CategoryNetwork       = "network"
+	CategoryCompute       = "compute"
+	CategoryStorage       = "storage"
+	CategoryIAM           = "iam"
+	CategoryObservability = "observability"
+	CategoryML            = "ml"
+)
+
+// AllCategories lists the categories resource types can belong to.
+var AllCategories = []string{
+	CategoryNetwork,
+	CategoryCompute,
+	CategoryStorage,
+	CategoryIAM,
+	CategoryObservability,
+	CategoryML,
+}
+
+// SelectAll is the pattern selecting every name.
+const SelectAll = "all"
+
+// ExpandSelection returns the names selected by the patterns, in the order of names, along with the patterns that
+// select nothing. A pattern is either:
+//   - a name, or SelectAll,
+//   - a glob matched against the names with path.Match, e.g., "ec2-*",
+//   - a group of names, e.g., a category, looked up in groups,
+//   - any of the above prefixed with "!", which removes the names it selects from those selected so far.
+//
+// Patterns are applied in order. When the first pattern is a removal, the selection starts from every name, so that
+// "!us-east-1" selects every name but us-east-1.
+func ExpandSelection(names []string, patterns []string, groups map[string][]string) ([]string, []string) {
+	var selected map[string]bool
+	var unmatched []string
+	for i, pattern := range patterns {
+		negated := strings.HasPrefix(pattern, "!")
+		pattern = strings.TrimPrefix(pattern, "!")
+
+		if selected == nil {
+			selected = make(map[string]bool)
+			if i == 0 && negated {
+				for _, name := range names {
+					selected[name] = true
+				}
+			}
+		}
+
+		matches := matchPattern(names, pattern, groups)
+		if len(matches) == 0 {
+			unmatched = append(unmatched, patterns[i])
+			continue
+		}
+		for _, name := range matches {
+			selected[name] = !negated
+		}
+	}
+
+	var expanded []string
+	for _, name := range names {
+		if selected[name] {
+			expanded = append(expanded, name)
+		}
+	}
+	return expanded, unmatched
+}
+
+// matchPattern returns the names selected by a pattern without its "!" prefix. A name is preferred over a group of
+// the same name.
+func matchPattern(names []string, pattern string, groups map[string][]string) []string {
+	if pattern == SelectAll {
+		return names
+	}
+	if slices.Contains(names, pattern) {
+		return []string{pattern}
+	}
+	if group, ok := groups[pattern]; ok {
+		var matches []string
+		for _, name := range group {
+			if slices.Contains(names, name) {
+				matches = append(matches, name)
+			}
+		}
+		return matches
+	}
+
+	var matches []string
+	for _, name := range names {
+		if ok, err := path.Match(pattern, name); err == nil && ok {
+			matches = append(matches, name)
+		}
+	}
+	return matches
+}
+
+// GroupByCategory returns the names of the resource types of each category.
+func GroupByCategory[T NukeableResource](res []T) map[string][]string {
+	groups := make(map[string][]string)
+	for _, r := range res {
+		for _, category := range r.ResourceCategories() {
+			groups[category] = append(groups[category], r.ResourceName())
+		}
+	}
+	return groups
+}
+
+// ConfigSchema returns the config sections of the resource types, keyed by their names, see
+// config.GetConfigWithSchema.
+func ConfigSchema[T NukeableResource](res []T) config.Schema {
+	var schema config.Schema
+	for _, r := range res {
+		schema = append(schema, config.Section{
+			Name:   r.ResourceName(),
+			Key:    r.ResourceConfigKey(),
+			NoTags: !r.SupportsTags(),
+		})
+	}
+	return schema
+}
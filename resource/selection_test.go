@@ -0,0 +1,63 @@
+package resource
+
+import (
+	"testing"
+
+	"github.com/gruntwork-io/cloud-nuke/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandSelection(t *testing.T) {
+	names := []string{"ec2", "ec2-keypairs", "ec2-subnet", "s3", "vpc"}
+	groups := map[string][]string{CategoryNetwork: {"ec2-subnet", "vpc"}, "ec2": {"s3"}}
+
+	testCases := []struct {
+		name      string
+		patterns  []string
+		expected  []string
+		unmatched []string
+	}{
+		{"names", []string{"vpc", "ec2"}, []string{"ec2", "vpc"}, nil},
+		{"all", []string{SelectAll}, names, nil},
+		{"glob", []string{"ec2-*"}, []string{"ec2-keypairs", "ec2-subnet"}, nil},
+		{"category", []string{CategoryNetwork}, []string{"ec2-subnet", "vpc"}, nil},
+		{"names are preferred over groups", []string{"ec2"}, []string{"ec2"}, nil},
+		{"negation starts from every name", []string{"!ec2*"}, []string{"s3", "vpc"}, nil},
+		{"negation removes from the selection", []string{"ec2*", "!ec2-subnet"}, []string{"ec2", "ec2-keypairs"}, nil},
+		{"patterns are applied in order", []string{"!ec2*", "ec2"}, []string{"ec2", "s3", "vpc"}, nil},
+		{"unmatched", []string{"vpc", "eks-*", "!rds", "["}, []string{"vpc"}, []string{"eks-*", "!rds", "["}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			expanded, unmatched := ExpandSelection(names, tc.patterns, groups)
+			assert.Equal(t, tc.expected, expanded)
+			assert.Equal(t, tc.unmatched, unmatched)
+		})
+	}
+}
+
+func TestGroupByCategory(t *testing.T) {
+	res := []NukeableResource{
+		&Resource[struct{}]{ResourceTypeName: "vpc", Categories: []string{CategoryNetwork}},
+		&Resource[struct{}]{ResourceTypeName: "rds-subnet-group", Categories: []string{CategoryStorage, CategoryNetwork}},
+		&Resource[struct{}]{ResourceTypeName: "sqs"},
+	}
+
+	assert.Equal(t, map[string][]string{
+		CategoryNetwork: {"vpc", "rds-subnet-group"},
+		CategoryStorage: {"rds-subnet-group"},
+	}, GroupByCategory(res))
+}
+
+func TestConfigSchema(t *testing.T) {
+	res := []NukeableResource{
+		&Resource[struct{}]{ResourceTypeName: "ec2-keypairs", ConfigKey: "EC2KeyPairs"},
+		&Resource[struct{}]{ResourceTypeName: "internal-service-tag", NoTags: true},
+	}
+
+	assert.Equal(t, config.Schema{
+		{Name: "ec2-keypairs", Key: "EC2KeyPairs"},
+		{Name: "internal-service-tag", NoTags: true},
+	}, ConfigSchema(res))
+}
@@ -0,0 +1,147 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/aws/smithy-go"
+)
+
+// DefaultCircuitBreakerThreshold is the number of consecutive failures of the same class after which nuking a
+// resource type is stopped.
+const DefaultCircuitBreakerThreshold = 10
+
+// CircuitBreaker stops an operation that keeps failing the same way, e.g., every deletion of a resource type
+// being denied for lack of permissions. It trips after Threshold consecutive failures of the same class. A
+// success, or a failure of another class, starts the count over. Once tripped, it stays tripped.
+//
+// Thread-safe for concurrent use. Methods are no-ops on a nil *CircuitBreaker, which never trips.
+type CircuitBreaker struct {
+	Threshold int
+
+	mu      sync.Mutex
+	class   string
+	count   int
+	tripped bool
+}
+
+// NewCircuitBreaker creates a circuit breaker that trips after threshold consecutive failures of the same class.
+func NewCircuitBreaker(threshold int) *CircuitBreaker {
+	return &CircuitBreaker{Threshold: threshold}
+}
+
+// Succeeded records a success, which starts the count of consecutive failures over.
+func (b *CircuitBreaker) Succeeded() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.class, b.count = "", 0
+}
+
+// Failed records a failure of the given class, and returns true if this failure tripped the breaker.
+func (b *CircuitBreaker) Failed(class string) bool {
+	if b == nil {
+		return false
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.tripped {
+		return false
+	}
+	if class != b.class {
+		b.class, b.count = class, 0
+	}
+	b.count++
+	if b.count >= b.Threshold {
+		b.tripped = true
+		return true
+	}
+	return false
+}
+
+// Tripped returns true if the breaker tripped, along with the class of the failures that tripped it.
+func (b *CircuitBreaker) Tripped() (bool, string) {
+	if b == nil {
+		return false, ""
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.tripped {
+		return false, ""
+	}
+	return true, b.class
+}
+
+// CircuitBreakers hands out one CircuitBreaker per key (e.g., per resource type and region).
+// Thread-safe for concurrent use.
+type CircuitBreakers struct {
+	threshold int
+
+	mu       sync.Mutex
+	breakers map[string]*CircuitBreaker
+}
+
+// NewCircuitBreakers creates a set of circuit breakers sharing the same threshold. A threshold of 0 or less
+// disables them: For then returns nil breakers, which never trip.
+func NewCircuitBreakers(threshold int) *CircuitBreakers {
+	return &CircuitBreakers{
+		threshold: threshold,
+		breakers:  make(map[string]*CircuitBreaker),
+	}
+}
+
+// For returns the circuit breaker for the given key, creating it on first use.
+func (c *CircuitBreakers) For(key string) *CircuitBreaker {
+	if c == nil || c.threshold <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	breaker, ok := c.breakers[key]
+	if !ok {
+		breaker = NewCircuitBreaker(c.threshold)
+		c.breakers[key] = breaker
+	}
+	return breaker
+}
+
+// CircuitBreakerTrippedError is returned when nuking a resource type in a region, or the rest of a region when
+// ResourceType is empty, is stopped by a circuit breaker.
+type CircuitBreakerTrippedError struct {
+	ResourceType string
+	Region       string
+	ErrorClass   string
+}
+
+func (err CircuitBreakerTrippedError) Error() string {
+	if err.ResourceType == "" {
+		return fmt.Sprintf("Stopped nuking %s after resource types repeatedly failed with %s", err.Region, err.ErrorClass)
+	}
+	return fmt.Sprintf("Stopped nuking %s in %s after repeated %s failures", err.ResourceType, err.Region, err.ErrorClass)
+}
+
+// ErrorClass returns the class of an error, so that repeated failures of the same kind can be told apart from
+// unrelated ones: the AWS error code when there is one, and the error message otherwise.
+func ErrorClass(err error) string {
+	if errors.Is(err, ErrInSufficientPermission) {
+		return "InsufficientPermission"
+	}
+	if IsResourceExecutionTimeout(err) {
+		return "ExecutionTimeout"
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.ErrorCode()
+	}
+	return err.Error()
+}
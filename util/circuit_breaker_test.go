@@ -0,0 +1,67 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker_TripsAfterConsecutiveFailuresOfSameClass(t *testing.T) {
+	t.Parallel()
+	breaker := NewCircuitBreaker(3)
+
+	assert.False(t, breaker.Failed("AccessDenied"))
+	assert.False(t, breaker.Failed("AccessDenied"))
+	assert.True(t, breaker.Failed("AccessDenied"))
+
+	tripped, class := breaker.Tripped()
+	assert.True(t, tripped)
+	assert.Equal(t, "AccessDenied", class)
+
+	assert.False(t, breaker.Failed("AccessDenied"), "a tripped breaker reports the trip only once")
+}
+
+func TestCircuitBreaker_SuccessOrOtherClassStartsOver(t *testing.T) {
+	t.Parallel()
+	breaker := NewCircuitBreaker(2)
+
+	breaker.Failed("AccessDenied")
+	breaker.Succeeded()
+	assert.False(t, breaker.Failed("AccessDenied"))
+	assert.False(t, breaker.Failed("DependencyViolation"))
+
+	tripped, _ := breaker.Tripped()
+	assert.False(t, tripped)
+}
+
+func TestCircuitBreaker_NilNeverTrips(t *testing.T) {
+	t.Parallel()
+	var breaker *CircuitBreaker
+
+	breaker.Succeeded()
+	assert.False(t, breaker.Failed("AccessDenied"))
+	tripped, _ := breaker.Tripped()
+	assert.False(t, tripped)
+}
+
+func TestCircuitBreakers_For(t *testing.T) {
+	t.Parallel()
+	breakers := NewCircuitBreakers(1)
+
+	assert.Same(t, breakers.For("us-east-1/ec2"), breakers.For("us-east-1/ec2"))
+	assert.NotSame(t, breakers.For("us-east-1/ec2"), breakers.For("us-west-2/ec2"))
+
+	assert.Nil(t, NewCircuitBreakers(0).For("us-east-1/ec2"), "a threshold of 0 disables the breakers")
+}
+
+func TestErrorClass(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "InsufficientPermission", ErrorClass(fmt.Errorf("wrapped: %w", ErrInSufficientPermission)))
+	assert.Equal(t, "ExecutionTimeout", ErrorClass(ResourceExecutionTimeout{}))
+	assert.Equal(t, "DependencyViolation", ErrorClass(&smithy.GenericAPIError{Code: "DependencyViolation"}))
+	assert.Equal(t, "boom", ErrorClass(errors.New("boom")))
+}
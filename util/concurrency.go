@@ -0,0 +1,31 @@
+package util
+
+import "sync"
+
+// ForEachConcurrently calls fn for every item using at most maxConcurrent goroutines
+// and blocks until all calls have returned. A maxConcurrent of 1 or less processes the
+// items sequentially, in order, on the calling goroutine.
+func ForEachConcurrently[T any](items []T, maxConcurrent int, fn func(item T)) {
+	if maxConcurrent <= 1 {
+		for _, item := range items {
+			fn(item)
+		}
+		return
+	}
+
+	sem := make(chan struct{}, maxConcurrent)
+	var wg sync.WaitGroup
+
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(item)
+		}(item)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachConcurrently_Sequential(t *testing.T) {
+	t.Parallel()
+	var seen []string
+	ForEachConcurrently([]string{"a", "b", "c"}, 1, func(item string) {
+		seen = append(seen, item)
+	})
+	require.Equal(t, []string{"a", "b", "c"}, seen)
+}
+
+func TestForEachConcurrently_BoundedParallelism(t *testing.T) {
+	t.Parallel()
+	var running, maxRunning int32
+	var mu sync.Mutex
+	var seen []int
+
+	ForEachConcurrently([]int{1, 2, 3, 4, 5, 6}, 2, func(item int) {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			prev := atomic.LoadInt32(&maxRunning)
+			if current <= prev || atomic.CompareAndSwapInt32(&maxRunning, prev, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+
+		mu.Lock()
+		seen = append(seen, item)
+		mu.Unlock()
+	})
+
+	require.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, seen)
+	require.LessOrEqual(t, maxRunning, int32(2))
+}
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -65,6 +66,17 @@ func (err ResourceExecutionTimeout) Error() string {
 	return fmt.Sprintf("execution timed out after: %v", err.Timeout)
 }
 
+// IsResourceExecutionTimeout checks if the error is, or wraps, a ResourceExecutionTimeout.
+func IsResourceExecutionTimeout(err error) bool {
+	var timeoutErr ResourceExecutionTimeout
+	return errors.As(err, &timeoutErr)
+}
+
+// IsContextError checks if the error is, or wraps, the error of a context that was cancelled or ran out of time.
+func IsContextError(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled)
+}
+
 // IsThrottlingError checks if the error is an AWS API throttling error
 // using structured error code matching via smithy.APIError.
 func IsThrottlingError(err error) bool {
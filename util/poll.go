@@ -39,3 +39,17 @@ func PollUntil(ctx context.Context, description string, interval, timeout time.D
 		}
 	}
 }
+
+// SleepWithContext pauses for the given duration, returning early with the
+// context error if the context is cancelled first.
+func SleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -65,3 +65,19 @@ func TestPollUntil_ContextCancellation(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "polling cancelled-op")
 }
+
+func TestSleepWithContext_Elapsed(t *testing.T) {
+	t.Parallel()
+	require.NoError(t, SleepWithContext(context.Background(), 10*time.Millisecond))
+}
+
+func TestSleepWithContext_Cancelled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err := SleepWithContext(ctx, time.Minute)
+	require.ErrorIs(t, err, context.Canceled)
+	require.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,132 @@
+package util
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultThrottleInitialDelay is the delay between calls after the first throttling response.
+	DefaultThrottleInitialDelay = 1 * time.Second
+	// DefaultThrottleMaxDelay caps the delay between calls to a throttled service.
+	DefaultThrottleMaxDelay = 1 * time.Minute
+)
+
+// AdaptiveRateLimiter paces calls to a single cloud service. Every throttling response doubles the delay
+// between calls, up to MaxDelay, and every success halves it again. Calls are not delayed at all until the
+// service throttles, so fast services are never slowed down by slow ones.
+//
+// Thread-safe for concurrent use.
+type AdaptiveRateLimiter struct {
+	InitialDelay time.Duration
+	MaxDelay     time.Duration
+
+	mu    sync.Mutex
+	delay time.Duration
+	next  time.Time
+}
+
+// NewAdaptiveRateLimiter creates a rate limiter that starts backing off at initialDelay, up to maxDelay.
+func NewAdaptiveRateLimiter(initialDelay, maxDelay time.Duration) *AdaptiveRateLimiter {
+	return &AdaptiveRateLimiter{InitialDelay: initialDelay, MaxDelay: maxDelay}
+}
+
+// Wait blocks until the next call is allowed, or until ctx is done, in which case the context error is returned.
+func (l *AdaptiveRateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	start := now
+	if l.next.After(now) {
+		start = l.next
+	}
+	// Reserve the slot so concurrent callers are spaced out by the current delay
+	l.next = start.Add(l.delay)
+	l.mu.Unlock()
+
+	wait := start.Sub(now)
+	if wait <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Throttled records a throttling response. It increases the delay exponentially and postpones the next call
+// by a jittered backoff, which is returned for logging.
+func (l *AdaptiveRateLimiter) Throttled() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.delay < l.InitialDelay {
+		l.delay = l.InitialDelay
+	} else {
+		l.delay *= 2
+	}
+	if l.delay > l.MaxDelay {
+		l.delay = l.MaxDelay
+	}
+
+	// Jitter the backoff within [delay/2, delay] so that concurrent callers do not retry in lockstep
+	backoff := l.delay/2 + time.Duration(rand.Int63n(int64(l.delay/2)+1))
+	l.next = time.Now().Add(backoff)
+	return backoff
+}
+
+// Succeeded records a call that was not throttled, halving the delay between calls.
+func (l *AdaptiveRateLimiter) Succeeded() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.delay /= 2
+	if l.delay < l.InitialDelay {
+		l.delay = 0
+	}
+}
+
+// Delay returns the current delay between calls.
+func (l *AdaptiveRateLimiter) Delay() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.delay
+}
+
+// RateLimiters hands out one AdaptiveRateLimiter per key (e.g., per service and region).
+// Thread-safe for concurrent use.
+type RateLimiters struct {
+	initialDelay time.Duration
+	maxDelay     time.Duration
+
+	mu       sync.Mutex
+	limiters map[string]*AdaptiveRateLimiter
+}
+
+// NewRateLimiters creates a set of rate limiters sharing the same backoff settings.
+func NewRateLimiters(initialDelay, maxDelay time.Duration) *RateLimiters {
+	return &RateLimiters{
+		initialDelay: initialDelay,
+		maxDelay:     maxDelay,
+		limiters:     make(map[string]*AdaptiveRateLimiter),
+	}
+}
+
+// For returns the rate limiter for the given key, creating it on first use.
+func (r *RateLimiters) For(key string) *AdaptiveRateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = NewAdaptiveRateLimiter(r.initialDelay, r.maxDelay)
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
@@ -0,0 +1,64 @@
+package util
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveRateLimiter_NoDelayUntilThrottled(t *testing.T) {
+	t.Parallel()
+	limiter := NewAdaptiveRateLimiter(time.Second, time.Minute)
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		require.NoError(t, limiter.Wait(context.Background()))
+	}
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+	assert.Zero(t, limiter.Delay())
+}
+
+func TestAdaptiveRateLimiter_BacksOffExponentially(t *testing.T) {
+	t.Parallel()
+	limiter := NewAdaptiveRateLimiter(time.Second, 5*time.Second)
+
+	backoff := limiter.Throttled()
+	assert.Equal(t, time.Second, limiter.Delay())
+	assert.GreaterOrEqual(t, backoff, 500*time.Millisecond)
+	assert.LessOrEqual(t, backoff, time.Second)
+
+	limiter.Throttled()
+	assert.Equal(t, 2*time.Second, limiter.Delay())
+	limiter.Throttled()
+	limiter.Throttled()
+	assert.Equal(t, 5*time.Second, limiter.Delay(), "delay is capped at the maximum")
+
+	limiter.Succeeded()
+	assert.Equal(t, 2500*time.Millisecond, limiter.Delay())
+	limiter.Succeeded()
+	limiter.Succeeded()
+	assert.Zero(t, limiter.Delay(), "delay drops to zero below the initial delay")
+}
+
+func TestAdaptiveRateLimiter_WaitHonoursContext(t *testing.T) {
+	t.Parallel()
+	limiter := NewAdaptiveRateLimiter(time.Minute, time.Minute)
+	limiter.Throttled()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	err := limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestRateLimiters_OnePerKey(t *testing.T) {
+	t.Parallel()
+	limiters := NewRateLimiters(time.Second, time.Minute)
+
+	assert.Same(t, limiters.For("us-east-1/ec2"), limiters.For("us-east-1/ec2"))
+	assert.NotSame(t, limiters.For("us-east-1/ec2"), limiters.For("us-east-1/s3"))
+}